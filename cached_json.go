@@ -0,0 +1,99 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// CachedJSON is a mutable container for an Optional that memoizes the result of marshaling it into JSON until the
+// Optional is changed using Set or Clear. This trades memory for CPU when the same Optional is marshaled repeatedly
+// (e.g. within hot serialization paths).
+//
+// The zero value of CachedJSON is ready to use and contains an empty Optional. A CachedJSON is safe for concurrent use
+// and should be passed around via a pointer, since a copy would carry its own memoized JSON that Set and Clear on the
+// original never discard.
+//
+// Since the memoized JSON is only discarded by Set or Clear, it goes stale if T is a map, slice, or pointer and the
+// data it references is mutated after the first marshal. Set must be called again after any such mutation for the
+// change to be reflected.
+type CachedJSON[T any] struct {
+	// data is the memoized JSON encoding of opt, if any.
+	data []byte
+	// mu guards data and opt.
+	mu sync.Mutex
+	// opt is the Optional.
+	opt Optional[T]
+}
+
+var _ json.Marshaler = (*CachedJSON[any])(nil)
+
+// NewCachedJSON returns a CachedJSON containing the given Optional.
+func NewCachedJSON[T any](opt Optional[T]) *CachedJSON[T] {
+	return &CachedJSON[T]{opt: opt}
+}
+
+// Clear empties the Optional within the CachedJSON, invalidating any memoized JSON.
+func (c *CachedJSON[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data = nil
+	c.opt = Optional[T]{}
+}
+
+// MarshalJSON marshals the Optional within the CachedJSON into JSON, reusing the result of any previous call unless the
+// Optional has since been changed. See Optional.MarshalJSON for more information.
+//
+// The returned bytes are shared between calls and so must not be modified.
+//
+// An error is returned if unable to marshal the value, in which case nothing is memoized.
+func (c *CachedJSON[T]) MarshalJSON() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.data == nil {
+		data, err := c.opt.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		c.data = data
+	}
+	return c.data, nil
+}
+
+// Optional returns the Optional within the CachedJSON.
+func (c *CachedJSON[T]) Optional() Optional[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.opt
+}
+
+// Set replaces the Optional within the CachedJSON with one that has the given value present, invalidating any memoized
+// JSON.
+func (c *CachedJSON[T]) Set(value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data = nil
+	c.opt = Optional[T]{
+		present: true,
+		value:   value,
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func BenchmarkNewCachedJSON(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = NewCachedJSON(opt)
+	}
+}
+
+type newCachedJSONTC[T any] struct {
+	opt Optional[T]
+	test.Control
+}
+
+func (tc newCachedJSONTC[T]) Test(t *testing.T) {
+	c := NewCachedJSON(tc.opt)
+	assert.Equal(t, tc.opt, c.Optional(), "unexpected Optional")
+}
+
+func TestNewCachedJSON(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": newCachedJSONTC[int]{
+			opt: Empty[int](),
+		},
+		"given non-empty int Optional with zero value": newCachedJSONTC[int]{
+			opt: Of(0),
+		},
+		"given non-empty int Optional with non-zero value": newCachedJSONTC[int]{
+			opt: Of(123),
+		},
+		"given empty string Optional": newCachedJSONTC[string]{
+			opt: Empty[string](),
+		},
+		"given non-empty string Optional with zero value": newCachedJSONTC[string]{
+			opt: Of(""),
+		},
+		"given non-empty string Optional with non-zero value": newCachedJSONTC[string]{
+			opt: Of("abc"),
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkCachedJSON_Clear(b *testing.B) {
+	c := NewCachedJSON(Of(123))
+	for i := 0; i < b.N; i++ {
+		c.Clear()
+	}
+}
+
+type cachedJSONClearTC[T any] struct {
+	opt Optional[T]
+	test.Control
+}
+
+func (tc cachedJSONClearTC[T]) Test(t *testing.T) {
+	c := NewCachedJSON(tc.opt)
+	_, err := c.MarshalJSON()
+	assert.NoError(t, err, "unexpected error")
+	c.Clear()
+	assert.Equal(t, Empty[T](), c.Optional(), "unexpected Optional")
+	data, err := c.MarshalJSON()
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, "null", string(data), "unexpected JSON")
+}
+
+func TestCachedJSON_Clear(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int CachedJSON": cachedJSONClearTC[int]{
+			opt: Empty[int](),
+		},
+		"on non-empty int CachedJSON": cachedJSONClearTC[int]{
+			opt: Of(123),
+		},
+		"on empty string CachedJSON": cachedJSONClearTC[string]{
+			opt: Empty[string](),
+		},
+		"on non-empty string CachedJSON": cachedJSONClearTC[string]{
+			opt: Of("abc"),
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkCachedJSON_MarshalJSON(b *testing.B) {
+	c := NewCachedJSON(Of(123))
+	for i := 0; i < b.N; i++ {
+		if _, err := c.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type cachedJSONMarshalJSONTC[T any] struct {
+	opt         Optional[T]
+	expectError bool
+	expect      string
+	test.Control
+}
+
+func (tc cachedJSONMarshalJSONTC[T]) Test(t *testing.T) {
+	c := NewCachedJSON(tc.opt)
+	first, err := c.MarshalJSON()
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+		_, err = c.MarshalJSON()
+		assert.Error(t, err, "expected error on subsequent call")
+		return
+	}
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expect, string(first), "unexpected JSON")
+	second, err := c.MarshalJSON()
+	assert.NoError(t, err, "unexpected error")
+	assert.Same(t, &first[0], &second[0], "expected memoized JSON to be reused")
+	data, err := json.Marshal(struct {
+		Field *CachedJSON[T] `json:"field"`
+	}{c})
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, `{"field":`+tc.expect+`}`, string(data), "unexpected JSON within struct")
+}
+
+func TestCachedJSON_MarshalJSON(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int CachedJSON": cachedJSONMarshalJSONTC[int]{
+			opt:    Empty[int](),
+			expect: "null",
+		},
+		"on non-empty int CachedJSON with zero value": cachedJSONMarshalJSONTC[int]{
+			opt:    Of(0),
+			expect: "0",
+		},
+		"on non-empty int CachedJSON with non-zero value": cachedJSONMarshalJSONTC[int]{
+			opt:    Of(123),
+			expect: "123",
+		},
+		"on empty string CachedJSON": cachedJSONMarshalJSONTC[string]{
+			opt:    Empty[string](),
+			expect: "null",
+		},
+		"on non-empty string CachedJSON with zero value": cachedJSONMarshalJSONTC[string]{
+			opt:    Of(""),
+			expect: `""`,
+		},
+		"on non-empty string CachedJSON with non-zero value": cachedJSONMarshalJSONTC[string]{
+			opt:    Of("abc"),
+			expect: `"abc"`,
+		},
+		// Other test cases...
+		"on non-empty float64 CachedJSON with unsupported value": cachedJSONMarshalJSONTC[float64]{
+			opt:         Of(math.Inf(1)),
+			expectError: true,
+		},
+	})
+}
+
+func BenchmarkCachedJSON_Optional(b *testing.B) {
+	c := NewCachedJSON(Of(123))
+	for i := 0; i < b.N; i++ {
+		_ = c.Optional()
+	}
+}
+
+func TestCachedJSON_Optional(t *testing.T) {
+	var c CachedJSON[int]
+	assert.Equal(t, Empty[int](), c.Optional(), "unexpected Optional for zero value")
+	c.Set(123)
+	assert.Equal(t, Of(123), c.Optional(), "unexpected Optional after Set")
+}
+
+func BenchmarkCachedJSON_Set(b *testing.B) {
+	c := NewCachedJSON(Of(123))
+	for i := 0; i < b.N; i++ {
+		c.Set(i)
+	}
+}
+
+type cachedJSONSetTC[T any] struct {
+	opt          Optional[T]
+	value        T
+	expectBefore string
+	expectAfter  string
+	test.Control
+}
+
+func (tc cachedJSONSetTC[T]) Test(t *testing.T) {
+	c := NewCachedJSON(tc.opt)
+	data, err := c.MarshalJSON()
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expectBefore, string(data), "unexpected JSON before Set")
+	c.Set(tc.value)
+	assert.Equal(t, Of(tc.value), c.Optional(), "unexpected Optional")
+	data, err = c.MarshalJSON()
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expectAfter, string(data), "unexpected JSON after Set")
+}
+
+func TestCachedJSON_Set(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int CachedJSON given zero value": cachedJSONSetTC[int]{
+			opt:          Empty[int](),
+			value:        0,
+			expectBefore: "null",
+			expectAfter:  "0",
+		},
+		"on non-empty int CachedJSON given non-zero value": cachedJSONSetTC[int]{
+			opt:          Of(123),
+			value:        456,
+			expectBefore: "123",
+			expectAfter:  "456",
+		},
+		"on empty string CachedJSON given zero value": cachedJSONSetTC[string]{
+			opt:          Empty[string](),
+			value:        "",
+			expectBefore: "null",
+			expectAfter:  `""`,
+		},
+		"on non-empty string CachedJSON given non-zero value": cachedJSONSetTC[string]{
+			opt:          Of("abc"),
+			value:        "def",
+			expectBefore: `"abc"`,
+			expectAfter:  `"def"`,
+		},
+		// Other test cases...
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"github.com/neocotic/go-optional/internal/example"
+	"time"
+)
+
+func ExampleExpiring() {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	token := NewExpiring[string](func() time.Time {
+		return now
+	})
+
+	example.Print(token.Get())
+
+	token.Set("abc", time.Hour)
+	example.Print(token.Get())
+
+	now = now.Add(2 * time.Hour)
+	example.Print(token.Get())
+
+	// Output:
+	// <empty>
+	// "abc"
+	// <empty>
+}
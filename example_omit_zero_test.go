@@ -0,0 +1,53 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func ExampleOmitZero_IsZero() {
+	fmt.Println(OmitZero[int]{}.IsZero())
+	fmt.Println(OmitZero[int](Of(0)).IsZero())
+	fmt.Println(OmitZero[int](Of(123)).IsZero())
+
+	// Output:
+	// true
+	// true
+	// false
+}
+
+func ExampleOmitZero_MarshalJSON() {
+	for _, opt := range []OmitZero[int]{{}, OmitZero[int](Of(0)), OmitZero[int](Of(123))} {
+		data, err := json.Marshal(opt)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(data))
+	}
+
+	// Output:
+	// null
+	// null
+	// 123
+}
@@ -21,6 +21,8 @@
 package optional
 
 import (
+	"bufio"
+	"cmp"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -31,8 +33,17 @@ import (
 	ptrs "github.com/neocotic/go-pointers"
 	"gopkg.in/yaml.v3"
 	"log"
+	"maps"
+	"math"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 	"unicode"
 )
 
@@ -41,6 +52,163 @@ var (
 	db  *sql.DB
 )
 
+func ExampleOptional_AddQuery() {
+	values := url.Values{}
+	Empty[int]().AddQuery(values, "limit")
+	Of(0).AddQuery(values, "offset")
+	Of("abc").AddQuery(values, "q")
+
+	fmt.Println(values.Encode())
+
+	// Output: offset=0&q=abc
+}
+
+func ExampleOptional_AssignTo_int() {
+	value := -1
+
+	assigned := Empty[int]().AssignTo(&value)
+	fmt.Println(assigned, value)
+	assigned = Of(0).AssignTo(&value)
+	fmt.Println(assigned, value)
+	assigned = Of(123).AssignTo(&value)
+	fmt.Println(assigned, value)
+
+	// Output:
+	// false -1
+	// true 0
+	// true 123
+}
+
+func ExampleOptional_AssignTo_string() {
+	value := "xyz"
+
+	assigned := Empty[string]().AssignTo(&value)
+	fmt.Printf("%v %q\n", assigned, value)
+	assigned = Of("").AssignTo(&value)
+	fmt.Printf("%v %q\n", assigned, value)
+	assigned = Of("abc").AssignTo(&value)
+	fmt.Printf("%v %q\n", assigned, value)
+
+	// Output:
+	// false "xyz"
+	// true ""
+	// true "abc"
+}
+
+func ExampleOptional_CSVField_int() {
+	fmt.Printf("%q\n", Empty[int]().CSVField())
+	fmt.Printf("%q\n", Of(0).CSVField())
+	fmt.Printf("%q\n", Of(123).CSVField())
+
+	// Output:
+	// ""
+	// "0"
+	// "123"
+}
+
+func ExampleOptional_CSVField_string() {
+	fmt.Printf("%q\n", Empty[string]().CSVField())
+	fmt.Printf("%q\n", Of("").CSVField())
+	fmt.Printf("%q\n", Of("abc").CSVField())
+
+	// Output:
+	// ""
+	// ""
+	// "abc"
+}
+
+func ExampleOptional_Chan_int() {
+	for value := range Empty[int]().Chan() {
+		example.PrintValue(value)
+	}
+	for value := range Of(0).Chan() {
+		example.PrintValue(value)
+	}
+	for value := range Of(123).Chan() {
+		example.PrintValue(value)
+	}
+
+	// Output:
+	// 0
+	// 123
+}
+
+func ExampleOptional_Chan_string() {
+	for value := range Empty[string]().Chan() {
+		example.PrintValue(value)
+	}
+	for value := range Of("").Chan() {
+		example.PrintValue(value)
+	}
+	for value := range Of("abc").Chan() {
+		example.PrintValue(value)
+	}
+
+	// Output:
+	// ""
+	// "abc"
+}
+
+func ExampleOptional_Close() {
+	closeFile := func(name string) error {
+		fmt.Printf("closing %q\n", name)
+		return nil
+	}
+
+	fmt.Println(Empty[string]().Close(closeFile))
+	fmt.Println(Of("example.txt").Close(closeFile))
+
+	// Output:
+	// <nil>
+	// closing "example.txt"
+	// <nil>
+}
+
+func ExampleOptional_Display_int() {
+	currency := func(value int) string {
+		return fmt.Sprintf("$%d.%02d", value/100, value%100)
+	}
+
+	fmt.Println(Empty[int]().Display(currency, "N/A"))
+	fmt.Println(Of(0).Display(currency, "N/A"))
+	fmt.Println(Of(12345).Display(currency, "N/A"))
+
+	// Output:
+	// N/A
+	// $0.00
+	// $123.45
+}
+
+func ExampleOptional_Display_string() {
+	quote := func(value string) string {
+		return "'" + value + "'"
+	}
+
+	fmt.Println(Empty[string]().Display(quote, "N/A"))
+	fmt.Println(Of("").Display(quote, "N/A"))
+	fmt.Println(Of("abc").Display(quote, "N/A"))
+
+	// Output:
+	// N/A
+	// ''
+	// 'abc'
+}
+
+func ExampleOptional_EnvLine() {
+	var b strings.Builder
+	b.WriteString(Of(8080).EnvLine("PORT"))
+	b.WriteString(Empty[string]().EnvLine("HOST"))
+	b.WriteString(Of("").EnvLine("USER"))
+	b.WriteString(Of("my app").EnvLine("NAME"))
+
+	fmt.Print(b.String())
+
+	// Output:
+	// PORT=8080
+	// USER=
+	// NAME="my app"
+}
+
 func ExampleOptional_Equal_int() {
 	fmt.Println(Empty[int]().Equal(Empty[int]()))
 	fmt.Println(Empty[int]().Equal(Of(0)))
@@ -87,6 +255,27 @@ func ExampleOptional_Equal_string() {
 	// false
 }
 
+func ExampleOptional_FillGet() {
+	cache := make(map[string]Optional[int])
+	compute := func() int {
+		fmt.Println("computing...")
+		return 123
+	}
+
+	value, filled := cache["abc"].FillGet(compute)
+	cache["abc"] = filled
+	example.PrintValue(value)
+
+	value, filled = cache["abc"].FillGet(compute)
+	cache["abc"] = filled
+	example.PrintValue(value)
+
+	// Output:
+	// computing...
+	// 123
+	// 123
+}
+
 func ExampleOptional_Filter_int() {
 	isPos := func(value int) bool {
 		return value >= 0
@@ -121,6 +310,49 @@ func ExampleOptional_Filter_string() {
 	// "abc"
 }
 
+func ExampleOptional_Frozen() {
+	opt := Of(123)
+	frozen := opt.Frozen()
+	_ = opt.Scan(nil)
+
+	example.Print(opt)
+	example.Print(frozen)
+
+	// Output:
+	// <empty>
+	// 123
+}
+
+func ExampleOptional_GaugeValue_int() {
+	valueFn := func(value int) float64 {
+		return float64(value)
+	}
+
+	example.PrintGet(Empty[int]().GaugeValue(valueFn))
+	example.PrintGet(Of(0).GaugeValue(valueFn))
+	example.PrintGet(Of(123).GaugeValue(valueFn))
+
+	// Output:
+	// 0 false
+	// 0 true
+	// 123 true
+}
+
+func ExampleOptional_GaugeValue_string() {
+	valueFn := func(value string) float64 {
+		return float64(len(value))
+	}
+
+	example.PrintGet(Empty[string]().GaugeValue(valueFn))
+	example.PrintGet(Of("").GaugeValue(valueFn))
+	example.PrintGet(Of("abc").GaugeValue(valueFn))
+
+	// Output:
+	// 0 false
+	// 0 true
+	// 3 true
+}
+
 func ExampleOptional_Get_int() {
 	example.PrintGet(Empty[int]().Get())
 	example.PrintGet(Of(0).Get())
@@ -143,6 +375,90 @@ func ExampleOptional_Get_string() {
 	// "abc" true
 }
 
+func ExampleOptional_GetOrNotFound_int() {
+	example.PrintTryValue(Empty[int]().GetOrNotFound())
+	example.PrintTryValue(Of(0).GetOrNotFound())
+	example.PrintTryValue(Of(123).GetOrNotFound())
+
+	// Output:
+	// 0 "go-optional: value not present"
+	// 0 <nil>
+	// 123 <nil>
+}
+
+func ExampleOptional_GetOrNotFound_string() {
+	example.PrintTryValue(Empty[string]().GetOrNotFound())
+	example.PrintTryValue(Of("").GetOrNotFound())
+	example.PrintTryValue(Of("abc").GetOrNotFound())
+
+	// Output:
+	// "" "go-optional: value not present"
+	// "" <nil>
+	// "abc" <nil>
+}
+
+func ExampleOptional_GetValid_int() {
+	isPos := func(value int) error {
+		if value < 0 {
+			return errors.New("negative")
+		}
+		return nil
+	}
+
+	example.PrintTryValue(Empty[int]().GetValid(isPos))
+	example.PrintTryValue(Of(-123).GetValid(isPos))
+	example.PrintTryValue(Of(0).GetValid(isPos))
+	example.PrintTryValue(Of(123).GetValid(isPos))
+
+	// Output:
+	// 0 "go-optional: value not present"
+	// 0 "negative"
+	// 0 <nil>
+	// 123 <nil>
+}
+
+func ExampleOptional_GetValid_string() {
+	isLower := func(value string) error {
+		if strings.ContainsFunc(value, unicode.IsUpper) {
+			return errors.New("uppercase")
+		}
+		return nil
+	}
+
+	example.PrintTryValue(Empty[string]().GetValid(isLower))
+	example.PrintTryValue(Of("ABC").GetValid(isLower))
+	example.PrintTryValue(Of("").GetValid(isLower))
+	example.PrintTryValue(Of("abc").GetValid(isLower))
+
+	// Output:
+	// "" "go-optional: value not present"
+	// "" "uppercase"
+	// "" <nil>
+	// "abc" <nil>
+}
+
+func ExampleOptional_If_int() {
+	example.PrintGet(Empty[int]().If())
+	example.PrintGet(Of(0).If())
+	example.PrintGet(Of(123).If())
+
+	// Output:
+	// 0 false
+	// 0 true
+	// 123 true
+}
+
+func ExampleOptional_If_string() {
+	example.PrintGet(Empty[string]().If())
+	example.PrintGet(Of("").If())
+	example.PrintGet(Of("abc").If())
+
+	// Output:
+	// "" false
+	// "" true
+	// "abc" true
+}
+
 func ExampleOptional_IfPresent_int() {
 	Empty[int]().IfPresent(example.PrintValue[int]) // Does nothing
 	Of(0).IfPresent(example.PrintValue[int])
@@ -163,6 +479,36 @@ func ExampleOptional_IfPresent_string() {
 	// "abc"
 }
 
+func ExampleOptional_IfPresentOrElse_int() {
+	emptyFn := func() {
+		fmt.Println("<empty>")
+	}
+
+	Empty[int]().IfPresentOrElse(example.PrintValue[int], emptyFn)
+	Of(0).IfPresentOrElse(example.PrintValue[int], emptyFn)
+	Of(123).IfPresentOrElse(example.PrintValue[int], emptyFn)
+
+	// Output:
+	// <empty>
+	// 0
+	// 123
+}
+
+func ExampleOptional_IfPresentOrElse_string() {
+	emptyFn := func() {
+		fmt.Println("<empty>")
+	}
+
+	Empty[string]().IfPresentOrElse(example.PrintValue[string], emptyFn)
+	Of("").IfPresentOrElse(example.PrintValue[string], emptyFn)
+	Of("abc").IfPresentOrElse(example.PrintValue[string], emptyFn)
+
+	// Output:
+	// <empty>
+	// ""
+	// "abc"
+}
+
 func ExampleOptional_IsEmpty_int() {
 	fmt.Println(Empty[int]().IsEmpty())
 	fmt.Println(Of(0).IsEmpty())
@@ -229,6 +575,65 @@ func ExampleOptional_IsZero_string() {
 	// false
 }
 
+func ExampleOptional_JSONStringOr_int() {
+	fmt.Println(Empty[int]().JSONStringOr("-"))
+	fmt.Println(Of(0).JSONStringOr("-"))
+	fmt.Println(Of(123).JSONStringOr("-"))
+
+	// Output:
+	// -
+	// 0
+	// 123
+}
+
+func ExampleOptional_JSONStringOr_string() {
+	fmt.Println(Empty[string]().JSONStringOr("-"))
+	fmt.Println(Of("").JSONStringOr("-"))
+	fmt.Println(Of("abc").JSONStringOr("-"))
+
+	// Output:
+	// -
+	// ""
+	// "abc"
+}
+
+func ExampleOptional_MapIf_int() {
+	isNeg := func(value int) bool {
+		return value < 0
+	}
+	negate := func(value int) int {
+		return -value
+	}
+
+	example.Print(Empty[int]().MapIf(isNeg, negate))
+	example.Print(Of(0).MapIf(isNeg, negate))
+	example.Print(Of(123).MapIf(isNeg, negate))
+	example.Print(Of(-123).MapIf(isNeg, negate))
+
+	// Output:
+	// <empty>
+	// 0
+	// 123
+	// 123
+}
+
+func ExampleOptional_MapIf_string() {
+	isUpper := func(value string) bool {
+		return value != "" && strings.ToUpper(value) == value
+	}
+
+	example.Print(Empty[string]().MapIf(isUpper, strings.ToLower))
+	example.Print(Of("").MapIf(isUpper, strings.ToLower))
+	example.Print(Of("abc").MapIf(isUpper, strings.ToLower))
+	example.Print(Of("ABC").MapIf(isUpper, strings.ToLower))
+
+	// Output:
+	// <empty>
+	// ""
+	// "abc"
+	// "abc"
+}
+
 func ExampleOptional_MarshalJSON() {
 	// json omitempty option does not apply to zero value structs
 	type MyStruct struct {
@@ -296,6 +701,54 @@ func ExampleOptional_MarshalYAML() {
 	// text: abc <nil>
 }
 
+func ExampleOptional_Or_int() {
+	example.Print(Empty[int]().Or(Empty[int]()))
+	example.Print(Empty[int]().Or(Of(-1)))
+	example.Print(Of(0).Or(Of(-1)))
+	example.Print(Of(123).Or(Of(-1)))
+
+	// Output:
+	// <empty>
+	// -1
+	// 0
+	// 123
+}
+
+func ExampleOptional_Or_string() {
+	example.Print(Empty[string]().Or(Empty[string]()))
+	example.Print(Empty[string]().Or(Of("unknown")))
+	example.Print(Of("").Or(Of("unknown")))
+	example.Print(Of("abc").Or(Of("unknown")))
+
+	// Output:
+	// <empty>
+	// "unknown"
+	// ""
+	// "abc"
+}
+
+func ExampleOptional_OrDefaultIfZero_int() {
+	example.PrintValue(Empty[int]().OrDefaultIfZero(-1))
+	example.PrintValue(Of(0).OrDefaultIfZero(-1))
+	example.PrintValue(Of(123).OrDefaultIfZero(-1))
+
+	// Output:
+	// -1
+	// -1
+	// 123
+}
+
+func ExampleOptional_OrDefaultIfZero_string() {
+	example.PrintValue(Empty[string]().OrDefaultIfZero("unknown"))
+	example.PrintValue(Of("").OrDefaultIfZero("unknown"))
+	example.PrintValue(Of("abc").OrDefaultIfZero("unknown"))
+
+	// Output:
+	// "unknown"
+	// "unknown"
+	// "abc"
+}
+
 func ExampleOptional_OrElse_int() {
 	defaultVal := -1
 
@@ -322,6 +775,27 @@ func ExampleOptional_OrElse_string() {
 	// "abc"
 }
 
+func ExampleOptional_OrElseChain() {
+	source := func(name string, opt Optional[int]) func() Optional[int] {
+		return func() Optional[int] {
+			fmt.Printf("checking %s\n", name)
+			return opt
+		}
+	}
+
+	example.Print(Empty[int]().OrElseChain(source("env", Empty[int]()), source("file", Of(123)), source("remote", Of(-123))))
+	example.Print(Of(0).OrElseChain(source("env", Of(123))))
+	example.Print(Empty[int]().OrElseChain(source("env", Empty[int]())))
+
+	// Output:
+	// checking env
+	// checking file
+	// 123
+	// 0
+	// checking env
+	// <empty>
+}
+
 func ExampleOptional_OrElseGet_int() {
 	defaultFunc := func() int {
 		return -1
@@ -352,25 +826,108 @@ func ExampleOptional_OrElseGet_string() {
 	// "abc"
 }
 
-func ExampleOptional_OrElseTryGet_int() {
+func ExampleOptional_OrElseHandle() {
+	var defaultUsed bool
 	defaultFunc := func() (int, error) {
+		if defaultUsed {
+			return 0, errors.New("default already used")
+		}
+		defaultUsed = true
 		return -1, nil
 	}
+	fallbackFunc := func(err error) int {
+		fmt.Printf("falling back: %v\n", err)
+		return -2
+	}
 
-	example.PrintTryValue(Empty[int]().OrElseTryGet(defaultFunc))
-	example.PrintTryValue(Of(0).OrElseTryGet(defaultFunc))
-	example.PrintTryValue(Of(123).OrElseTryGet(defaultFunc))
+	example.PrintValue(Of(123).OrElseHandle(defaultFunc, fallbackFunc))
+	example.PrintValue(Empty[int]().OrElseHandle(defaultFunc, fallbackFunc))
+	example.PrintValue(Empty[int]().OrElseHandle(defaultFunc, fallbackFunc))
+
+	// Output:
+	// 123
+	// -1
+	// falling back: default already used
+	// -2
+}
+
+func ExampleOptional_OrElseJSON_int() {
+	defaultJSON := []byte("-1")
+
+	example.PrintTryValue(Empty[int]().OrElseJSON(defaultJSON))
+	example.PrintTryValue(Of(0).OrElseJSON(defaultJSON))
+	example.PrintTryValue(Of(123).OrElseJSON(defaultJSON))
+	example.PrintTryValue(Empty[int]().OrElseJSON([]byte(`"abc"`)))
 
 	// Output:
 	// -1 <nil>
 	// 0 <nil>
 	// 123 <nil>
+	// 0 "json: cannot unmarshal string into Go value of type int"
 }
 
-func ExampleOptional_OrElseTryGet_string() {
-	var defaultStringUsed bool
-	defaultFunc := func() (string, error) {
-		if defaultStringUsed {
+func ExampleOptional_OrElseJSON_string() {
+	defaultJSON := []byte(`"unknown"`)
+
+	example.PrintTryValue(Empty[string]().OrElseJSON(defaultJSON))
+	example.PrintTryValue(Of("").OrElseJSON(defaultJSON))
+	example.PrintTryValue(Of("abc").OrElseJSON(defaultJSON))
+
+	// Output:
+	// "unknown" <nil>
+	// "" <nil>
+	// "abc" <nil>
+}
+
+func ExampleOptional_OrElseKey_int() {
+	defaults := map[string]int{"port": 8080}
+
+	example.PrintValue(Empty[int]().OrElseKey("port", defaults))
+	example.PrintValue(Empty[int]().OrElseKey("timeout", defaults))
+	example.PrintValue(Of(0).OrElseKey("port", defaults))
+	example.PrintValue(Of(123).OrElseKey("port", defaults))
+
+	// Output:
+	// 8080
+	// 0
+	// 0
+	// 123
+}
+
+func ExampleOptional_OrElseKey_string() {
+	defaults := map[string]string{"host": "localhost"}
+
+	example.PrintValue(Empty[string]().OrElseKey("host", defaults))
+	example.PrintValue(Empty[string]().OrElseKey("user", defaults))
+	example.PrintValue(Of("").OrElseKey("host", defaults))
+	example.PrintValue(Of("example.com").OrElseKey("host", defaults))
+
+	// Output:
+	// "localhost"
+	// ""
+	// ""
+	// "example.com"
+}
+
+func ExampleOptional_OrElseTryGet_int() {
+	defaultFunc := func() (int, error) {
+		return -1, nil
+	}
+
+	example.PrintTryValue(Empty[int]().OrElseTryGet(defaultFunc))
+	example.PrintTryValue(Of(0).OrElseTryGet(defaultFunc))
+	example.PrintTryValue(Of(123).OrElseTryGet(defaultFunc))
+
+	// Output:
+	// -1 <nil>
+	// 0 <nil>
+	// 123 <nil>
+}
+
+func ExampleOptional_OrElseTryGet_string() {
+	var defaultStringUsed bool
+	defaultFunc := func() (string, error) {
+		if defaultStringUsed {
 			return "", errors.New("default string already used")
 		}
 		defaultStringUsed = true
@@ -389,6 +946,105 @@ func ExampleOptional_OrElseTryGet_string() {
 	// "" "default string already used"
 }
 
+func ExampleOptional_OrderKey() {
+	opts := []Optional[int]{Of(123), Empty[int](), Of(0), Empty[int](), Of(-123)}
+	slices.SortFunc(opts, func(x, y Optional[int]) int {
+		xEmpty, xValue := x.OrderKey()
+		yEmpty, yValue := y.OrderKey()
+		if xEmpty != yEmpty {
+			if xEmpty {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(xValue, yValue)
+	})
+
+	fmt.Println(opts)
+
+	// Output: [-123 0 123 <empty> <empty>]
+}
+
+func ExampleOptional_Pair_int() {
+	example.PrintGet(Empty[int]().Pair())
+	example.PrintGet(Of(0).Pair())
+	example.PrintGet(Of(123).Pair())
+
+	// Output:
+	// 0 false
+	// 0 true
+	// 123 true
+}
+
+func ExampleOptional_Pair_string() {
+	example.PrintGet(Empty[string]().Pair())
+	example.PrintGet(Of("").Pair())
+	example.PrintGet(Of("abc").Pair())
+
+	// Output:
+	// "" false
+	// "" true
+	// "abc" true
+}
+
+func ExampleOptional_PresenceInt() {
+	fmt.Println(Empty[int]().PresenceInt())
+	fmt.Println(Of(0).PresenceInt())
+	fmt.Println(Of("abc").PresenceInt())
+
+	fmt.Println(Of(0).PresenceInt() + Empty[string]().PresenceInt() + Of("abc").PresenceInt())
+
+	// Output:
+	// 0
+	// 1
+	// 1
+	// 2
+}
+
+func ExampleOptional_ProtoPtr_int() {
+	example.PrintValue(Empty[int]().ProtoPtr())
+	example.PrintValue(Of(0).ProtoPtr())
+	example.PrintValue(Of(123).ProtoPtr())
+
+	// Output:
+	// <nil>
+	// &0
+	// &123
+}
+
+func ExampleOptional_ProtoPtr_string() {
+	example.PrintValue(Empty[string]().ProtoPtr())
+	example.PrintValue(Of("").ProtoPtr())
+	example.PrintValue(Of("abc").ProtoPtr())
+
+	// Output:
+	// <nil>
+	// &""
+	// &"abc"
+}
+
+func ExampleOptional_Quoted_int() {
+	fmt.Println(Empty[int]().Quoted())
+	fmt.Println(Of(0).Quoted())
+	fmt.Println(Of(123).Quoted())
+
+	// Output:
+	// <empty>
+	// 0
+	// 123
+}
+
+func ExampleOptional_Quoted_string() {
+	fmt.Println(Empty[string]().Quoted())
+	fmt.Println(Of("").Quoted())
+	fmt.Println(Of(" abc ").Quoted())
+
+	// Output:
+	// <empty>
+	// ""
+	// " abc "
+}
+
 func ExampleOptional_Require_int() {
 	example.PrintValue(Of(0).Require())
 	example.PrintValue(Of(123).Require())
@@ -417,6 +1073,70 @@ func ExampleOptional_Require_string() {
 	// "abc"
 }
 
+func ExampleOptional_RequireNoError_int() {
+	example.PrintValue(Of(0).RequireNoError(nil))
+	example.PrintValue(Of(123).RequireNoError(nil))
+
+	// Output:
+	// 0
+	// 123
+}
+
+func ExampleOptional_RequireNoError_panic() {
+	defer func() {
+		fmt.Println(recover())
+	}()
+
+	opt, err := TryMap(Of("abc"), strconv.Atoi)
+	opt.RequireNoError(err)
+
+	// Output: strconv.Atoi: parsing "abc": invalid syntax
+}
+
+func ExampleOptional_RequireNoError_string() {
+	example.PrintValue(Of("").RequireNoError(nil))
+	example.PrintValue(Of("abc").RequireNoError(nil))
+
+	// Output:
+	// ""
+	// "abc"
+}
+
+func ExampleOptional_Sanitize_int() {
+	example.Print(Empty[int]().Sanitize())
+	example.Print(Of(0).Sanitize())
+	example.Print(Of(123).Sanitize())
+
+	// Output:
+	// <empty>
+	// <empty>
+	// 123
+}
+
+func ExampleOptional_Sanitize_intPointer() {
+	example.Print(Empty[*int]().Sanitize())
+	example.Print(Of[*int](nil).Sanitize())
+	example.Print(Of(ptrs.ZeroInt()).Sanitize())
+	example.Print(Of(ptrs.Int(123)).Sanitize())
+
+	// Output:
+	// <empty>
+	// <empty>
+	// &0
+	// &123
+}
+
+func ExampleOptional_Sanitize_string() {
+	example.Print(Empty[string]().Sanitize())
+	example.Print(Of("").Sanitize())
+	example.Print(Of("abc").Sanitize())
+
+	// Output:
+	// <empty>
+	// <empty>
+	// "abc"
+}
+
 func ExampleOptional_Scan() {
 	rows, err := db.QueryContext(ctx, "SELECT name, age FROM users")
 	if err != nil {
@@ -442,6 +1162,26 @@ func ExampleOptional_Scan() {
 	log.Printf("user demographics: %s", users)
 }
 
+func ExampleOptional_State() {
+	describe := func(opt Optional[int]) {
+		switch opt.State() {
+		case Absent:
+			fmt.Println("absent")
+		case Present:
+			fmt.Println("present:", opt.OrElse(-1))
+		}
+	}
+
+	describe(Empty[int]())
+	describe(Of(0))
+	describe(Of(123))
+
+	// Output:
+	// absent
+	// present: 0
+	// present: 123
+}
+
 func ExampleOptional_String_int() {
 	fmt.Printf("%q\n", Empty[int]().String())
 	fmt.Printf("%q\n", Of(0).String())
@@ -464,6 +1204,112 @@ func ExampleOptional_String_string() {
 	// "abc"
 }
 
+func ExampleOptional_Stringer_int() {
+	fmt.Printf("%q\n", Empty[int]().Stringer())
+	fmt.Printf("%q\n", Of(0).Stringer())
+	fmt.Printf("%q\n", Of(123).Stringer())
+
+	// Output:
+	// "<empty>"
+	// "0"
+	// "123"
+}
+
+func ExampleOptional_Stringer_string() {
+	fmt.Printf("%q\n", Empty[string]().Stringer())
+	fmt.Printf("%q\n", Of("").Stringer())
+	fmt.Printf("%q\n", Of("abc").Stringer())
+
+	// Output:
+	// "<empty>"
+	// ""
+	// "abc"
+}
+
+func ExampleOptional_Tap_int() {
+	empty := func() {
+		fmt.Println("empty")
+	}
+
+	example.Print(Empty[int]().Tap(example.PrintValue[int], empty))
+	example.Print(Of(0).Tap(example.PrintValue[int], empty))
+	example.Print(Of(123).Tap(example.PrintValue[int], empty))
+
+	// Output:
+	// empty
+	// <empty>
+	// 0
+	// 0
+	// 123
+	// 123
+}
+
+func ExampleOptional_Tap_string() {
+	empty := func() {
+		fmt.Println("empty")
+	}
+
+	example.Print(Empty[string]().Tap(example.PrintValue[string], empty))
+	example.Print(Of("").Tap(example.PrintValue[string], empty))
+	example.Print(Of("abc").Tap(example.PrintValue[string], empty))
+
+	// Output:
+	// empty
+	// <empty>
+	// ""
+	// ""
+	// "abc"
+	// "abc"
+}
+
+func ExampleOptional_ToErrgroupFunc() {
+	var result struct {
+		Count Optional[int]
+		Name  Optional[string]
+	}
+
+	var wg sync.WaitGroup
+	var countErr, nameErr error
+	countFn := result.Count.ToErrgroupFunc(func() (int, error) {
+		return 123, nil
+	})
+	nameFn := result.Name.ToErrgroupFunc(func() (string, error) {
+		return "", errors.New("name unavailable")
+	})
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		countErr = countFn()
+	}()
+	go func() {
+		defer wg.Done()
+		nameErr = nameFn()
+	}()
+	wg.Wait()
+
+	example.Print(result.Count)
+	fmt.Println(countErr)
+	example.Print(result.Name)
+	fmt.Println(nameErr)
+
+	// Output:
+	// 123
+	// <nil>
+	// <empty>
+	// name unavailable
+}
+
+func ExampleOptional_ToMap() {
+	settings := map[string]int{"port": 8080}
+	maps.Copy(settings, Empty[int]().ToMap("timeout"))
+	maps.Copy(settings, Of(0).ToMap("retries"))
+	maps.Copy(settings, Of(443).ToMap("port"))
+
+	fmt.Println(settings)
+
+	// Output: map[port:443 retries:0]
+}
+
 func ExampleOptional_UnmarshalJSON() {
 	type MyStruct struct {
 		Number Optional[int]    `json:"number"`
@@ -582,6 +1428,204 @@ func ExampleOptional_Value() {
 	}
 }
 
+func ExampleOptional_WriteToBuilder() {
+	var b strings.Builder
+	Of("abc").WriteToBuilder(&b, ", ")
+	Empty[string]().WriteToBuilder(&b, ", ")
+	Of("").WriteToBuilder(&b, ", ")
+	Of("def").WriteToBuilder(&b, ", ")
+
+	fmt.Printf("%q\n", b.String())
+
+	// Output: "abc, , def"
+}
+
+func ExampleAppendPresent_int() {
+	var values []int
+	AppendPresent(&values, Empty[int](), Of(0), Of(123))
+	AppendPresent(&values, Of(456), Empty[int]())
+
+	example.PrintValues(values)
+
+	// Output: [0 123 456]
+}
+
+func ExampleAppendPresent_string() {
+	var values []string
+	AppendPresent(&values, Empty[string](), Of("abc"), Of(""))
+	AppendPresent(&values, Of("def"), Empty[string]())
+
+	example.PrintValues(values)
+
+	// Output: ["abc" "" "def"]
+}
+
+func ExampleAsError() {
+	fmt.Println(AsError(Empty[error]()))
+	fmt.Println(AsError(Of[error](nil)))
+	fmt.Println(AsError(Of(errors.New("failed"))))
+
+	// Output:
+	// <nil>
+	// <nil>
+	// failed
+}
+
+func ExampleAt_int() {
+	s := []int{0, 123}
+
+	example.Print(At([]int(nil), 0))
+	example.Print(At(s, 0))
+	example.Print(At(s, 1))
+	example.Print(At(s, 2))
+	example.Print(At(s, -1))
+
+	// Output:
+	// <empty>
+	// 0
+	// 123
+	// <empty>
+	// <empty>
+}
+
+func ExampleAt_string() {
+	s := []string{"abc", ""}
+
+	example.Print(At([]string(nil), 0))
+	example.Print(At(s, 0))
+	example.Print(At(s, 1))
+	example.Print(At(s, 2))
+	example.Print(At(s, -1))
+
+	// Output:
+	// <empty>
+	// "abc"
+	// ""
+	// <empty>
+	// <empty>
+}
+
+func ExampleAtLeast_int() {
+	fmt.Println(AtLeast(Empty[int](), 100))
+	fmt.Println(AtLeast(Of(0), 100))
+	fmt.Println(AtLeast(Of(123), 100))
+
+	// Output:
+	// 100
+	// 100
+	// 123
+}
+
+func ExampleAtLeast_string() {
+	fmt.Println(AtLeast(Empty[string](), "b"))
+	fmt.Println(AtLeast(Of("abc"), "b"))
+	fmt.Println(AtLeast(Of("xyz"), "b"))
+
+	// Output:
+	// b
+	// b
+	// xyz
+}
+
+func ExampleBucket() {
+	bounds := []int{10, 100}
+	labels := []string{"low", "medium", "high"}
+
+	example.Print(Bucket(Empty[int](), bounds, labels))
+	example.Print(Bucket(Of(5), bounds, labels))
+	example.Print(Bucket(Of(10), bounds, labels))
+	example.Print(Bucket(Of(100), bounds, labels))
+
+	// Output:
+	// <empty>
+	// "low"
+	// "medium"
+	// "high"
+}
+
+func ExampleChangedFields() {
+	before := map[string]Optional[string]{
+		"email": Of("alasdair@example.com"),
+		"name":  Of("Alasdair"),
+		"role":  Of("admin"),
+	}
+	after := map[string]Optional[string]{
+		"email": Of("alasdair@example.org"),
+		"name":  Of("Alasdair"),
+		"team":  Of("platform"),
+	}
+
+	fmt.Println(ChangedFields(before, after))
+
+	// Output: map[email:alasdair@example.org role:<empty> team:platform]
+}
+
+func ExampleClamp_int() {
+	example.Print(Clamp(Empty[int](), 0, 100))
+	example.Print(Clamp(Of(-123), 0, 100))
+	example.Print(Clamp(Of(50), 0, 100))
+	example.Print(Clamp(Of(123), 0, 100))
+
+	// Output:
+	// <empty>
+	// 0
+	// 50
+	// 100
+}
+
+func ExampleClamp_string() {
+	example.Print(Clamp(Empty[string](), "b", "d"))
+	example.Print(Clamp(Of("abc"), "b", "d"))
+	example.Print(Clamp(Of("cde"), "b", "d"))
+	example.Print(Clamp(Of("xyz"), "b", "d"))
+
+	// Output:
+	// <empty>
+	// "b"
+	// "cde"
+	// "d"
+}
+
+func ExampleCollectMap_int() {
+	example.Print(CollectMap[string, int](nil))
+	example.Print(CollectMap(map[string]Optional[int]{"a": Of(0), "b": Of(123), "c": Of(-123)}))
+	example.Print(CollectMap(map[string]Optional[int]{"a": Of(0), "b": Empty[int](), "c": Of(-123)}))
+
+	// Output:
+	// map[]
+	// map[a:0 b:123 c:-123]
+	// <empty>
+}
+
+func ExampleCollectMap_string() {
+	example.Print(CollectMap(map[string]Optional[string]{"a": Of(""), "b": Of("abc")}))
+	example.Print(CollectMap(map[string]Optional[string]{"a": Empty[string](), "b": Of("abc")}))
+
+	// Output:
+	// map[a: b:abc]
+	// <empty>
+}
+
+func ExampleCollectResults_int() {
+	values := []int{0, 123, -123}
+	errs := []error{nil, errors.New("failed"), nil}
+
+	example.PrintSlice(CollectResults(values, errs))
+	fmt.Println()
+
+	// Output: [0 <empty> -123]
+}
+
+func ExampleCollectResults_string() {
+	values := []string{"", "abc", "ABC"}
+	errs := []error{nil, errors.New("failed"), nil}
+
+	example.PrintSlice(CollectResults(values, errs))
+	fmt.Println()
+
+	// Output: ["" <empty> "ABC"]
+}
+
 func ExampleCompare_int() {
 	fmt.Println(Compare(Empty[int](), Of(0)))
 	fmt.Println(Compare(Of(0), Of(123)))
@@ -624,6 +1668,79 @@ func ExampleCompare_string() {
 	// 1
 }
 
+func ExampleCompareSlices_int() {
+	fmt.Println(CompareSlices([]Optional[int]{Empty[int](), Of(123)}, []Optional[int]{Of(0)}))
+	fmt.Println(CompareSlices([]Optional[int]{Of(0)}, []Optional[int]{Of(0), Empty[int]()}))
+
+	fmt.Println(CompareSlices([]Optional[int]{Of(0), Empty[int]()}, []Optional[int]{Of(0), Empty[int]()}))
+
+	fmt.Println(CompareSlices([]Optional[int]{Of(0), Of(123)}, []Optional[int]{Of(0), Empty[int]()}))
+	fmt.Println(CompareSlices([]Optional[int]{Of(123)}, []Optional[int]{Of(0), Of(123)}))
+
+	// Output:
+	// -1
+	// -1
+	// 0
+	// 1
+	// 1
+}
+
+func ExampleCompareSlices_string() {
+	fmt.Println(CompareSlices([]Optional[string]{Empty[string](), Of("abc")}, []Optional[string]{Of("")}))
+	fmt.Println(CompareSlices([]Optional[string]{Of("")}, []Optional[string]{Of(""), Empty[string]()}))
+
+	fmt.Println(CompareSlices([]Optional[string]{Of(""), Empty[string]()}, []Optional[string]{Of(""), Empty[string]()}))
+
+	fmt.Println(CompareSlices([]Optional[string]{Of(""), Of("abc")}, []Optional[string]{Of(""), Empty[string]()}))
+	fmt.Println(CompareSlices([]Optional[string]{Of("abc")}, []Optional[string]{Of(""), Of("abc")}))
+
+	// Output:
+	// -1
+	// -1
+	// 0
+	// 1
+	// 1
+}
+
+func ExampleConcatPresent_int() {
+	fmt.Println(ConcatPresent[int]())
+	fmt.Println(ConcatPresent(Empty[[]int](), Empty[[]int]()))
+	fmt.Println(ConcatPresent(Of([]int{0, 123}), Empty[[]int](), Of([]int{-123})))
+
+	// Output:
+	// []
+	// []
+	// [0 123 -123]
+}
+
+func ExampleConcatPresent_string() {
+	fmt.Println(ConcatPresent(Of([]string{"abc"}), Empty[[]string](), Of([]string{"def", "ghi"})))
+
+	// Output: [abc def ghi]
+}
+
+func ExampleCountDistinct_int() {
+	fmt.Println(CountDistinct[int]())
+	fmt.Println(CountDistinct(Empty[int]()))
+	fmt.Println(CountDistinct(Empty[int](), Of(0), Of(123), Of(123)))
+
+	// Output:
+	// 0
+	// 0
+	// 2
+}
+
+func ExampleCountDistinct_string() {
+	fmt.Println(CountDistinct[string]())
+	fmt.Println(CountDistinct(Empty[string]()))
+	fmt.Println(CountDistinct(Empty[string](), Of("abc"), Of(""), Of("abc")))
+
+	// Output:
+	// 0
+	// 0
+	// 2
+}
+
 func ExampleEmpty_int() {
 	example.Print(Empty[int]())
 
@@ -697,6 +1814,105 @@ func ExampleEqual_string() {
 	// false
 }
 
+func ExampleEqualDeep() {
+	fmt.Println(EqualDeep(Empty[[]int](), Empty[[]int]()))
+	fmt.Println(EqualDeep(Empty[[]int](), Of([]int(nil))))
+	fmt.Println(EqualDeep(Of([]int(nil)), Of([]int(nil))))
+	fmt.Println(EqualDeep(Of([]int{1, 2, 3}), Of([]int{1, 2, 3})))
+	fmt.Println(EqualDeep(Of([]int{1, 2, 3}), Of([]int{3, 2, 1})))
+
+	// Output:
+	// true
+	// false
+	// true
+	// true
+	// false
+}
+
+func ExampleEqualFloat() {
+	fmt.Println(EqualFloat(Empty[float64](), Empty[float64](), 0.01))
+	fmt.Println(EqualFloat(Empty[float64](), Of(0.0), 0.01))
+	fmt.Println(EqualFloat(Of(1.0), Of(1.005), 0.01))
+	fmt.Println(EqualFloat(Of(1.0), Of(1.02), 0.01))
+	fmt.Println(EqualFloat(Of(math.NaN()), Of(math.NaN()), 0.01))
+
+	// Output:
+	// true
+	// false
+	// true
+	// false
+	// true
+}
+
+func ExampleFieldFillRate() {
+	type User struct {
+		Age  Optional[int]
+		Name Optional[string]
+	}
+
+	users := []User{
+		{Age: Of(30), Name: Of("Alice")},
+		{Name: Of("Bob")},
+		{Age: Of(0), Name: Of("Carol")},
+		{Name: Of("Dave")},
+	}
+
+	fmt.Println(FieldFillRate(users, func(user User) Optional[int] {
+		return user.Age
+	}))
+	fmt.Println(FieldFillRate(users, func(user User) Optional[string] {
+		return user.Name
+	}))
+	fmt.Println(FieldFillRate(nil, func(user User) Optional[int] {
+		return user.Age
+	}))
+
+	// Output:
+	// 0.5
+	// 1
+	// 0
+}
+
+func ExampleFilterMapSlice() {
+	isNonNegative := func(value int) bool {
+		return value >= 0
+	}
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
+	}
+
+	example.PrintSlice(FilterMapSlice([]Optional[int](nil), isNonNegative, toString))
+	fmt.Println()
+	example.PrintSlice(FilterMapSlice([]Optional[int]{Of(-1), Empty[int](), Of(0), Of(123)}, isNonNegative, toString))
+	fmt.Println()
+
+	// Output:
+	// []
+	// [<empty> <empty> "0" "123"]
+}
+
+func ExampleFilterPresent_int() {
+	fmt.Println(FilterPresent[int](nil))
+	fmt.Println(FilterPresent([]Optional[int]{Empty[int]()}))
+	fmt.Println(FilterPresent([]Optional[int]{Of(123), Empty[int](), Of(0)}))
+
+	// Output:
+	// []
+	// []
+	// [123 0]
+}
+
+func ExampleFilterPresent_string() {
+	fmt.Println(FilterPresent[string](nil))
+	fmt.Println(FilterPresent([]Optional[string]{Empty[string]()}))
+	fmt.Println(FilterPresent([]Optional[string]{Empty[string](), Of("abc"), Of("")}))
+
+	// Output:
+	// []
+	// []
+	// [abc ]
+}
+
 func ExampleFind_int() {
 	example.Print(Find[int]())
 	example.Print(Find(Empty[int]()))
@@ -731,60 +1947,244 @@ func ExampleFlatMap_int() {
 		return Of(strconv.FormatInt(int64(value), 10))
 	}
 
-	example.Print(FlatMap(Empty[int](), mapper))
-	example.Print(FlatMap(Of(0), mapper))
-	example.Print(FlatMap(Of(123), mapper))
+	example.Print(FlatMap(Empty[int](), mapper))
+	example.Print(FlatMap(Of(0), mapper))
+	example.Print(FlatMap(Of(123), mapper))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// "123"
+}
+
+func ExampleFlatMap_string() {
+	mapper := func(value string) Optional[int] {
+		if value == "" {
+			return Empty[int]()
+		}
+		i, err := strconv.ParseInt(value, 10, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return OfZeroable(int(i))
+	}
+
+	example.Print(FlatMap(Empty[string](), mapper))
+	example.Print(FlatMap(Of(""), mapper))
+	example.Print(FlatMap(Of("0"), mapper))
+	example.Print(FlatMap(Of("123"), mapper))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// <empty>
+	// 123
+}
+
+func ExampleFromProtoPtr_int() {
+	example.Print(FromProtoPtr[int](nil))
+	example.Print(FromProtoPtr(ptrs.ZeroInt()))
+	example.Print(FromProtoPtr(ptrs.Int(123)))
+
+	// Output:
+	// <empty>
+	// 0
+	// 123
+}
+
+func ExampleFromProtoPtr_string() {
+	example.Print(FromProtoPtr[string](nil))
+	example.Print(FromProtoPtr(ptrs.ZeroString()))
+	example.Print(FromProtoPtr(ptrs.String("abc")))
+
+	// Output:
+	// <empty>
+	// ""
+	// "abc"
+}
+
+func ExampleFuncMap() {
+	type Person struct {
+		Age  Optional[int]
+		Name Optional[string]
+	}
+
+	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(
+		`{{ orElse .Name "unknown" }} ({{ if isPresent .Age }}{{ getOrZero .Age }}{{ else }}?{{ end }})` + "\n",
+	))
+
+	_ = tmpl.Execute(os.Stdout, Person{Age: Of(42), Name: Of("Alasdair")})
+	_ = tmpl.Execute(os.Stdout, Person{})
+
+	// Output:
+	// Alasdair (42)
+	// unknown (?)
+}
+
+func ExampleGetAny_int() {
+	example.PrintValues(GetAny[int]())
+	example.PrintValues(GetAny(Empty[int]()))
+	example.PrintValues(GetAny(Empty[int](), Of(0), Of(123)))
+
+	// Output:
+	// []
+	// []
+	// [0 123]
+}
+
+func ExampleGetAny_string() {
+	example.PrintValues(GetAny[string]())
+	example.PrintValues(GetAny(Empty[string]()))
+	example.PrintValues(GetAny(Empty[string](), Of("abc"), Of("")))
+
+	// Output:
+	// []
+	// []
+	// ["abc" ""]
+}
+
+func ExampleHighestPriority() {
+	type source = struct {
+		Priority int
+		Opt      Optional[string]
+	}
+
+	example.Print(HighestPriority(
+		source{Priority: 1, Opt: Of("config")},
+		source{Priority: 3, Opt: Empty[string]()},
+		source{Priority: 2, Opt: Of("env")},
+	))
+	example.Print(HighestPriority(
+		source{Priority: 1, Opt: Empty[string]()},
+		source{Priority: 2, Opt: Empty[string]()},
+	))
+
+	// Output:
+	// "env"
+	// <empty>
+}
+
+func ExampleIfChanged_int() {
+	example.Print(IfChanged(Empty[int](), 123))
+	example.Print(IfChanged(Of(123), 123))
+	example.Print(IfChanged(Of(0), 123))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// 0
+}
+
+func ExampleIfChanged_string() {
+	example.Print(IfChanged(Empty[string](), "abc"))
+	example.Print(IfChanged(Of("abc"), "abc"))
+	example.Print(IfChanged(Of(""), "abc"))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// ""
+}
+
+func ExampleIndexBy() {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	byID := func(user User) int {
+		return user.ID
+	}
+
+	index := IndexBy(byID, Of(User{1, "Alasdair"}), Empty[User](), Of(User{2, "Brian"}), Of(User{1, "Callum"}))
+
+	fmt.Println(index)
+
+	// Output: map[1:{1 Callum} 2:{2 Brian}]
+}
+
+func ExampleInvert() {
+	var config struct {
+		DisableCache Optional[bool]
+	}
+
+	example.Print(Invert(config.DisableCache, true))
+
+	config.DisableCache = Of(true)
+	example.Print(Invert(config.DisableCache, true))
+
+	// Output:
+	// true
+	// <empty>
+}
+
+func ExampleJSONEqual() {
+	type User struct {
+		Name    string `json:"name"`
+		Session string `json:"-"`
+	}
+
+	example.PrintTryValue(JSONEqual(Empty[User](), Empty[User]()))
+	example.PrintTryValue(JSONEqual(Empty[User](), Of(User{})))
+	example.PrintTryValue(JSONEqual(Of(User{Name: "Alasdair", Session: "abc"}), Of(User{Name: "Alasdair", Session: "def"})))
+	example.PrintTryValue(JSONEqual(Of(User{Name: "Alasdair"}), Of(User{Name: "Brian"})))
 
 	// Output:
-	// <empty>
-	// <empty>
-	// "123"
+	// true <nil>
+	// false <nil>
+	// true <nil>
+	// false <nil>
 }
 
-func ExampleFlatMap_string() {
-	mapper := func(value string) Optional[int] {
-		if value == "" {
-			return Empty[int]()
-		}
-		i, err := strconv.ParseInt(value, 10, 0)
-		if err != nil {
-			log.Fatal(err)
-		}
-		return OfZeroable(int(i))
+func ExampleLastOk() {
+	type reading = struct {
+		Value float64
+		Err   error
 	}
 
-	example.Print(FlatMap(Empty[string](), mapper))
-	example.Print(FlatMap(Of(""), mapper))
-	example.Print(FlatMap(Of("0"), mapper))
-	example.Print(FlatMap(Of("123"), mapper))
+	example.Print(LastOk(
+		reading{Value: 20.5},
+		reading{Value: 21.0},
+		reading{Err: errors.New("sensor offline")},
+	))
+	example.Print(LastOk(
+		reading{Err: errors.New("sensor offline")},
+		reading{Err: errors.New("sensor offline")},
+	))
 
 	// Output:
+	// 21
 	// <empty>
-	// <empty>
-	// <empty>
-	// 123
 }
 
-func ExampleGetAny_int() {
-	example.PrintValues(GetAny[int]())
-	example.PrintValues(GetAny(Empty[int]()))
-	example.PrintValues(GetAny(Empty[int](), Of(0), Of(123)))
+func ExampleLoadMap() {
+	var m sync.Map
+	m.Store("abc", 123)
+	m.Store("def", "123")
+
+	example.Print(LoadMap[string, int](&m, "abc"))
+	example.Print(LoadMap[string, int](&m, "def"))
+	example.Print(LoadMap[string, int](&m, "ghi"))
 
 	// Output:
-	// []
-	// []
-	// [0 123]
+	// 123
+	// <empty>
+	// <empty>
 }
 
-func ExampleGetAny_string() {
-	example.PrintValues(GetAny[string]())
-	example.PrintValues(GetAny(Empty[string]()))
-	example.PrintValues(GetAny(Empty[string](), Of("abc"), Of("")))
+func ExampleLookup() {
+	m := map[string]int{"abc": 123, "def": 0}
+
+	example.Print(Lookup(map[string]int(nil), "abc"))
+	example.Print(Lookup(m, "ghi"))
+	example.Print(Lookup(m, "def"))
+	example.Print(Lookup(m, "abc"))
 
 	// Output:
-	// []
-	// []
-	// ["abc" ""]
+	// <empty>
+	// <empty>
+	// 0
+	// 123
 }
 
 func ExampleMap_int() {
@@ -821,6 +2221,70 @@ func ExampleMap_string() {
 	// 123
 }
 
+func ExampleMapContext() {
+	toInt := func(ctx context.Context, value string) (int, error) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		i, err := strconv.ParseInt(value, 10, 0)
+		return int(i), err
+	}
+	ctx := context.Background()
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	example.PrintTry(MapContext(ctx, Empty[string](), toInt))
+	example.PrintTry(MapContext(ctx, Of("123"), toInt))
+	example.PrintTry(MapContext(ctx, Of("abc"), toInt))
+	example.PrintTry(MapContext(cancelledCtx, Of("123"), toInt))
+
+	// Output:
+	// <empty> <nil>
+	// 123 <nil>
+	// <empty> "strconv.ParseInt: parsing \"abc\": invalid syntax"
+	// <empty> "context canceled"
+}
+
+func ExampleMapSlice_int() {
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
+	}
+
+	example.PrintSlice(MapSlice([]Optional[int]{Empty[int](), Of(0), Empty[int](), Of(123)}, toString))
+	fmt.Println()
+
+	// Output: [<empty> "0" <empty> "123"]
+}
+
+func ExampleMapSlice_string() {
+	toInt := func(value string) int {
+		i, err := strconv.ParseInt(value, 10, 0)
+		if err != nil {
+			panic(err)
+		}
+		return int(i)
+	}
+
+	example.PrintSlice(MapSlice([]Optional[string]{Empty[string](), Of("0"), Empty[string](), Of("123")}, toInt))
+	fmt.Println()
+
+	// Output: [<empty> 0 <empty> 123]
+}
+
+func ExampleMergeMaps() {
+	defaults := Of(map[string]int{"port": 80, "timeout": 30})
+	overrides := Of(map[string]int{"port": 443})
+
+	example.Print(MergeMaps[string, int]())
+	example.Print(MergeMaps(Empty[map[string]int]()))
+	example.Print(MergeMaps(defaults, Empty[map[string]int](), overrides))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// map[port:443 timeout:30]
+}
+
 func ExampleMustFind_int() {
 	example.PrintValue(MustFind(Empty[int](), Of(0), Of(123)))
 
@@ -883,6 +2347,57 @@ func ExampleOf_stringPointer() {
 	// &"abc"
 }
 
+func ExampleOfAssert_int() {
+	example.Print(OfAssert[int](nil))
+	example.Print(OfAssert[int]("123"))
+	example.Print(OfAssert[int](0))
+	example.Print(OfAssert[int](123))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// 0
+	// 123
+}
+
+func ExampleOfAssert_string() {
+	example.Print(OfAssert[string](nil))
+	example.Print(OfAssert[string](123))
+	example.Print(OfAssert[string](""))
+	example.Print(OfAssert[string]("abc"))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// ""
+	// "abc"
+}
+
+func ExampleOfCSVList() {
+	example.Print(OfCSVList(""))
+	example.Print(OfCSVList("a"))
+	example.Print(OfCSVList("a, b ,c"))
+
+	// Output:
+	// <empty>
+	// [a]
+	// [a b c]
+}
+
+func ExampleOfFirstReady() {
+	primary := make(chan string, 1)
+	fallback := make(chan string, 1)
+
+	example.Print(OfFirstReady[string](primary, fallback))
+
+	fallback <- "abc"
+	example.Print(OfFirstReady[string](primary, fallback))
+
+	// Output:
+	// <empty>
+	// "abc"
+}
+
 func ExampleOfNillable_int() {
 	example.Print(OfNillable(0))
 	example.Print(OfNillable(123))
@@ -923,6 +2438,17 @@ func ExampleOfNillable_stringPointer() {
 	// &"abc"
 }
 
+func ExampleOfNonNil() {
+	fmt.Println(Of[error](nil))
+	fmt.Println(OfNonNil[error](nil))
+	fmt.Println(OfNonNil(errors.New("failed")))
+
+	// Output:
+	// <nil>
+	// <empty>
+	// failed
+}
+
 func ExampleOfPointer_int() {
 	example.Print(OfPointer(0))
 	example.Print(OfPointer(123))
@@ -941,6 +2467,62 @@ func ExampleOfPointer_string() {
 	// &"abc"
 }
 
+func ExampleOfReflect() {
+	example.Print(OfReflect(reflect.Value{}))
+	example.Print(OfReflect(reflect.ValueOf((*int)(nil))))
+	example.Print(OfReflect(reflect.ValueOf(0)))
+	example.Print(OfReflect(reflect.ValueOf("abc")))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// 0
+	// "abc"
+}
+
+func ExampleOfRegexpNamed() {
+	re := regexp.MustCompile(`^(?P<key>\w+)(?:=(?P<value>\w*))?$`)
+
+	example.Print(OfRegexpNamed(re, "abc=123", "value"))
+	example.Print(OfRegexpNamed(re, "abc=", "value"))
+	example.Print(OfRegexpNamed(re, "abc", "value"))
+	example.Print(OfRegexpNamed(re, "abc=123=456", "value"))
+
+	// Output:
+	// "123"
+	// ""
+	// <empty>
+	// <empty>
+}
+
+func ExampleOfScan() {
+	sc := bufio.NewScanner(strings.NewReader("abc\ndef\n"))
+
+	example.Print(OfScan(sc))
+	example.Print(OfScan(sc))
+	example.Print(OfScan(sc))
+
+	// Output:
+	// "abc"
+	// "def"
+	// <empty>
+}
+
+func ExampleOfSelect() {
+	valueCh := make(chan int, 1)
+	done := make(chan struct{})
+
+	valueCh <- 123
+	example.Print(OfSelect(valueCh, done))
+
+	close(done)
+	example.Print(OfSelect(valueCh, done))
+
+	// Output:
+	// 123
+	// <empty>
+}
+
 func ExampleOfZeroable_int() {
 	example.Print(OfZeroable(0))
 	example.Print(OfZeroable(123))
@@ -981,6 +2563,125 @@ func ExampleOfZeroable_stringPointer() {
 	// &"abc"
 }
 
+func ExamplePairs_int() {
+	Pairs(Of(0), Empty[int](), Of(123))(func(value int, present bool) bool {
+		example.PrintGet(value, present)
+		return true
+	})
+
+	// Output:
+	// 0 true
+	// 0 false
+	// 123 true
+}
+
+func ExamplePairs_string() {
+	Pairs(Of(""), Empty[string](), Of("abc"))(func(value string, present bool) bool {
+		example.PrintGet(value, present)
+		return true
+	})
+
+	// Output:
+	// "" true
+	// "" false
+	// "abc" true
+}
+
+func ExampleParseCSVField_int() {
+	example.Print(ParseCSVField[int](""))
+	example.Print(ParseCSVField[int]("0"))
+	example.Print(ParseCSVField[int]("123"))
+	example.Print(ParseCSVField[int]("abc"))
+
+	// Output:
+	// <empty>
+	// 0
+	// 123
+	// <empty>
+}
+
+func ExampleParseCSVField_string() {
+	example.Print(ParseCSVField[string](""))
+	example.Print(ParseCSVField[string]("abc"))
+
+	// Output:
+	// <empty>
+	// "abc"
+}
+
+func ExampleParseFirst() {
+	parseDec := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	}
+	parseAny := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 0, 64)
+	}
+
+	example.Print(ParseFirst(Empty[string](), parseDec, parseAny))
+	example.Print(ParseFirst(Of("abc"), parseDec, parseAny))
+	example.Print(ParseFirst(Of("123"), parseDec, parseAny))
+	example.Print(ParseFirst(Of("0x7b"), parseDec, parseAny))
+
+	// Output:
+	// <empty>
+	// <empty>
+	// 123
+	// 123
+}
+
+func ExampleRecoverAsError() {
+	fmt.Println(RecoverAsError(func() {
+		Of(123).Require()
+	}))
+	fmt.Println(RecoverAsError(func() {
+		Empty[int]().Require()
+	}))
+
+	// Output:
+	// <nil>
+	// go-optional: value not present
+}
+
+func ExampleRegisterEnum() {
+	type Status string
+
+	RegisterEnum(map[int64]Status{
+		0: "pending",
+		1: "active",
+		2: "closed",
+	})
+
+	var opt Optional[Status]
+
+	fmt.Println(opt.Scan(int64(1)))
+	example.Print(opt)
+	fmt.Println(opt.Scan(int64(3)))
+
+	// Output:
+	// <nil>
+	// "active"
+	// go-optional: couldn't scan int64 value ("3") into type *optional.Status (string): ordinal not registered
+}
+
+func ExampleRequireAll() {
+	errs := map[string]error{"name": errors.New("name is required")}
+
+	fmt.Println(RequireAll(errs, map[string]Optional[string]{
+		"email": Of("alasdair@example.com"),
+		"name":  Of("Alasdair"),
+	}))
+	fmt.Println(RequireAll(errs, map[string]Optional[string]{
+		"email": Empty[string](),
+		"name":  Empty[string](),
+		"role":  Of("admin"),
+	}))
+
+	// Output:
+	// <nil>
+	// go-optional: value not present: email
+	// name is required
+}
+
 func ExampleRequireAny_int() {
 	example.PrintValues(RequireAny(Empty[int](), Of(0), Of(123)))
 
@@ -1003,6 +2704,92 @@ func ExampleRequireAny_string() {
 	// Output: ["" "abc"]
 }
 
+func ExampleRetry() {
+	var attempt int
+	lookup := func() (Optional[string], error) {
+		attempt++
+		switch attempt {
+		case 1:
+			return Empty[string](), nil
+		case 2:
+			return Empty[string](), errors.New("timeout")
+		default:
+			return Of("abc"), nil
+		}
+	}
+
+	example.PrintTry(Retry(2, lookup))
+	attempt = 0
+	example.PrintTry(Retry(3, lookup))
+
+	// Output:
+	// <empty> "timeout"
+	// "abc" <nil>
+}
+
+func ExampleRound() {
+	example.Print(Round(Empty[float64](), 2))
+	example.Print(Round(Of(0.0), 2))
+	example.Print(Round(Of(3.14159), 2))
+	example.Print(Round(Of(-3.14159), 3))
+
+	// Output:
+	// <empty>
+	// 0
+	// 3.14
+	// -3.142
+}
+
+func ExampleScanners() {
+	rows, err := db.QueryContext(ctx, "SELECT age, height FROM users")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var age, height Optional[int]
+		if err = rows.Scan(Scanners(&age, &height)...); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("age: %s, height: %s", age, height)
+	}
+	if err = rows.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func ExampleSelect_int() {
+	example.Print(Select(true, Of(123), Empty[int]()))
+	example.Print(Select(false, Of(123), Empty[int]()))
+
+	// Output:
+	// 123
+	// <empty>
+}
+
+func ExampleSelect_string() {
+	example.Print(Select(true, Empty[string](), Of("abc")))
+	example.Print(Select(false, Empty[string](), Of("abc")))
+
+	// Output:
+	// <empty>
+	// "abc"
+}
+
+func ExampleSummary() {
+	fmt.Println(Summary[int]())
+	fmt.Println(Summary(Empty[int](), Empty[int]()))
+	fmt.Println(Summary(Of(0), Empty[int](), Of(123), Of(-123), Empty[int]()))
+	fmt.Println(Summary(Of(""), Of("abc")))
+
+	// Output:
+	// 0/0 present
+	// 0/2 present ..
+	// 3/5 present P.PP.
+	// 2/2 present PP
+}
+
 func ExampleTryFlatMap_int() {
 	mapper := func(value int) (Optional[string], error) {
 		if value == 0 {
@@ -1079,3 +2866,14 @@ func ExampleTryMap_string() {
 	// 123 <nil>
 	// <empty> "strconv.ParseInt: parsing \"abc\": invalid syntax"
 }
+
+func ExampleZip3() {
+	fmt.Println(Zip3(Of(123), Empty[string](), Of(true)))
+	fmt.Println(Zip3(Of(0), Of(""), Of(false)))
+	fmt.Println(Zip3(Of(123), Of("abc"), Of(true)))
+
+	// Output:
+	// <empty>
+	// {0  false}
+	// {123 abc true}
+}
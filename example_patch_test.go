@@ -0,0 +1,56 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-optional/internal/example"
+)
+
+func ExamplePatchOptional_UnmarshalJSON() {
+	type MyPatch struct {
+		Number PatchOptional[int]    `json:"number"`
+		Text   PatchOptional[string] `json:"text"`
+	}
+
+	var patch MyPatch
+	if err := json.Unmarshal([]byte(`{"number":null,"text":"abc"}`), &patch); err != nil {
+		fmt.Println(err)
+		return
+	}
+	example.Print(patch.Number.Optional())
+	example.Print(patch.Text.Optional())
+
+	patch = MyPatch{}
+	if err := json.Unmarshal([]byte(`{"number":0}`), &patch); err != nil {
+		fmt.Println(err)
+		return
+	}
+	example.Print(patch.Number.Optional())
+	example.Print(patch.Text.Optional())
+
+	// Output:
+	// <empty>
+	// "abc"
+	// 0
+	// <empty>
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"context"
+	"fmt"
+	"github.com/neocotic/go-optional/internal/example"
+)
+
+func ExamplePromise() {
+	config := NewPromise[string]()
+	go func() {
+		config.Resolve("production")
+	}()
+
+	opt, err := config.Get(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	example.Print(opt)
+
+	// Output: "production"
+}
+
+func ExamplePromise_Get() {
+	config := NewPromise[string]()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	opt, err := config.Get(ctx)
+	example.Print(opt)
+	fmt.Println(err)
+
+	// Output:
+	// <empty>
+	// context canceled
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-optional/internal/example"
+)
+
+func ExampleReadOnly() {
+	type Config struct {
+		Name ReadOnly[string] `json:"name"`
+		Port ReadOnly[int]    `json:"port"`
+	}
+
+	cfg := Config{
+		Name: NewReadOnly(Of("app")),
+		Port: NewReadOnly(Empty[int]()),
+	}
+
+	example.PrintGet(cfg.Name.Get())
+	example.PrintGet(cfg.Port.Get())
+	example.PrintValue(cfg.Port.OrElse(8080))
+
+	data, _ := json.Marshal(cfg)
+	fmt.Println(string(data))
+
+	// Output:
+	// "app" true
+	// 0 false
+	// 8080
+	// {"name":"app","port":null}
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"fmt"
+	"github.com/neocotic/go-optional/internal/example"
+)
+
+func ExampleTracked() {
+	var nickname Tracked[string]
+	example.Print(nickname.Optional())
+	fmt.Println(nickname.WasEverSet())
+
+	nickname.Set("abc")
+	example.Print(nickname.Optional())
+	fmt.Println(nickname.WasEverSet())
+
+	nickname.Clear()
+	example.Print(nickname.Optional())
+	fmt.Println(nickname.WasEverSet())
+
+	// Output:
+	// <empty>
+	// false
+	// "abc"
+	// true
+	// <empty>
+	// true
+}
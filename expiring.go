@@ -0,0 +1,81 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"sync"
+	"time"
+)
+
+// Expiring is a mutable container for an Optional whose value is only considered present until it expires, after
+// which it is treated as empty even though a value was set. This can be especially useful for TTL caches.
+//
+// The zero value of Expiring is ready to use, contains an empty Optional, and uses time.Now as its clock. An Expiring
+// is safe for concurrent use and should be passed around via a pointer, since a copy would hold its own value and
+// expiry, neither of which are affected by subsequent calls to Set on the original.
+type Expiring[T any] struct {
+	// expiresAt is the time at which opt expires.
+	expiresAt time.Time
+	// mu guards expiresAt and opt.
+	mu sync.RWMutex
+	// now returns the current time, if not nil, otherwise time.Now is used.
+	now func() time.Time
+	// opt is the Optional.
+	opt Optional[T]
+}
+
+// NewExpiring returns an empty Expiring that uses the given function to determine the current time. If now is nil,
+// time.Now is used.
+//
+// This can be especially useful for controlling the clock within tests.
+func NewExpiring[T any](now func() time.Time) *Expiring[T] {
+	return &Expiring[T]{now: now}
+}
+
+// Get returns the Optional within the Expiring, if it has not expired, otherwise an empty Optional.
+func (e *Expiring[T]) Get() Optional[T] {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if !e.opt.present || !e.currentTime().Before(e.expiresAt) {
+		return Optional[T]{}
+	}
+	return e.opt
+}
+
+// Set replaces the Optional within the Expiring with one that has the given value present until the ttl provided has
+// elapsed. A ttl that is not positive results in the value expiring immediately.
+func (e *Expiring[T]) Set(value T, ttl time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.expiresAt = e.currentTime().Add(ttl)
+	e.opt = Optional[T]{
+		present: true,
+		value:   value,
+	}
+}
+
+// currentTime returns the current time using the clock of the Expiring.
+func (e *Expiring[T]) currentTime() time.Time {
+	if e.now != nil {
+		return e.now()
+	}
+	return time.Now()
+}
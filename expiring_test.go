@@ -0,0 +1,168 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func BenchmarkNewExpiring(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NewExpiring[int](time.Now)
+	}
+}
+
+func TestNewExpiring(t *testing.T) {
+	e := NewExpiring[int](nil)
+	assert.Equal(t, Empty[int](), e.Get(), "unexpected Optional")
+	e.Set(123, time.Hour)
+	assert.Equal(t, Of(123), e.Get(), "unexpected Optional using default clock")
+}
+
+func BenchmarkExpiring_Get(b *testing.B) {
+	e := NewExpiring[int](nil)
+	e.Set(123, time.Hour)
+	for i := 0; i < b.N; i++ {
+		_ = e.Get()
+	}
+}
+
+type expiringGetTC[T any] struct {
+	set           bool
+	value         T
+	ttl           time.Duration
+	elapsed       time.Duration
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc expiringGetTC[T]) Test(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	e := NewExpiring[T](func() time.Time {
+		return now
+	})
+	if tc.set {
+		e.Set(tc.value, tc.ttl)
+	}
+	now = now.Add(tc.elapsed)
+	value, present := e.Get().Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestExpiring_Get(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on unset int Expiring": expiringGetTC[int]{
+			set:           false,
+			expectPresent: false,
+		},
+		"on int Expiring with zero value that has not expired": expiringGetTC[int]{
+			set:           true,
+			value:         0,
+			ttl:           time.Minute,
+			elapsed:       time.Second,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on int Expiring with non-zero value that has not expired": expiringGetTC[int]{
+			set:           true,
+			value:         123,
+			ttl:           time.Minute,
+			elapsed:       time.Second,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on int Expiring with non-zero value that has expired": expiringGetTC[int]{
+			set:           true,
+			value:         123,
+			ttl:           time.Minute,
+			elapsed:       time.Hour,
+			expectPresent: false,
+		},
+		"on unset string Expiring": expiringGetTC[string]{
+			set:           false,
+			expectPresent: false,
+		},
+		"on string Expiring with non-zero value that has not expired": expiringGetTC[string]{
+			set:           true,
+			value:         "abc",
+			ttl:           time.Minute,
+			elapsed:       time.Second,
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		"on string Expiring with non-zero value that has expired": expiringGetTC[string]{
+			set:           true,
+			value:         "abc",
+			ttl:           time.Minute,
+			elapsed:       time.Hour,
+			expectPresent: false,
+		},
+		// Other test cases...
+		"on int Expiring with value at exact expiry": expiringGetTC[int]{
+			set:           true,
+			value:         123,
+			ttl:           time.Minute,
+			elapsed:       time.Minute,
+			expectPresent: false,
+		},
+		"on int Expiring with value set using zero ttl": expiringGetTC[int]{
+			set:           true,
+			value:         123,
+			ttl:           0,
+			expectPresent: false,
+		},
+		"on int Expiring with value set using negative ttl": expiringGetTC[int]{
+			set:           true,
+			value:         123,
+			ttl:           -time.Minute,
+			expectPresent: false,
+		},
+	})
+}
+
+func BenchmarkExpiring_Set(b *testing.B) {
+	e := NewExpiring[int](nil)
+	for i := 0; i < b.N; i++ {
+		e.Set(i, time.Hour)
+	}
+}
+
+func TestExpiring_Set(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	e := NewExpiring[string](func() time.Time {
+		return now
+	})
+
+	e.Set("abc", time.Minute)
+	assert.Equal(t, Of("abc"), e.Get(), "unexpected Optional after first Set")
+
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, Empty[string](), e.Get(), "unexpected Optional after expiry")
+
+	e.Set("def", time.Minute)
+	assert.Equal(t, Of("def"), e.Get(), "unexpected Optional after second Set")
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+)
+
+// OmitZero is an Optional that treats a value that is present but equal to the zero value for T the same as an absent
+// value when marshalling, which can be especially useful for sparse JSON payloads where both are to be omitted.
+//
+// Unlike Optional, whose MarshalJSON renders a present zero value as the zero JSON value (e.g. 0 or ""), the IsZero of
+// OmitZero returns true for both an empty Optional and a present zero value. When used with the json "omitzero" tag
+// option (Go 1.24+), the field is omitted entirely in either case. Otherwise, since an encoding/json marshaler cannot
+// produce zero-length output, MarshalJSON returns a null-like value in either case.
+//
+// The same applies to YAML, where the yaml "omitempty" tag option omits the field in either case, and MarshalYAML
+// otherwise returns a null-like value. When unmarshalling, a null value results in an empty OmitZero, which allows its
+// own output to be decoded.
+//
+// An OmitZero can be converted to and from an Optional at any time.
+type OmitZero[T comparable] Optional[T]
+
+var (
+	_ json.Marshaler   = (*OmitZero[int])(nil)
+	_ json.Unmarshaler = (*OmitZero[int])(nil)
+	_ yaml.IsZeroer    = (*OmitZero[int])(nil)
+	_ yaml.Marshaler   = (*OmitZero[int])(nil)
+	_ yaml.Unmarshaler = (*OmitZero[int])(nil)
+)
+
+// IsZero returns whether the value of the OmitZero is either absent or present but equal to the zero value for T.
+//
+// IsZero conforms to the yaml.IsZeroer interface and is also used by the json "omitzero" tag option (Go 1.24+).
+func (z OmitZero[T]) IsZero() bool {
+	var zero T
+	return !z.present || z.value == zero
+}
+
+// MarshalJSON marshals the value of the OmitZero into JSON, if present and not equal to the zero value for T, otherwise
+// returns a null-like value.
+//
+// An error is returned if unable to marshal the value.
+func (z OmitZero[T]) MarshalJSON() ([]byte, error) {
+	if z.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(z.value)
+}
+
+// MarshalYAML marshals the value of the OmitZero into YAML, if present and not equal to the zero value for T, otherwise
+// returns a null-like value.
+//
+// An error is returned if unable to marshal the value.
+func (z OmitZero[T]) MarshalYAML() (any, error) {
+	if z.IsZero() {
+		return nil, nil
+	}
+	return z.value, nil
+}
+
+// Optional returns the OmitZero as an Optional.
+func (z OmitZero[T]) Optional() Optional[T] {
+	return Optional[T](z)
+}
+
+// UnmarshalJSON unmarshalls the JSON data provided as the value for the OmitZero. If data is null, the OmitZero will be
+// empty, otherwise it treats the OmitZero as having a value even though that value may still be the zero value for T.
+//
+// An error is returned if unable to unmarshal data.
+func (z *OmitZero[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*z = OmitZero[T]{}
+		return nil
+	}
+	return (*Optional[T])(z).UnmarshalJSON(data)
+}
+
+// UnmarshalYAML unmarshalls the decoded YAML node provided as the value for the OmitZero. See Optional.UnmarshalYAML
+// for more information.
+//
+// An error is returned if unable to unmarshal the given node.
+func (z *OmitZero[T]) UnmarshalYAML(value *yaml.Node) error {
+	return (*Optional[T])(z).UnmarshalYAML(value)
+}
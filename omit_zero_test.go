@@ -0,0 +1,395 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func BenchmarkOmitZero_IsZero(b *testing.B) {
+	opt := OmitZero[int](Of(123))
+	for i := 0; i < b.N; i++ {
+		_ = opt.IsZero()
+	}
+}
+
+type omitZeroIsZeroTC[T comparable] struct {
+	opt    OmitZero[T]
+	expect bool
+	test.Control
+}
+
+func (tc omitZeroIsZeroTC[T]) Test(t *testing.T) {
+	actual := tc.opt.IsZero()
+	assert.Equal(t, tc.expect, actual, "unexpected zero check")
+}
+
+func TestOmitZero_IsZero(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int OmitZero": omitZeroIsZeroTC[int]{
+			opt:    OmitZero[int]{},
+			expect: true,
+		},
+		"on non-empty int OmitZero with zero value": omitZeroIsZeroTC[int]{
+			opt:    OmitZero[int](Of(0)),
+			expect: true,
+		},
+		"on non-empty int OmitZero with non-zero value": omitZeroIsZeroTC[int]{
+			opt:    OmitZero[int](Of(123)),
+			expect: false,
+		},
+		"on empty string OmitZero": omitZeroIsZeroTC[string]{
+			opt:    OmitZero[string]{},
+			expect: true,
+		},
+		"on non-empty string OmitZero with zero value": omitZeroIsZeroTC[string]{
+			opt:    OmitZero[string](Of("")),
+			expect: true,
+		},
+		"on non-empty string OmitZero with non-zero value": omitZeroIsZeroTC[string]{
+			opt:    OmitZero[string](Of("abc")),
+			expect: false,
+		},
+		// Other test cases...
+		"on non-empty *int OmitZero with nil value": omitZeroIsZeroTC[*int]{
+			opt:    OmitZero[*int](Of[*int](nil)),
+			expect: true,
+		},
+	})
+}
+
+func BenchmarkOmitZero_MarshalJSON(b *testing.B) {
+	opt := OmitZero[int](Of(123))
+	for i := 0; i < b.N; i++ {
+		if _, err := opt.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type omitZeroMarshalJSONTC[T comparable] struct {
+	opt    OmitZero[T]
+	expect string
+	test.Control
+}
+
+func (tc omitZeroMarshalJSONTC[T]) Test(t *testing.T) {
+	data, err := json.Marshal(tc.opt)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expect, string(data), "unexpected JSON")
+}
+
+func TestOmitZero_MarshalJSON(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int OmitZero": omitZeroMarshalJSONTC[int]{
+			opt:    OmitZero[int]{},
+			expect: "null",
+		},
+		"on non-empty int OmitZero with zero value": omitZeroMarshalJSONTC[int]{
+			opt:    OmitZero[int](Of(0)),
+			expect: "null",
+		},
+		"on non-empty int OmitZero with non-zero value": omitZeroMarshalJSONTC[int]{
+			opt:    OmitZero[int](Of(123)),
+			expect: "123",
+		},
+		"on empty string OmitZero": omitZeroMarshalJSONTC[string]{
+			opt:    OmitZero[string]{},
+			expect: "null",
+		},
+		"on non-empty string OmitZero with zero value": omitZeroMarshalJSONTC[string]{
+			opt:    OmitZero[string](Of("")),
+			expect: "null",
+		},
+		"on non-empty string OmitZero with non-zero value": omitZeroMarshalJSONTC[string]{
+			opt:    OmitZero[string](Of("abc")),
+			expect: `"abc"`,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOmitZero_MarshalYAML(b *testing.B) {
+	opt := OmitZero[int](Of(123))
+	for i := 0; i < b.N; i++ {
+		if _, err := opt.MarshalYAML(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestOmitZero_MarshalYAML(t *testing.T) {
+	type Example struct {
+		Int        OmitZero[int]    `yaml:"int"`
+		IntOmit    OmitZero[int]    `yaml:"intOmit,omitempty"`
+		StringOmit OmitZero[string] `yaml:"stringOmit,omitempty"`
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int OmitZero": optionalMarshalYAMLTC{
+			value:      OmitZero[int]{},
+			expectYAML: `null`,
+		},
+		"on non-empty int OmitZero with zero value": optionalMarshalYAMLTC{
+			value:      OmitZero[int](Of(0)),
+			expectYAML: `null`,
+		},
+		"on non-empty int OmitZero with non-zero value": optionalMarshalYAMLTC{
+			value:      OmitZero[int](Of(123)),
+			expectYAML: `123`,
+		},
+		"on non-empty string OmitZero with zero value": optionalMarshalYAMLTC{
+			value:      OmitZero[string](Of("")),
+			expectYAML: `null`,
+		},
+		"on non-empty string OmitZero with non-zero value": optionalMarshalYAMLTC{
+			value:      OmitZero[string](Of("abc")),
+			expectYAML: `abc`,
+		},
+		// Other test cases...
+		"on struct with empty OmitZeros": optionalMarshalYAMLTC{
+			value:      Example{},
+			expectYAML: `int: null`,
+		},
+		"on struct with non-empty OmitZeros and zero field values": optionalMarshalYAMLTC{
+			value: Example{
+				Int:        OmitZero[int](Of(0)),
+				IntOmit:    OmitZero[int](Of(0)),
+				StringOmit: OmitZero[string](Of("")),
+			},
+			expectYAML: `int: null`,
+		},
+		"on struct with non-empty OmitZeros and non-zero field values": optionalMarshalYAMLTC{
+			value: Example{
+				Int:        OmitZero[int](Of(5)),
+				IntOmit:    OmitZero[int](Of(5)),
+				StringOmit: OmitZero[string](Of("abc")),
+			},
+			expectYAML: `int: 5
+intOmit: 5
+stringOmit: abc`,
+		},
+	})
+}
+
+func BenchmarkOmitZero_Optional(b *testing.B) {
+	opt := OmitZero[int](Of(123))
+	for i := 0; i < b.N; i++ {
+		_ = opt.Optional()
+	}
+}
+
+type omitZeroOptionalTC[T comparable] struct {
+	opt    OmitZero[T]
+	expect Optional[T]
+	test.Control
+}
+
+func (tc omitZeroOptionalTC[T]) Test(t *testing.T) {
+	actual := tc.opt.Optional()
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestOmitZero_Optional(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int OmitZero": omitZeroOptionalTC[int]{
+			opt:    OmitZero[int]{},
+			expect: Empty[int](),
+		},
+		"on non-empty int OmitZero with zero value": omitZeroOptionalTC[int]{
+			opt:    OmitZero[int](Of(0)),
+			expect: Of(0),
+		},
+		"on non-empty int OmitZero with non-zero value": omitZeroOptionalTC[int]{
+			opt:    OmitZero[int](Of(123)),
+			expect: Of(123),
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOmitZero_UnmarshalJSON(b *testing.B) {
+	data := []byte("123")
+	for i := 0; i < b.N; i++ {
+		var opt OmitZero[int]
+		if err := opt.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type omitZeroUnmarshalJSONTC[T comparable] struct {
+	data          string
+	expectError   bool
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc omitZeroUnmarshalJSONTC[T]) Test(t *testing.T) {
+	var actual struct {
+		Field OmitZero[T] `json:"field"`
+	}
+	err := json.Unmarshal([]byte(tc.data), &actual)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	value, present := actual.Field.Optional().Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestOmitZero_UnmarshalJSON(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"with missing int field": omitZeroUnmarshalJSONTC[int]{
+			data:          `{}`,
+			expectPresent: false,
+		},
+		"with null int field": omitZeroUnmarshalJSONTC[int]{
+			data:          `{"field":null}`,
+			expectPresent: false,
+		},
+		"with zero int field": omitZeroUnmarshalJSONTC[int]{
+			data:          `{"field":0}`,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"with non-zero int field": omitZeroUnmarshalJSONTC[int]{
+			data:          `{"field":5}`,
+			expectPresent: true,
+			expectValue:   5,
+		},
+		"with erroneous int field": omitZeroUnmarshalJSONTC[int]{
+			data:        `{"field":"abc"}`,
+			expectError: true,
+		},
+		"with null string field": omitZeroUnmarshalJSONTC[string]{
+			data:          `{"field":null}`,
+			expectPresent: false,
+		},
+		"with non-zero string field": omitZeroUnmarshalJSONTC[string]{
+			data:          `{"field":"abc"}`,
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func TestOmitZero_UnmarshalJSON_roundTrip(t *testing.T) {
+	type Example struct {
+		A OmitZero[int]    `json:"a"`
+		B OmitZero[string] `json:"b"`
+	}
+
+	for _, expect := range []Example{
+		{},
+		{A: OmitZero[int](Of(5))},
+		{A: OmitZero[int](Of(5)), B: OmitZero[string](Of("abc"))},
+	} {
+		data, err := json.Marshal(expect)
+		if !assert.NoError(t, err, "unexpected error") {
+			return
+		}
+		var actual Example
+		err = json.Unmarshal(data, &actual)
+		assert.NoError(t, err, "unexpected error")
+		assert.Equal(t, expect, actual, "unexpected value after round trip of %s", data)
+	}
+}
+
+func BenchmarkOmitZero_UnmarshalYAML(b *testing.B) {
+	data := []byte("123")
+	for i := 0; i < b.N; i++ {
+		var opt OmitZero[int]
+		if err := yaml.Unmarshal(data, &opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestOmitZero_UnmarshalYAML(t *testing.T) {
+	type Example struct {
+		Int    OmitZero[int]    `yaml:"int"`
+		String OmitZero[string] `yaml:"string"`
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on non-empty int OmitZero with zero value": optionalUnmarshalYAMLTC[OmitZero[int]]{
+			yaml:   `0`,
+			expect: OmitZero[int](Of(0)),
+		},
+		"on non-empty int OmitZero with non-zero value": optionalUnmarshalYAMLTC[OmitZero[int]]{
+			yaml:   `5`,
+			expect: OmitZero[int](Of(5)),
+		},
+		"on non-empty string OmitZero with non-zero value": optionalUnmarshalYAMLTC[OmitZero[string]]{
+			yaml:   `abc`,
+			expect: OmitZero[string](Of("abc")),
+		},
+		// Other test cases...
+		"on struct with null OmitZeros": optionalUnmarshalYAMLTC[Example]{
+			yaml:   "int: null\nstring: null",
+			expect: Example{},
+		},
+		"on struct with non-empty OmitZeros": optionalUnmarshalYAMLTC[Example]{
+			yaml: "int: 5\nstring: abc",
+			expect: Example{
+				Int:    OmitZero[int](Of(5)),
+				String: OmitZero[string](Of("abc")),
+			},
+		},
+	})
+}
+
+func TestOmitZero_UnmarshalYAML_roundTrip(t *testing.T) {
+	type Example struct {
+		A OmitZero[int]    `yaml:"a"`
+		B OmitZero[string] `yaml:"b,omitempty"`
+	}
+
+	for _, expect := range []Example{
+		{},
+		{A: OmitZero[int](Of(5))},
+		{A: OmitZero[int](Of(5)), B: OmitZero[string](Of("abc"))},
+	} {
+		data, err := yaml.Marshal(expect)
+		if !assert.NoError(t, err, "unexpected error") {
+			return
+		}
+		var actual Example
+		err = yaml.Unmarshal(data, &actual)
+		assert.NoError(t, err, "unexpected error")
+		assert.Equal(t, expect, actual, "unexpected value after round trip of %s", data)
+	}
+}
@@ -23,8 +23,10 @@
 package optional
 
 import (
+	"bufio"
 	"bytes"
 	"cmp"
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
@@ -32,10 +34,18 @@ import (
 	"errors"
 	"fmt"
 	"gopkg.in/yaml.v3"
+	"maps"
+	"math"
+	"net/url"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 	"time"
+	"unicode"
 )
 
 // Optional contains an immutable value as well as an indication whether it was explicitly set. This can be especially
@@ -44,8 +54,10 @@ import (
 // For the best experience when marshaling a struct with Optional struct field types, the following information may be
 // useful;
 //
-//   - json: it's recommended to include the "omitempty" tag option and have the Optional field type declared as a
-//     pointer, otherwise the "omitempty" tag option is ignored
+//   - json: it's recommended to include the "omitzero" tag option (Go 1.24+), which omits an empty Optional as it
+//     implements IsZero on the value receiver, so no pointer is required. Otherwise, it's recommended to include the
+//     "omitempty" tag option and have the Optional field type declared as a pointer, as the "omitempty" tag option is
+//     ignored for struct types
 //   - xml: seems to work perfectly as expected
 //   - yaml: it's recommended to include the "omitempty" tag option
 //
@@ -79,8 +91,114 @@ var (
 // emptyString is returned by Optional.String when no value is present.
 const emptyString = "<empty>"
 
-// errNotPresent is used when panicking.
-var errNotPresent = fmt.Errorf("go-optional: value not present")
+// ErrNotPresent is returned, or used when panicking, when a value is required but not present.
+var ErrNotPresent = errors.New("go-optional: value not present")
+
+var (
+	// enumRegistry contains the values registered using RegisterEnum, mapped by their ordinals and keyed by type.
+	enumRegistry = map[reflect.Type]map[int64]string{}
+	// enumRegistryMu guards enumRegistry.
+	enumRegistryMu sync.RWMutex
+)
+
+// optionalPkgPath is the package path of Optional, which is used to reflectively identify an Optional of any type.
+var optionalPkgPath = reflect.TypeOf(Optional[any]{}).PkgPath()
+
+// AddQuery adds the string representation of the value of the Optional to the given url.Values using the key provided,
+// if present, otherwise values is left untouched. A value is added even if it is the zero value for T.
+func (o Optional[T]) AddQuery(values url.Values, key string) {
+	if o.present {
+		values.Add(key, fmt.Sprint(o.value))
+	}
+}
+
+// AssignTo assigns the value of the Optional to the given pointer, if present, returning whether it was assigned. If
+// the Optional has no value present or dst is nil, dst is left untouched.
+func (o Optional[T]) AssignTo(dst *T) bool {
+	if !o.present || dst == nil {
+		return false
+	}
+	*dst = o.value
+	return true
+}
+
+// CSVField returns a string representation of the underlying value suitable for use as a CSV field, if present,
+// otherwise an empty string (i.e. an empty field).
+//
+// Since an empty field is used to represent an empty Optional, a value present whose string representation is also
+// empty cannot be differentiated from an empty Optional. See ParseCSVField for the inverse.
+func (o Optional[T]) CSVField() string {
+	if o.present {
+		return fmt.Sprint(o.value)
+	}
+	return ""
+}
+
+// Chan returns a closed channel that yields the value of the Optional before closing, if present, otherwise a closed
+// channel that yields nothing.
+//
+// This can be especially useful when ranging over the channel, which will loop either once or not at all, or when
+// composing the Optional with other channels in a select statement.
+func (o Optional[T]) Chan() <-chan T {
+	if !o.present {
+		ch := make(chan T)
+		close(ch)
+		return ch
+	}
+	ch := make(chan T, 1)
+	ch <- o.value
+	close(ch)
+	return ch
+}
+
+// Close calls the given function only if the Optional has a value present, passing the value to the function, and
+// returns its error. Otherwise, nil is returned.
+//
+// This can be especially useful for cleaning up a resource only if it was opened (e.g. an Optional[*os.File]).
+//
+// Warning: While fn will only be called if Optional has a value present, that value may still be nil or the zero value
+// for T.
+func (o Optional[T]) Close(fn func(value T) error) error {
+	if o.present {
+		return fn(o.value)
+	}
+	return nil
+}
+
+// Display returns the result of passing the value of the Optional to the given function, if present, otherwise the
+// absent string provided.
+//
+// Unlike String, Display allows both the formatting of the value and the representation of an empty Optional to be
+// controlled (e.g. for currencies or percentages).
+//
+// Warning: While fn will only be called if Optional has a value present, that value may still be nil or the zero value
+// for T.
+func (o Optional[T]) Display(fn func(value T) string, absent string) string {
+	if !o.present {
+		return absent
+	}
+	return fn(o.value)
+}
+
+// EnvLine returns a line in the format of an env file (i.e. "KEY=value\n") assigning a string representation of the
+// value of the Optional to the given key, if present, otherwise an empty string so that no line is produced. A line is
+// always produced when a value is present, even if it's the zero value for T.
+//
+// The string representation of the value is the same as that returned by String. If it contains any whitespace, quote,
+// or other character with special meaning within env files (e.g. "#" or "$"), it is double-quoted as per
+// strconv.Quote.
+func (o Optional[T]) EnvLine(key string) string {
+	if !o.present {
+		return ""
+	}
+	value := fmt.Sprint(o.value)
+	if strings.ContainsFunc(value, func(r rune) bool {
+		return unicode.IsSpace(r) || !unicode.IsPrint(r) || strings.ContainsRune("\"#$'\\`", r)
+	}) {
+		value = strconv.Quote(value)
+	}
+	return key + "=" + value + "\n"
+}
 
 // Equal returns whether the Optional is equal to the other provided.
 //
@@ -96,6 +214,22 @@ func (o Optional[T]) Equal(other Optional[T]) bool {
 	return reflect.DeepEqual(o.value, other.value)
 }
 
+// FillGet returns the value of the Optional along with the Optional itself, if present, otherwise calls fn and returns
+// its return value along with a new Optional with that value present.
+//
+// The Optional itself is never modified, allowing callers to store the returned Optional as a means of lazily
+// populating a value (e.g. within a cache) while retaining immutability.
+func (o Optional[T]) FillGet(fn func() T) (value T, filled Optional[T]) {
+	if o.present {
+		return o.value, o
+	}
+	value = fn()
+	return value, Optional[T]{
+		present: true,
+		value:   value,
+	}
+}
+
 // Filter returns the Optional if it has a value present that the given function returns true for, otherwise an empty
 // Optional.
 //
@@ -108,11 +242,71 @@ func (o Optional[T]) Filter(fn func(value T) bool) Optional[T] {
 	return Optional[T]{}
 }
 
+// Frozen returns a copy of the Optional that is safe to share. Since Optional is a value type, the copy is unaffected
+// by any subsequent mutation of the original (e.g. via Scan or UnmarshalJSON). However, the copy is shallow and so any
+// value that is a pointer, map, or slice will still share its underlying data.
+//
+// Use NewReadOnly when immutability should also be enforced at the type level (e.g. across an API boundary).
+func (o Optional[T]) Frozen() Optional[T] {
+	return o
+}
+
+// GaugeValue returns the result of passing the value of the Optional to the given function and true, if present,
+// otherwise zero and false.
+//
+// This can be especially useful for metrics exporters (e.g. Prometheus) that should not emit a sample for an Optional
+// that has no value present.
+//
+// Warning: While valueFn will only be called if Optional has a value present, that value may still be nil or the zero
+// value for T.
+func (o Optional[T]) GaugeValue(valueFn func(value T) float64) (float64, bool) {
+	if !o.present {
+		return 0, false
+	}
+	return valueFn(o.value), true
+}
+
 // Get returns the value of the Optional and whether it is present.
 func (o Optional[T]) Get() (T, bool) {
 	return o.value, o.present
 }
 
+// GetOrNotFound returns the value of the Optional and no error if present, otherwise the zero value for T and
+// ErrNotPresent.
+//
+// This mirrors the getter shape commonly expected by configuration libraries.
+func (o Optional[T]) GetOrNotFound() (T, error) {
+	if !o.present {
+		var zero T
+		return zero, ErrNotPresent
+	}
+	return o.value, nil
+}
+
+// GetValid returns the value of the Optional only if present and the given function returns no error for it. If the
+// Optional has no value present, ErrNotPresent is returned, otherwise any error returned by validate.
+//
+// Warning: While validate will only be called if Optional has a value present, that value may still be nil or the zero
+// value for T.
+func (o Optional[T]) GetValid(validate func(value T) error) (T, error) {
+	var zero T
+	if !o.present {
+		return zero, ErrNotPresent
+	}
+	if err := validate(o.value); err != nil {
+		return zero, err
+	}
+	return o.value, nil
+}
+
+// If returns the value of the Optional and whether it is present.
+//
+// If is an alias for Get, named to read naturally within an if statement with an initializer (e.g.
+// `if v, ok := opt.If(); ok { ... }`). Get remains the canonical method.
+func (o Optional[T]) If() (T, bool) {
+	return o.value, o.present
+}
+
 // IfPresent calls the given function only the Optional has a value present, passing the value to the function.
 //
 // Warning: While fn will only be called if Optional has a value present, that value may still be nil or the zero value
@@ -123,6 +317,19 @@ func (o Optional[T]) IfPresent(fn func(value T)) {
 	}
 }
 
+// IfPresentOrElse calls the given function only if the Optional has a value present, passing the value to the
+// function, otherwise calls emptyFn.
+//
+// Warning: While fn will only be called if Optional has a value present, that value may still be nil or the zero value
+// for T.
+func (o Optional[T]) IfPresentOrElse(fn func(value T), emptyFn func()) {
+	if o.present {
+		fn(o.value)
+	} else {
+		emptyFn()
+	}
+}
+
 // IsEmpty returns whether the value of the Optional is absent. That is; it has NOT been explicitly set.
 //
 // IsEmpty is effectively the inverse of IsPresent. It's important to note that IsEmpty will not return true if the
@@ -140,12 +347,43 @@ func (o Optional[T]) IsPresent() bool {
 // IsZero returns whether the value of the Optional is absent. That is; it has NOT been explicitly set.
 //
 // IsZero is effectively the inverse of IsPresent and an alternative for IsEmpty that conforms to the yaml.IsZeroer
-// interface. It's important to note that IsZero will not return true if the underlying value of the Optional is equal
-// to the zero value for T but instead only if the value is absent.
+// interface and is also used by the json "omitzero" tag option (Go 1.24+). It's important to note that IsZero will not
+// return true if the underlying value of the Optional is equal to the zero value for T but instead only if the value is
+// absent.
 func (o Optional[T]) IsZero() bool {
 	return !o.present
 }
 
+// JSONStringOr returns the JSON encoding of the value of the Optional as a string, if present and it can be marshaled,
+// otherwise the given fallback.
+//
+// This can be especially useful when logging an Optional without needing to handle any marshaling error.
+func (o Optional[T]) JSONStringOr(fallback string) string {
+	if !o.present {
+		return fallback
+	}
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return fallback
+	}
+	return string(data)
+}
+
+// MapIf returns an Optional with the value of the Optional mapped using fn present, if present and cond returns true
+// for the value, otherwise the Optional itself.
+//
+// Warning: While cond and fn will only be called if Optional has a value present, that value may still be nil or the
+// zero value for T.
+func (o Optional[T]) MapIf(cond func(value T) bool, fn func(value T) T) Optional[T] {
+	if !o.present || !cond(o.value) {
+		return o
+	}
+	return Optional[T]{
+		present: true,
+		value:   fn(o.value),
+	}
+}
+
 // MarshalJSON marshals the value of the Optional into JSON, if present, otherwise returns a null-like value.
 //
 // An error is returned if unable to marshal the value.
@@ -184,6 +422,28 @@ func (o Optional[T]) MarshalYAML() (any, error) {
 	return o.value, nil
 }
 
+// Or returns the Optional if it has a value present, otherwise other. That is; unlike OrElse, Or returns an Optional,
+// allowing further chaining (e.g. using Filter).
+//
+// Or is effectively Find for exactly two Optionals.
+func (o Optional[T]) Or(other Optional[T]) Optional[T] {
+	if o.present {
+		return o
+	}
+	return other
+}
+
+// OrDefaultIfZero returns the value of the Optional if present and not equal to the zero value for T, otherwise def.
+// That is; unlike OrElse, OrDefaultIfZero also treats a value of zero as absent.
+//
+// Since T can be any type, whether the value is equal to the zero value of T is checked reflectively.
+func (o Optional[T]) OrDefaultIfZero(def T) T {
+	if o.present && !isZero(reflect.ValueOf(o.value)) {
+		return o.value
+	}
+	return def
+}
+
 // OrElse returns the value of the Optional if present, otherwise other.
 func (o Optional[T]) OrElse(other T) T {
 	if o.present {
@@ -192,6 +452,23 @@ func (o Optional[T]) OrElse(other T) T {
 	return other
 }
 
+// OrElseChain returns the Optional if it has a value present, otherwise calls each of the given functions in turn and
+// returns the first Optional returned by them that has a value present, without calling any further functions. If no
+// such Optional could be found, an empty Optional is returned.
+//
+// This is recommended over Find in cases where each fallback is expensive to initialize so lazy-initializes them.
+func (o Optional[T]) OrElseChain(getters ...func() Optional[T]) Optional[T] {
+	if o.present {
+		return o
+	}
+	for _, getter := range getters {
+		if opt := getter(); opt.present {
+			return opt
+		}
+	}
+	return Optional[T]{}
+}
+
 // OrElseGet returns the value of the Optional if present, otherwise calls other and returns its return value. This is
 // recommended over OrElse in cases where a default value is expensive to initialize so lazy-initializes it.
 func (o Optional[T]) OrElseGet(other func() T) T {
@@ -201,6 +478,45 @@ func (o Optional[T]) OrElseGet(other func() T) T {
 	return other()
 }
 
+// OrElseHandle returns the value of the Optional if present, otherwise calls other and returns its return value. The
+// difference from OrElseTryGet is that, if other returns an error, onErr is called with that error and its return value
+// is returned instead, allowing for graceful degradation rather than propagating the error.
+func (o Optional[T]) OrElseHandle(other func() (T, error), onErr func(err error) T) T {
+	if o.present {
+		return o.value
+	}
+	value, err := other()
+	if err != nil {
+		return onErr(err)
+	}
+	return value
+}
+
+// OrElseJSON returns the value of the Optional if present, otherwise unmarshals defaultJSON into a new value of T and
+// returns it. This allows default values to be declared as JSON literals (e.g. within configuration). defaultJSON is
+// only unmarshaled when the Optional is empty, and any error that occurs while unmarshaling is returned along with the
+// zero value of T.
+func (o Optional[T]) OrElseJSON(defaultJSON []byte) (T, error) {
+	if o.present {
+		return o.value, nil
+	}
+	var value T
+	if err := json.Unmarshal(defaultJSON, &value); err != nil {
+		var zero T
+		return zero, err
+	}
+	return value, nil
+}
+
+// OrElseKey returns the value of the Optional if present, otherwise the value for the given key within defaults, if
+// any, otherwise the zero value for T. This can be useful for per-key fallback tables (e.g. within configuration).
+func (o Optional[T]) OrElseKey(key string, defaults map[string]T) T {
+	if o.present {
+		return o.value
+	}
+	return defaults[key]
+}
+
 // OrElseTryGet returns the value of the Optional if present, otherwise calls other and returns its return value. This
 // is recommended over OrElse in cases where a default value is expensive to initialize so lazy-initializes it. The
 // difference from OrElseGet is that the given function may return an error which, if not nil, will be returned by
@@ -212,12 +528,93 @@ func (o Optional[T]) OrElseTryGet(other func() (T, error)) (T, error) {
 	return other()
 }
 
+// OrderKey returns whether the Optional is empty along with its value, which can be used as a composite key when
+// ordering Optional values such that those with a value present are ordered before any empty Optional (i.e. NULLS
+// LAST).
+//
+// Since false is ordered before true, callers should compare empty first, followed by value only when both have a
+// value present.
+func (o Optional[T]) OrderKey() (empty bool, value T) {
+	return !o.present, o.value
+}
+
+// Pair returns the value of the Optional and whether it is present.
+//
+// Pair is an alias for Get, named to match the comma-ok idiom commonly consumed by worker pools and channels.
+func (o Optional[T]) Pair() (T, bool) {
+	return o.value, o.present
+}
+
+// PresenceInt returns 1 if the Optional has a value present, otherwise 0.
+//
+// This is a convenience for counting how many Optional have a value present without branching (e.g.
+// a.PresenceInt() + b.PresenceInt()).
+func (o Optional[T]) PresenceInt() int {
+	if o.present {
+		return 1
+	}
+	return 0
+}
+
+// ProtoPtr returns a pointer to a copy of the value of the Optional, if present, otherwise nil.
+//
+// This matches the shape expected by protoc-gen-go for proto3 optional scalar fields. See FromProtoPtr for the inverse.
+func (o Optional[T]) ProtoPtr() *T {
+	if !o.present {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
+// Quoted returns a quoted string representation of the underlying value, if any. This can be especially useful when
+// logging as it allows an empty Optional to be differentiated from a value present that has an empty string
+// representation, and reveals any leading/trailing whitespace.
+//
+// Only a value whose kind is string is quoted (as if by strconv.Quote), with all other values being represented in the
+// same way as String.
+func (o Optional[T]) Quoted() string {
+	if !o.present {
+		return emptyString
+	}
+	if rv := reflect.ValueOf(o.value); rv.Kind() == reflect.String {
+		return strconv.Quote(rv.String())
+	}
+	return fmt.Sprint(o.value)
+}
+
 // Require returns the value of the Optional only if present, otherwise panics.
 func (o Optional[T]) Require() T {
 	if o.present {
 		return o.value
 	}
-	panic(errNotPresent)
+	panic(ErrNotPresent)
+}
+
+// RequireNoError returns the value of the Optional only if err is nil and the value is present, otherwise panics. If
+// err is not nil, it is used as the panic value, otherwise ErrNotPresent is.
+//
+// This is intended to be used with functions that return both an Optional and an error (e.g. TryMap) where a value must
+// be produced (e.g. during initialization).
+func (o Optional[T]) RequireNoError(err error) T {
+	if err != nil {
+		panic(err)
+	}
+	if o.present {
+		return o.value
+	}
+	panic(ErrNotPresent)
+}
+
+// Sanitize returns the Optional if it has a value present that does not equal the zero value for T, otherwise an empty
+// Optional. That is; Sanitize is effectively OfZeroable applied to an existing Optional.
+//
+// Since T can be any type, whether the value is equal to the zero value of T is checked reflectively.
+func (o Optional[T]) Sanitize() Optional[T] {
+	if o.present && !isZero(reflect.ValueOf(o.value)) {
+		return o
+	}
+	return Optional[T]{}
 }
 
 // Scan assigns the given value from a database driver into the value of the Optional, where possible. See sql.Scanner
@@ -225,7 +622,23 @@ func (o Optional[T]) Require() T {
 //
 // Scan supports scanning all the same types as sql.Rows except for sql.Rows itself. If src is nil, the Optional will be
 // empty, otherwise it will have an assigned (and often converted) value present. If the value of the Optional is a
-// sql.Scanner itself, its own Scan method will be called to assign src.
+// sql.Scanner itself, its own Scan method will be called to assign src. A json.Number src is treated as a string,
+// preserving its textual precision. A string or []byte src containing a JSON array (e.g. from a JSON or array column)
+// can be scanned into an Optional of any slice type (e.g. Optional[[]string]).
+//
+// Scan uses the following precedence when assigning src:
+//
+//  1. If src implements driver.Valuer (e.g. another Optional or a sql.NullString), its Value method is called and the
+//     result is assigned in place of src, where nil results in the Optional being empty
+//  2. If the value of the Optional is a sql.Scanner, its Scan method is called
+//  3. If src is one of the concrete types supported by sql.Rows (or a json.Number or uint64), it is converted and
+//     assigned
+//  4. If src has a Uint64() uint64 method (e.g. a driver-specific unsigned or decimal wrapper), the result of that
+//     method is assigned as if src were a uint64, unless src also has an IsUint64() bool method (e.g. *big.Int) that
+//     returns false, in which case the value cannot be represented as a uint64 and so the result of its String method
+//     is assigned as if src were a string instead, if src implements fmt.Stringer, otherwise an error is returned
+//  5. If src implements fmt.Stringer (e.g. a driver-specific enum type), the result of its String method is assigned as
+//     if src were a string
 //
 // An error is returned if src cannot be stored within the Optional without loss of information or there is a type
 // mismatch.
@@ -234,6 +647,18 @@ func (o *Optional[T]) Scan(src any) error {
 		*o = Optional[T]{}
 		return nil
 	}
+	if valuer, ok := src.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		if err != nil {
+			o.present = false
+			return err
+		}
+		if _, ok = value.(driver.Valuer); ok {
+			o.present = false
+			return fmt.Errorf("go-optional: couldn't scan %T value as its Value method returned a %T", src, value)
+		}
+		return o.Scan(value)
+	}
 	var ovp any = &o.value
 	if scanner, ok := ovp.(sql.Scanner); ok {
 		err := scanner.Scan(src)
@@ -257,6 +682,10 @@ func (o *Optional[T]) Scan(src any) error {
 		var err error
 		o.present, err = scanString(s, ovp)
 		return err
+	case json.Number:
+		var err error
+		o.present, err = scanString(string(s), ovp)
+		return err
 	case []byte:
 		var err error
 		o.present, err = scanBytes(s, ovp)
@@ -265,11 +694,41 @@ func (o *Optional[T]) Scan(src any) error {
 		var err error
 		o.present, err = scanTime(s, ovp)
 		return err
+	case uint64:
+		var err error
+		o.present, err = scanUint(s, ovp)
+		return err
+	case interface{ Uint64() uint64 }:
+		var err error
+		if r, ok := s.(interface{ IsUint64() bool }); ok && !r.IsUint64() {
+			if str, ok := s.(fmt.Stringer); ok {
+				o.present, err = scanString(str.String(), ovp)
+				return err
+			}
+			o.present = false
+			return fmtConversionErr(src, fmt.Sprint(src), ovp, reflect.ValueOf(o.value).Kind(), strconv.ErrRange)
+		}
+		o.present, err = scanUint(s.Uint64(), ovp)
+		return err
+	case fmt.Stringer:
+		var err error
+		o.present, err = scanString(s.String(), ovp)
+		return err
 	default:
 		return fmtUnsupportedScanTypeErr(src, o.value, reflect.ValueOf(o.value).Kind())
 	}
 }
 
+// State returns Present if the Optional has a value present, otherwise Absent.
+//
+// This can be especially useful when an exhaustive switch statement is preferred over checking IsPresent.
+func (o Optional[T]) State() State {
+	if o.present {
+		return Present
+	}
+	return Absent
+}
+
 // String returns a string representation of the underlying value, if any.
 func (o Optional[T]) String() string {
 	if o.present {
@@ -278,6 +737,63 @@ func (o Optional[T]) String() string {
 	return emptyString
 }
 
+// Stringer returns a fmt.Stringer whose String method renders the Optional identically to Optional.String.
+//
+// This can be useful when passing the Optional to APIs expecting a fmt.Stringer without leaking its type parameter.
+func (o Optional[T]) Stringer() fmt.Stringer {
+	return o
+}
+
+// Tap calls the present function, passing the value to it, if the Optional has a value present, otherwise calls the
+// empty function. Either function may be nil, in which case it is not called. The Optional is always returned
+// unchanged.
+//
+// Unlike IfPresent, Tap returns the Optional so that it can be used mid-chain (e.g. for logging).
+//
+// Warning: While present will only be called if Optional has a value present, that value may still be nil or the zero
+// value for T.
+func (o Optional[T]) Tap(present func(value T), empty func()) Optional[T] {
+	if o.present {
+		if present != nil {
+			present(o.value)
+		}
+	} else if empty != nil {
+		empty()
+	}
+	return o
+}
+
+// ToErrgroupFunc returns a function that, when called, calls produce and, if it returns no error, replaces the
+// Optional with one that has the produced value present, otherwise returns the error without modifying the Optional.
+//
+// The returned function is suitable for passing to errgroup.Group.Go, allowing an Optional (e.g. a struct field) to be
+// populated by a concurrent task. As with any such task, the Optional must not be accessed until the group has been
+// waited on.
+func (o *Optional[T]) ToErrgroupFunc(produce func() (T, error)) func() error {
+	return func() error {
+		value, err := produce()
+		if err != nil {
+			return err
+		}
+		*o = Optional[T]{
+			present: true,
+			value:   value,
+		}
+		return nil
+	}
+}
+
+// ToMap returns a map containing a single entry for the given key with the value of the Optional, if present, otherwise
+// a nil map.
+//
+// This can be especially useful when merging Optional fields into a map (e.g. using maps.Copy).
+func (o Optional[T]) ToMap(key string) map[string]T {
+	if o.present {
+		return map[string]T{key: o.value}
+	}
+	return nil
+}
+
 // UnmarshalJSON unmarshalls the JSON data provided as the value for the Optional. Anytime UnmarshalJSON is called, it
 // treats the Optional as having a value even though that value may still be nil or the zero value for T.
 //
@@ -319,7 +835,9 @@ func (o *Optional[T]) UnmarshalYAML(value *yaml.Node) error {
 // Value returns a driver.Value for the value of the Optional, if present, otherwise returns nil.
 //
 // Effectively, nil is always returned if a value is not present, otherwise driver.DefaultParameterConverter is used to
-// convert the value.
+// convert the value. Since driver.DefaultParameterConverter delegates to any value that implements driver.Valuer, a
+// value that is itself an Optional (e.g. Optional[Optional[int]]) is converted using its own Value method, resulting in
+// nil if the inner Optional is empty, otherwise the converted inner value.
 //
 // An error is returned if unable to return a valid driver.Value.
 func (o Optional[T]) Value() (driver.Value, error) {
@@ -329,6 +847,186 @@ func (o Optional[T]) Value() (driver.Value, error) {
 	return driver.DefaultParameterConverter.ConvertValue(o.value)
 }
 
+// WriteToBuilder writes a string representation of the value of the Optional to b, if present, preceded by sep if b is
+// not empty, otherwise does nothing. This can be useful for assembling a summary from Optional fields where only those
+// with a value present are to be included (e.g. as comma-separated values).
+//
+// The string representation of the value is the same as that returned by String.
+func (o Optional[T]) WriteToBuilder(b *strings.Builder, sep string) {
+	if !o.present {
+		return
+	}
+	if b.Len() > 0 {
+		b.WriteString(sep)
+	}
+	b.WriteString(fmt.Sprint(o.value))
+}
+
+// AppendPresent appends the values of any given Optional that has a value present to the slice that dst points to,
+// preserving their order.
+//
+// Unlike GetAny, AppendPresent allows values to be accumulated across multiple calls without allocating a new slice
+// each time. dst must not be nil.
+func AppendPresent[T any](dst *[]T, opts ...Optional[T]) {
+	for _, opt := range opts {
+		if opt.present {
+			*dst = append(*dst, opt.value)
+		}
+	}
+}
+
+// AsError returns the error within the given Optional, if present, otherwise nil.
+//
+// If the Optional has a nil error present, nil is returned. However, an error interface holding a typed nil pointer is
+// returned as-is and so will not be equal to nil.
+func AsError(opt Optional[error]) error {
+	if !opt.present {
+		return nil
+	}
+	return opt.value
+}
+
+// At returns an Optional with the element at index i within the given slice present, if i is within range, otherwise
+// an empty Optional.
+//
+// Negative indices are not supported and always result in an empty Optional.
+func At[E any](s []E, i int) Optional[E] {
+	if i < 0 || i >= len(s) {
+		return Optional[E]{}
+	}
+	return Optional[E]{
+		present: true,
+		value:   s[i],
+	}
+}
+
+// AtLeast returns the value of the given Optional, if present and not less than lo, otherwise lo.
+//
+// That is; AtLeast behaves like Optional.OrElse while also enforcing a lower bound on the value.
+func AtLeast[T cmp.Ordered](opt Optional[T], lo T) T {
+	if !opt.present {
+		return lo
+	}
+	return max(opt.value, lo)
+}
+
+// Bucket returns an Optional with the label of the bucket into which the value of the Optional provided falls present,
+// if present, otherwise an empty Optional.
+//
+// bounds must be in ascending order and labels must contain exactly one more label than bounds, where a value less than
+// bounds[0] falls into labels[0], a value greater than or equal to bounds[i-1] but less than bounds[i] falls into
+// labels[i], and a value greater than or equal to the last bound falls into the last label. For example; bounds of
+// []int{10, 100} with labels of []string{"low", "medium", "high"} results in 5 being labeled "low", 10 "medium", and
+// 100 "high".
+//
+// Bucket panics if bounds is not in ascending order or the number of labels does not equal len(bounds)+1, regardless of
+// whether the Optional has a value present, as either indicates a programming error.
+func Bucket[T cmp.Ordered](opt Optional[T], bounds []T, labels []string) Optional[string] {
+	if len(labels) != len(bounds)+1 {
+		panic(fmt.Sprintf(
+			"go-optional: expected %d labels for %d bounds but got %d",
+			len(bounds)+1,
+			len(bounds),
+			len(labels),
+		))
+	}
+	if !slices.IsSorted(bounds) {
+		panic("go-optional: bounds must be in ascending order")
+	}
+	if !opt.present {
+		return Optional[string]{}
+	}
+	i, found := slices.BinarySearch(bounds, opt.value)
+	if found {
+		// Skip past any duplicate bounds equal to the value
+		for i < len(bounds) && cmp.Compare(bounds[i], opt.value) == 0 {
+			i++
+		}
+	}
+	return Optional[string]{
+		present: true,
+		value:   labels[i],
+	}
+}
+
+// ChangedFields returns a map containing only the keys whose Optional differs between the given maps, either in
+// presence or value, with the Optional from after as the value.
+//
+// A key missing from either map is treated as having an empty Optional. Therefore, a key that has been removed (i.e.
+// only exists within before and has a value present) is included with an empty Optional, while a key with an empty
+// Optional that only exists within one of the maps is considered unchanged.
+func ChangedFields[T comparable](before, after map[string]Optional[T]) map[string]Optional[T] {
+	changed := make(map[string]Optional[T])
+	for key, opt := range after {
+		prev := before[key]
+		if opt.present != prev.present || (opt.present && opt.value != prev.value) {
+			changed[key] = opt
+		}
+	}
+	for key, opt := range before {
+		if _, ok := after[key]; !ok && opt.present {
+			changed[key] = Optional[T]{}
+		}
+	}
+	return changed
+}
+
+// Clamp returns an Optional whose value is that of the Optional provided clamped to within lo and hi (inclusive), if
+// present, otherwise an empty Optional.
+//
+// That is; a value less than lo results in lo and a value greater than hi results in hi, while any value within range
+// is unchanged. If lo is greater than hi, hi is always used.
+func Clamp[T cmp.Ordered](opt Optional[T], lo, hi T) Optional[T] {
+	if !opt.present {
+		return Optional[T]{}
+	}
+	return Optional[T]{
+		present: true,
+		value:   min(max(opt.value, lo), hi),
+	}
+}
+
+// CollectMap returns an Optional containing a map of each key within m to the value of its corresponding Optional, but
+// only if every Optional within m has a value present, otherwise an empty Optional. This can be useful for assembling a
+// record only when all of its fields are present.
+//
+// If m is empty (incl. nil), an Optional containing an empty map is returned.
+func CollectMap[K comparable, V any](m map[K]Optional[V]) Optional[map[K]V] {
+	values := make(map[K]V, len(m))
+	for key, opt := range m {
+		if !opt.present {
+			return Optional[map[K]V]{}
+		}
+		values[key] = opt.value
+	}
+	return Optional[map[K]V]{
+		present: true,
+		value:   values,
+	}
+}
+
+// CollectResults returns a slice containing an Optional for each of the given values, with the value present only if
+// its corresponding error (i.e. at the same index within errs) is nil, otherwise an empty Optional.
+//
+// The returned slice always has the same length as values. Any value without a corresponding error (i.e. when errs is
+// shorter than values) is treated as having a nil error, while any additional errors are ignored.
+func CollectResults[T any](values []T, errs []error) []Optional[T] {
+	if values == nil {
+		return nil
+	}
+	opts := make([]Optional[T], len(values))
+	for i, value := range values {
+		if i < len(errs) && errs[i] != nil {
+			continue
+		}
+		opts[i] = Optional[T]{
+			present: true,
+			value:   value,
+		}
+	}
+	return opts
+}
+
 // Compare returns the following:
 //
 //   - -1 if x has not value present and y does; or if both have a value present and the value of x is less than that of
@@ -352,6 +1050,41 @@ func Compare[T cmp.Ordered](x, y Optional[T]) int {
 	}
 }
 
+// CompareSlices compares the elements of two slices of Optional lexicographically, using Compare on each pair of
+// elements in turn, and returns the first non-zero result. If one slice is a prefix of the other, the shorter slice is
+// considered less than the longer one.
+//
+// This can be especially useful when sorting rows that are keyed by multiple Optional columns.
+func CompareSlices[T cmp.Ordered](x, y []Optional[T]) int {
+	return slices.CompareFunc(x, y, Compare[T])
+}
+
+// ConcatPresent returns a slice containing the elements of the slice within each given Optional that has a value
+// present, concatenated in order, ignoring any empty Optional.
+//
+// If no given Optional has a value present, or all such values are empty slices, nil is returned.
+func ConcatPresent[T any](opts ...Optional[[]T]) []T {
+	var values []T
+	for _, opt := range opts {
+		if opt.present {
+			values = append(values, opt.value...)
+		}
+	}
+	return values
+}
+
+// CountDistinct returns the number of unique values of any given Optional that has a value present, ignoring any empty
+// Optional.
+func CountDistinct[T comparable](opts ...Optional[T]) int {
+	seen := make(map[T]struct{})
+	for _, opt := range opts {
+		if opt.present {
+			seen[opt.value] = struct{}{}
+		}
+	}
+	return len(seen)
+}
+
 // Empty returns an Optional with no value. It's the equivalent of using a zero value Optional.
 func Empty[T any]() Optional[T] {
 	return Optional[T]{}
@@ -373,6 +1106,102 @@ func Equal[T1 any, T2 any](o1 Optional[T1], o2 Optional[T2]) bool {
 	return reflect.DeepEqual(o1.value, o2.value)
 }
 
+// EqualDeep returns whether a given Optional is equal to another.
+//
+// Two Optional are only considered equal if they are either both empty or both contain the same value. The equality of
+// the value itself is always checked using reflect.DeepEqual, making EqualDeep safe to use when T is not comparable
+// (e.g. a slice or map) as values are compared by their contents rather than their identity.
+//
+// EqualDeep behaves exactly like Equal and Optional.Equal but exists to make this guarantee explicit at the call site.
+func EqualDeep[T any](x, y Optional[T]) bool {
+	if x.present != y.present {
+		return false
+	}
+	if !x.present {
+		return true
+	}
+	return reflect.DeepEqual(x.value, y.value)
+}
+
+// EqualFloat returns whether a given Optional is approximately equal to another.
+//
+// Two Optional are only considered equal if they are either both empty or both contain values whose absolute
+// difference is less than or equal to epsilon.
+//
+// As with Compare, a NaN is considered equal to a NaN (but not to any non-NaN), and -0.0 is equal to 0.0. Infinite
+// values are only considered equal to an infinite value with the same sign.
+func EqualFloat[T ~float32 | ~float64](x, y Optional[T], epsilon T) bool {
+	if x.present != y.present {
+		return false
+	}
+	if !x.present || x.value == y.value {
+		return true
+	}
+	xNaN, yNaN := math.IsNaN(float64(x.value)), math.IsNaN(float64(y.value))
+	if xNaN || yNaN {
+		return xNaN && yNaN
+	}
+	if math.IsInf(float64(x.value), 0) || math.IsInf(float64(y.value), 0) {
+		return false
+	}
+	return math.Abs(float64(x.value)-float64(y.value)) <= float64(epsilon)
+}
+
+// FieldFillRate returns the fraction (i.e. between 0.0 and 1.0) of the given records for which the Optional returned
+// by calling field has a value present. This can be useful for quantifying the completeness of an optional field (e.g.
+// within a data quality report).
+//
+// If records is empty, 0 is returned and field is never called.
+func FieldFillRate[E any, T any](records []E, field func(record E) Optional[T]) float64 {
+	if len(records) == 0 {
+		return 0
+	}
+	var filled int
+	for _, record := range records {
+		if field(record).present {
+			filled++
+		}
+	}
+	return float64(filled) / float64(len(records))
+}
+
+// FilterMapSlice returns a slice containing an Optional for each of those provided, whose value is mapped using the
+// transform function, if present and the keep function returns true for it, otherwise an empty Optional. That is;
+// FilterMapSlice is effectively Optional.Filter followed by Map applied to each Optional in opts within a single pass,
+// preserving their order.
+//
+// Warning: While keep will only be called for each Optional in opts that has a value present, and transform only for
+// those values that are kept, that value may still be nil or the zero value for T.
+func FilterMapSlice[T, M any](opts []Optional[T], keep func(value T) bool, transform func(value T) M) []Optional[M] {
+	if opts == nil {
+		return nil
+	}
+	mapped := make([]Optional[M], len(opts))
+	for i, opt := range opts {
+		if opt.present && keep(opt.value) {
+			mapped[i] = Optional[M]{
+				present: true,
+				value:   transform(opt.value),
+			}
+		}
+	}
+	return mapped
+}
+
+// FilterPresent returns a slice containing only the given Optional that have a value present, preserving their order,
+// where possible.
+//
+// Unlike GetAny, which returns the values themselves, FilterPresent retains the Optional elements.
+func FilterPresent[T any](opts []Optional[T]) []Optional[T] {
+	var filtered []Optional[T]
+	for _, opt := range opts {
+		if opt.present {
+			filtered = append(filtered, opt)
+		}
+	}
+	return filtered
+}
+
 // Find returns the first given Optional that has a value present, otherwise an empty Optional.
 func Find[T any](opts ...Optional[T]) Optional[T] {
 	for _, opt := range opts {
@@ -395,29 +1224,280 @@ func FlatMap[T, M any](opt Optional[T], fn func(value T) Optional[M]) Optional[M
 	return fn(opt.value)
 }
 
-// GetAny returns a slice containing only the values of any given Optional that has a value present, where possible.
-func GetAny[T any](opts ...Optional[T]) []T {
-	var filtered []T
-	for _, opt := range opts {
+// FromProtoPtr returns an Optional with the value that the given pointer points to present, if not nil, otherwise an
+// empty Optional.
+//
+// This matches the explicit presence semantics of proto3 optional scalar fields, where a non-nil pointer to the zero
+// value for T is considered present. See Optional.ProtoPtr for the inverse.
+func FromProtoPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return Optional[T]{}
+	}
+	return Optional[T]{
+		present: true,
+		value:   *p,
+	}
+}
+
+// FuncMap returns a template.FuncMap containing functions that make working with Optional values within templates more
+// ergonomic. The following functions are included:
+//
+//   - getOrZero: returns the value of the Optional, if present, otherwise the zero value for its type
+//   - isPresent: returns whether the Optional has a value present
+//   - orElse: returns the value of the Optional, if present, otherwise the other value given
+//
+// Since templates are not aware of type parameters, each function accepts an Optional of any type (or a pointer to one)
+// and dispatches reflectively to its Get method. An error is returned when executing the template if any other type is
+// passed, including other types with a Get method (e.g. ReadOnly or Tracked).
+//
+// For example;
+//
+//	tmpl := template.Must(template.New("").Funcs(optional.FuncMap()).Parse(`{{ orElse .Age 0 }}`))
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"getOrZero": func(opt any) (any, error) {
+			value, _, err := reflectGet(opt)
+			if err != nil {
+				return nil, err
+			}
+			return value.Interface(), nil
+		},
+		"isPresent": func(opt any) (bool, error) {
+			_, present, err := reflectGet(opt)
+			return present, err
+		},
+		"orElse": func(opt any, other any) (any, error) {
+			value, present, err := reflectGet(opt)
+			if err != nil {
+				return nil, err
+			}
+			if !present {
+				return other, nil
+			}
+			return value.Interface(), nil
+		},
+	}
+}
+
+// GetAny returns a slice containing only the values of any given Optional that has a value present, where possible.
+func GetAny[T any](opts ...Optional[T]) []T {
+	var filtered []T
+	for _, opt := range opts {
+		if opt.present {
+			filtered = append(filtered, opt.value)
+		}
+	}
+	return filtered
+}
+
+// HighestPriority returns the Optional with the greatest Priority among the given items whose Optional has a value
+// present, otherwise an empty Optional. If more than one such item shares the greatest Priority, the first is returned.
+//
+// This can be useful when layering multiple sources of a value where each carries its own priority (e.g. flags,
+// environment variables, and configuration files).
+func HighestPriority[T any](items ...struct {
+	Priority int
+	Opt      Optional[T]
+}) Optional[T] {
+	var (
+		found    bool
+		highest  int
+		selected Optional[T]
+	)
+	for _, item := range items {
+		if item.Opt.present && (!found || item.Priority > highest) {
+			found = true
+			highest = item.Priority
+			selected = item.Opt
+		}
+	}
+	return selected
+}
+
+// IfChanged returns the given Optional only if it has a value present that differs from baseline, otherwise an empty
+// Optional.
+//
+// This can be especially useful when building a patch where any unchanged values should be omitted.
+func IfChanged[T comparable](opt Optional[T], baseline T) Optional[T] {
+	if !opt.present || opt.value == baseline {
+		return Optional[T]{}
+	}
+	return opt
+}
+
+// IndexBy returns a map containing the values of any given Optional that has a value present, keyed by the result of
+// passing each value to the given function.
+//
+// If multiple values share the same key, later values overwrite earlier ones. The returned map is never nil.
+func IndexBy[T any, K comparable](keyFn func(value T) K, opts ...Optional[T]) map[K]T {
+	index := make(map[K]T)
+	for _, opt := range opts {
+		if opt.present {
+			index[keyFn(opt.value)] = opt.value
+		}
+	}
+	return index
+}
+
+// Invert returns an empty Optional if the Optional provided has a value present, otherwise an Optional with whenAbsent
+// present. This can be useful for inverted semantics where the absence of a value implies a value (e.g. a missing
+// "disable_x" configuration key implying that "x" is enabled).
+func Invert[T any](opt Optional[T], whenAbsent T) Optional[T] {
+	if opt.present {
+		return Optional[T]{}
+	}
+	return Optional[T]{
+		present: true,
+		value:   whenAbsent,
+	}
+}
+
+// JSONEqual returns whether the given Optionals would be marshaled into identical JSON. An empty Optional is marshaled
+// as null and so two empty Optional are always considered equal.
+//
+// Unlike Equal, which compares values directly, JSONEqual ignores any differences that do not affect the JSON encoding
+// (e.g. unexported struct fields or fields tagged to be omitted).
+//
+// An error is returned if either value cannot be marshaled.
+func JSONEqual[T any](x, y Optional[T]) (bool, error) {
+	xData, err := x.MarshalJSON()
+	if err != nil {
+		return false, err
+	}
+	yData, err := y.MarshalJSON()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(xData, yData), nil
+}
+
+// LastOk returns an Optional with the value of the last given result whose error is nil present, otherwise an empty
+// Optional if every result has an error (or no results are given). This can be useful for tracking the most recent
+// successful value (e.g. the latest healthy reading).
+func LastOk[T any](results ...struct {
+	Value T
+	Err   error
+}) Optional[T] {
+	for i := len(results) - 1; i >= 0; i-- {
+		if results[i].Err == nil {
+			return Optional[T]{
+				present: true,
+				value:   results[i].Value,
+			}
+		}
+	}
+	return Optional[T]{}
+}
+
+// LoadMap returns an Optional with the value stored in the given sync.Map for the key provided present, if any and it
+// is of type V, otherwise an empty Optional.
+func LoadMap[K comparable, V any](m *sync.Map, key K) Optional[V] {
+	if loaded, ok := m.Load(key); ok {
+		if value, ok := loaded.(V); ok {
+			return Optional[V]{
+				present: true,
+				value:   value,
+			}
+		}
+	}
+	return Optional[V]{}
+}
+
+// Lookup returns an Optional with the value stored in the given map for the key provided present, if any, otherwise an
+// empty Optional.
+//
+// The value is present whenever the map contains the key, even if the value is nil or the zero value for V.
+func Lookup[K comparable, V any](m map[K]V, key K) Optional[V] {
+	value, ok := m[key]
+	return Optional[V]{
+		present: ok,
+		value:   value,
+	}
+}
+
+// Map returns an Optional whose value is mapped from the Optional provided using the given function, if present,
+// otherwise an empty Optional.
+//
+// Warning: While fn will only be called if opt has a value present, that value may still be nil or the zero value for
+// T.
+func Map[T, M any](opt Optional[T], fn func(value T) M) Optional[M] {
+	if !opt.present {
+		return Optional[M]{}
+	}
+	return Optional[M]{
+		present: true,
+		value:   fn(opt.value),
+	}
+}
+
+// MapContext returns an Optional whose value is mapped from the Optional provided using the given function, if
+// present, otherwise an empty Optional. The difference from TryMap is that the given function is also passed ctx,
+// allowing the mapping to be cancelled, and may return an error which, if not nil, will be returned by MapContext.
+//
+// Warning: While fn will only be called if opt has a value present, that value may still be nil or the zero value for
+// T.
+func MapContext[T, M any](
+	ctx context.Context,
+	opt Optional[T],
+	fn func(ctx context.Context, value T) (M, error),
+) (Optional[M], error) {
+	if !opt.present {
+		return Optional[M]{}, nil
+	}
+	mapped, err := fn(ctx, opt.value)
+	if err != nil {
+		return Optional[M]{}, err
+	}
+	return Optional[M]{
+		present: true,
+		value:   mapped,
+	}, nil
+}
+
+// MapSlice returns a slice containing an Optional for each of those provided, whose value is mapped using the given
+// function, if present, otherwise an empty Optional. That is; MapSlice is effectively Map applied to each Optional in
+// opts, preserving their order.
+//
+// Warning: While fn will only be called for each Optional in opts that has a value present, that value may still be nil
+// or the zero value for T.
+func MapSlice[T, M any](opts []Optional[T], fn func(value T) M) []Optional[M] {
+	if opts == nil {
+		return nil
+	}
+	mapped := make([]Optional[M], len(opts))
+	for i, opt := range opts {
 		if opt.present {
-			filtered = append(filtered, opt.value)
+			mapped[i] = Optional[M]{
+				present: true,
+				value:   fn(opt.value),
+			}
 		}
 	}
-	return filtered
+	return mapped
 }
 
-// Map returns an Optional whose value is mapped from the Optional provided using the given function, if present,
-// otherwise an empty Optional.
+// MergeMaps returns an Optional with a map present containing the entries of the maps of all given Optional that have
+// a value present, otherwise an empty Optional if none have a value present.
 //
-// Warning: While fn will only be called if opt has a value present, that value may still be nil or the zero value for
-// T.
-func Map[T, M any](opt Optional[T], fn func(value T) M) Optional[M] {
-	if !opt.present {
-		return Optional[M]{}
+// Layers are merged in order, so entries within later layers override those within earlier layers that share the same
+// key. A nil map within a layer is treated as an empty map. The given maps are never modified.
+func MergeMaps[K comparable, V any](layers ...Optional[map[K]V]) Optional[map[K]V] {
+	var merged map[K]V
+	for _, layer := range layers {
+		if !layer.present {
+			continue
+		}
+		if merged == nil {
+			merged = make(map[K]V, len(layer.value))
+		}
+		maps.Copy(merged, layer.value)
 	}
-	return Optional[M]{
+	if merged == nil {
+		return Optional[map[K]V]{}
+	}
+	return Optional[map[K]V]{
 		present: true,
-		value:   fn(opt.value),
+		value:   merged,
 	}
 }
 
@@ -428,7 +1508,7 @@ func MustFind[T any](opts ...Optional[T]) T {
 			return opt.value
 		}
 	}
-	panic(errNotPresent)
+	panic(ErrNotPresent)
 }
 
 // Of returns an Optional with the given value present.
@@ -439,6 +1519,67 @@ func Of[T any](value T) Optional[T] {
 	}
 }
 
+// OfAssert returns an Optional with the given value present as type T only if it can be asserted as T, otherwise an
+// empty Optional.
+//
+// As with any type assertion, a nil value can never be asserted as T, even where T is a pointer or interface type, and
+// so always results in an empty Optional. However, a typed nil pointer held by value can be asserted as its own
+// pointer type (or any interface it implements).
+func OfAssert[T any](value any) Optional[T] {
+	v, ok := value.(T)
+	return Optional[T]{
+		present: ok,
+		value:   v,
+	}
+}
+
+// OfCSVList returns an Optional with the comma-separated items within the given string present, each with any leading
+// and trailing white space removed, only if s is not empty, otherwise an empty Optional.
+//
+// Any blank items (e.g. within "a,,b") are retained as empty strings.
+func OfCSVList(s string) Optional[[]string] {
+	if s == "" {
+		return Optional[[]string]{}
+	}
+	items := strings.Split(s, ",")
+	for i, item := range items {
+		items[i] = strings.TrimSpace(item)
+	}
+	return Optional[[]string]{
+		present: true,
+		value:   items,
+	}
+}
+
+// OfFirstReady performs a non-blocking receive across all the given channels, returning an Optional with the first
+// value that is ready present, otherwise an empty Optional if no value is ready on any of them.
+//
+// Any nil or closed channel is ignored. If values are ready on more than one channel at the same time, which is
+// received from is chosen at random, as with any select statement.
+func OfFirstReady[T any](chans ...<-chan T) Optional[T] {
+	cases := make([]reflect.SelectCase, len(chans)+1)
+	for i, ch := range chans {
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+	cases[len(chans)] = reflect.SelectCase{Dir: reflect.SelectDefault}
+	for {
+		chosen, rv, ok := reflect.Select(cases)
+		if chosen == len(chans) {
+			return Optional[T]{}
+		}
+		if ok {
+			// Type assertion can only fail when T is an interface and a nil value was received
+			value, _ := rv.Interface().(T)
+			return Optional[T]{
+				present: true,
+				value:   value,
+			}
+		}
+		// Ignore closed channel from now on
+		cases[chosen].Chan = reflect.Value{}
+	}
+}
+
 // OfNillable returns an Optional with the given value present only if value is nil. That is; unlike Of, OfNillable
 // treats a nil value as absent and so the returned Optional will be empty.
 //
@@ -453,6 +1594,17 @@ func OfNillable[T any](value T) Optional[T] {
 	}
 }
 
+// OfNonNil returns an Optional with the given value present only if value is not nil. That is; unlike Of, OfNonNil
+// treats a nil value as absent and so the returned Optional will be empty.
+//
+// OfNonNil behaves exactly like OfNillable but exists to make the intent clearer when T is an interface type (e.g.
+// error), where Of(nil) would otherwise result in an Optional with a nil interface present. Since whether value is nil
+// is checked reflectively, both a nil interface and an interface holding a typed nil (e.g. a nil pointer) are treated
+// as absent.
+func OfNonNil[T any](value T) Optional[T] {
+	return OfNillable(value)
+}
+
 // OfPointer returns an Optional with the given value present as a pointer.
 func OfPointer[T any](value T) Optional[*T] {
 	return Optional[*T]{
@@ -461,6 +1613,80 @@ func OfPointer[T any](value T) Optional[*T] {
 	}
 }
 
+// OfReflect returns an Optional with the underlying value of the given reflect.Value present, unless it is invalid
+// (i.e. the zero reflect.Value) or is a nil pointer or interface, otherwise an empty Optional.
+//
+// This can be especially useful for frameworks that work reflectively and so cannot provide a type parameter. As with
+// reflect.Value.Interface, OfReflect panics if rv was obtained by accessing unexported struct fields.
+func OfReflect(rv reflect.Value) Optional[any] {
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return Optional[any]{}
+	case reflect.Interface, reflect.Pointer:
+		if rv.IsNil() {
+			return Optional[any]{}
+		}
+	default:
+		// Do nothing
+	}
+	return Optional[any]{
+		present: true,
+		value:   rv.Interface(),
+	}
+}
+
+// OfRegexpNamed returns an Optional with the text matched by the capture group with the given name within the leftmost
+// match of the regexp in s present, only if the regexp matches and the group participated in that match, otherwise an
+// empty Optional. Unlike regexp.Regexp.FindStringSubmatch, a group that matched an empty string is distinguished from
+// one that did not participate in the match (e.g. an optional group).
+//
+// If the regexp has no capture group with the given name, an empty Optional is always returned.
+func OfRegexpNamed(re *regexp.Regexp, s, name string) Optional[string] {
+	i := re.SubexpIndex(name)
+	if i < 0 {
+		return Optional[string]{}
+	}
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil || loc[2*i] < 0 {
+		return Optional[string]{}
+	}
+	return Optional[string]{
+		present: true,
+		value:   s[loc[2*i]:loc[2*i+1]],
+	}
+}
+
+// OfScan advances the given bufio.Scanner to the next token and returns an Optional with the text of that token
+// present, if any, otherwise an empty Optional (i.e. when the scanner has reached EOF or encountered an error).
+//
+// Callers should check bufio.Scanner.Err once an empty Optional is returned to differentiate between EOF and an error.
+func OfScan(sc *bufio.Scanner) Optional[string] {
+	if !sc.Scan() {
+		return Optional[string]{}
+	}
+	return Optional[string]{
+		present: true,
+		value:   sc.Text(),
+	}
+}
+
+// OfSelect blocks until either a value is received from valueCh or done is closed (or receives), returning an Optional
+// with the received value present in the former case, otherwise an empty Optional.
+//
+// If valueCh is closed before a value is received, an empty Optional is returned. If both channels are ready at the
+// same time, which is selected is chosen at random, as with any select statement.
+func OfSelect[T any](valueCh <-chan T, done <-chan struct{}) Optional[T] {
+	select {
+	case value, ok := <-valueCh:
+		return Optional[T]{
+			present: ok,
+			value:   value,
+		}
+	case <-done:
+		return Optional[T]{}
+	}
+}
+
 // OfZeroable returns an Optional with the given value present only if value does not equal the zero value for T. That
 // is; unlike Of, OfZeroable treats a value of zero as absent and so the returned Optional will be empty.
 //
@@ -475,6 +1701,121 @@ func OfZeroable[T any](value T) Optional[T] {
 	}
 }
 
+// Pairs returns a function that iterates over the given Optional, yielding the value of each along with whether it is
+// present, until the yield function returns false. The returned function is compatible with iter.Seq2.
+func Pairs[T any](opts ...Optional[T]) func(yield func(value T, present bool) bool) {
+	return func(yield func(value T, present bool) bool) {
+		for _, opt := range opts {
+			if !yield(opt.value, opt.present) {
+				return
+			}
+		}
+	}
+}
+
+// ParseCSVField returns an Optional with the value parsed from the given CSV field present, unless it's empty or cannot
+// be parsed into T, in which case the returned Optional will also be empty. See Optional.CSVField for the inverse.
+//
+// ParseCSVField supports parsing into all the same types as Optional.Scan does when given a string. Since an empty
+// field and a field that cannot be parsed both result in an empty Optional, Optional.Scan should be used directly when
+// the two need to be differentiated.
+func ParseCSVField[T any](s string) Optional[T] {
+	if s == "" {
+		return Optional[T]{}
+	}
+	var opt Optional[T]
+	if err := opt.Scan(s); err != nil {
+		return Optional[T]{}
+	}
+	return opt
+}
+
+// ParseFirst returns an Optional with the result of the first of the given parsers to successfully parse the value of
+// the Optional provided present, if present, otherwise an empty Optional. Parsers are attempted in order and an empty
+// Optional is also returned if all of them fail.
+func ParseFirst[T any](opt Optional[string], parsers ...func(s string) (T, error)) Optional[T] {
+	if !opt.present {
+		return Optional[T]{}
+	}
+	for _, parser := range parsers {
+		if value, err := parser(opt.value); err == nil {
+			return Optional[T]{
+				present: true,
+				value:   value,
+			}
+		}
+	}
+	return Optional[T]{}
+}
+
+// RecoverAsError calls the given function, recovering from any panic caused by a value being required but not present
+// (e.g. by Optional.Require or MustFind) and returning ErrNotPresent, or the error wrapping it, instead. Any other
+// panic is re-panicked.
+//
+// This can be especially useful at boundaries (e.g. within top-level handlers) where code relying on Require should
+// result in an error rather than a panic.
+func RecoverAsError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rerr, ok := r.(error); ok && errors.Is(rerr, ErrNotPresent) {
+				err = rerr
+				return
+			}
+			panic(r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+// RegisterEnum registers the given values, mapped by their ordinals, for the named string type T so that an Optional[T]
+// (or Optional[*T]) can be scanned from an int64 source containing an ordinal (e.g. where a database stores enums as
+// integers).
+//
+// Once registered, scanning an int64 source that is not mapped within values into an Optional[T] returns an error.
+// Calling RegisterEnum again for the same type replaces any previously registered values. values is copied and so can
+// be safely modified afterward. RegisterEnum is safe for concurrent use.
+//
+// T is expected to be a named type (e.g. `type Color string`) as the registered values are never consulted when
+// scanning into an Optional[string].
+func RegisterEnum[T ~string](values map[int64]T) {
+	registered := make(map[int64]string, len(values))
+	for ordinal, value := range values {
+		registered[ordinal] = string(value)
+	}
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+	enumRegistryMu.Lock()
+	defer enumRegistryMu.Unlock()
+	enumRegistry[rt] = registered
+}
+
+// RequireAll returns an error for each given named Optional that has no value present, joined using errors.Join, or nil
+// if all have a value present.
+//
+// The error for each empty Optional is taken from errs using the same name, where possible, otherwise an error wrapping
+// ErrNotPresent that includes the name is used. Errors are joined in order of name to ensure a deterministic result.
+func RequireAll[T any](errs map[string]error, opts map[string]Optional[T]) error {
+	names := make([]string, 0, len(opts))
+	for name, opt := range opts {
+		if !opt.present {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+	joined := make([]error, len(names))
+	for i, name := range names {
+		if err := errs[name]; err != nil {
+			joined[i] = err
+		} else {
+			joined[i] = fmt.Errorf("%w: %s", ErrNotPresent, name)
+		}
+	}
+	return errors.Join(joined...)
+}
+
 // RequireAny returns a slice containing only the values of any given Optional that has a value present, panicking only
 // if no Optional could be found with a value present.
 func RequireAny[T any](opts ...Optional[T]) []T {
@@ -485,11 +1826,98 @@ func RequireAny[T any](opts ...Optional[T]) []T {
 		}
 	}
 	if len(filtered) == 0 {
-		panic(errNotPresent)
+		panic(ErrNotPresent)
 	}
 	return filtered
 }
 
+// Retry calls the given function up to the number of attempts provided, returning the first Optional returned by it
+// that has a value present without an error. Otherwise, the result of the final attempt is returned, which will be
+// either an empty Optional with the error returned by fn, if any, or an empty Optional without an error.
+//
+// fn is not called if attempts is less than one, in which case an empty Optional is returned.
+func Retry[T any](attempts int, fn func() (Optional[T], error)) (Optional[T], error) {
+	var err error
+	for i := 0; i < attempts; i++ {
+		var opt Optional[T]
+		if opt, err = fn(); err == nil && opt.present {
+			return opt, nil
+		}
+	}
+	return Optional[T]{}, err
+}
+
+// Round returns an Optional with the value of the Optional provided rounded to the given number of decimal places
+// (half away from zero), if present, otherwise an empty Optional. A negative number of places rounds to the left of the
+// decimal point (e.g. -1 rounds to the nearest ten).
+//
+// If the value is NaN or infinite, or too large to be rounded to the given number of places, it is returned unchanged.
+func Round[T ~float32 | ~float64](opt Optional[T], places int) Optional[T] {
+	if !opt.present {
+		return Optional[T]{}
+	}
+	pow := math.Pow10(places)
+	scaled := float64(opt.value) * pow
+	if math.IsInf(scaled, 0) || math.IsNaN(scaled) || pow == 0 || math.IsInf(pow, 0) {
+		return opt
+	}
+	return Optional[T]{
+		present: true,
+		value:   T(math.Round(scaled) / pow),
+	}
+}
+
+// Scanners returns a slice containing each of the given Optional pointers typed as any, which can be passed to
+// sql.Rows.Scan (or sql.Row.Scan) to scan multiple columns directly into the Optionals. For example;
+//
+//	var age, height Optional[int]
+//	err := rows.Scan(optional.Scanners(&age, &height)...)
+//
+// Since each element is a pointer, the Optionals are populated by Optional.Scan.
+func Scanners[T any](opts ...*Optional[T]) []any {
+	scanners := make([]any, len(opts))
+	for i, opt := range opts {
+		scanners[i] = opt
+	}
+	return scanners
+}
+
+// Select returns a if useFirst is true, otherwise b.
+//
+// No consideration is given to whether either Optional has a value present; Select simply makes the intent of choosing
+// between two Optional clearer at the call site than an inline conditional.
+func Select[T any](useFirst bool, a, b Optional[T]) Optional[T] {
+	if useFirst {
+		return a
+	}
+	return b
+}
+
+// Summary returns a human-readable summary of the presence of values within the given Optionals, intended as a
+// debugging aid for bulk data.
+//
+// The summary contains the number of Optionals with a value present out of the total (e.g. "3/5 present") followed by a
+// mask (e.g. "P.PP.") where each character represents the Optional at the same index, being "P" if it has a value
+// present, otherwise ".". The mask is omitted if no Optionals are given.
+func Summary[T any](opts ...Optional[T]) string {
+	if len(opts) == 0 {
+		return "0/0 present"
+	}
+	var (
+		mask    strings.Builder
+		present int
+	)
+	for _, opt := range opts {
+		if opt.present {
+			mask.WriteByte('P')
+			present++
+		} else {
+			mask.WriteByte('.')
+		}
+	}
+	return fmt.Sprintf("%d/%d present %s", present, len(opts), mask.String())
+}
+
 // TryFlatMap calls the given function and returns the Optional returned by it if the Optional provided has a value
 // present, otherwise an empty Optional is returned. The difference from FlatMap is that the given function may return
 // an error which, if not nil, will be returned by TryFlatMap.
@@ -523,6 +1951,22 @@ func TryMap[T, M any](opt Optional[T], fn func(value T) (M, error)) (Optional[M]
 	}, nil
 }
 
+// Zip3 returns an Optional with a struct value containing the values of each of the given Optionals present, only if
+// all three have a value present, otherwise an empty Optional.
+func Zip3[A, B, C any](a Optional[A], b Optional[B], c Optional[C]) (zipped Optional[struct {
+	A A
+	B B
+	C C
+}]) {
+	if a.present && b.present && c.present {
+		zipped.present = true
+		zipped.value.A = a.value
+		zipped.value.B = b.value
+		zipped.value.C = c.value
+	}
+	return zipped
+}
+
 // fmtConversionErr returns a formatted error for when a value scanned from a database cannot be converted to its
 // destination's type.
 func fmtConversionErr(src any, srcStr string, dest any, destKind reflect.Kind, err error) error {
@@ -566,6 +2010,53 @@ func isZero(rv reflect.Value) bool {
 	return !rv.IsValid() || rv.IsZero()
 }
 
+// lookupEnum returns the values registered using RegisterEnum for the given type, if any.
+func lookupEnum(rt reflect.Type) (map[int64]string, bool) {
+	enumRegistryMu.RLock()
+	defer enumRegistryMu.RUnlock()
+	values, ok := enumRegistry[rt]
+	return values, ok
+}
+
+// parseDuration parses the given string as a time.Duration using time.ParseDuration (e.g. "1h30m"), falling back to
+// parsing it as an integer number of nanoseconds.
+//
+// An error is returned if s cannot be parsed as either.
+func parseDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err == nil {
+		return d, nil
+	}
+	if ns, nsErr := strconv.ParseInt(s, 10, 64); nsErr == nil {
+		return time.Duration(ns), nil
+	}
+	return 0, err
+}
+
+// reflectGet reflectively calls the Get method of the given Optional, which may be a pointer to an Optional, returning
+// its value and whether it is present.
+//
+// An error is returned if opt is neither an Optional nor a pointer to one, even if it has a Get method of its own (e.g.
+// ReadOnly).
+func reflectGet(opt any) (reflect.Value, bool, error) {
+	rv := reflect.ValueOf(opt)
+	if !rv.IsValid() {
+		return reflect.Value{}, false, errors.New("go-optional: cannot get value of nil")
+	}
+	rt := rv.Type()
+	if rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt.PkgPath() != optionalPkgPath || !strings.HasPrefix(rt.Name(), "Optional[") {
+		return reflect.Value{}, false, fmt.Errorf("go-optional: cannot get value of unsupported type %T", opt)
+	}
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		rv = reflect.Zero(rt)
+	}
+	out := rv.MethodByName("Get").Call(nil)
+	return out[0], out[1].Bool(), nil
+}
+
 // scanBool assigns the src bool value provided from a database driver into the given dest pointer.
 //
 // The value that dest points to can be any type but only the following are supported (incl. pointers and convertible
@@ -635,6 +2126,8 @@ func scanBool(src bool, dest any) (bool, error) {
 //   - int, int8, int16, int32, int64
 //   - string
 //   - uint, uint8, uint16, uint32, uint64
+//   - time.Duration (parsed using time.ParseDuration or as nanoseconds)
+//   - other slices (parsed from JSON array text, e.g. ["a","b"])
 //   - any
 //
 // src is copied when assigned directly to dest in order to retain its contents.
@@ -651,6 +2144,14 @@ func scanBytes(src []byte, dest any) (bool, error) {
 	case *sql.RawBytes:
 		*d = src
 		return true, nil
+	case *time.Duration:
+		s := string(src)
+		dur, err := parseDuration(s)
+		if err != nil {
+			return false, fmtConversionErr(src, s, dest, reflect.Int64, err)
+		}
+		*d = dur
+		return true, nil
 	case *any:
 		*d = bytes.Clone(src)
 		return true, nil
@@ -699,6 +2200,14 @@ func scanBytes(src []byte, dest any) (bool, error) {
 			dv.SetBytes(bytes.Clone(src))
 			return true, nil
 		}
+		if bytes.HasPrefix(bytes.TrimSpace(src), []byte("[")) {
+			sv := reflect.New(dv.Type())
+			if err = json.Unmarshal(src, sv.Interface()); err != nil {
+				return false, fmtConversionErr(src, string(src), dest, dv.Kind(), err)
+			}
+			dv.Set(sv.Elem())
+			return true, nil
+		}
 	case reflect.String:
 		dv.SetString(string(src))
 		return true, nil
@@ -722,6 +2231,7 @@ func scanBytes(src []byte, dest any) (bool, error) {
 // types):
 //
 //   - float32, float64
+//   - bool (only if src is 0 or 1)
 //   - int, int8, int16, int32, int64
 //   - string
 //   - uint, uint8, uint16, uint32, uint64
@@ -762,6 +2272,11 @@ func scanFloat(src float64, dest any) (bool, error) {
 			dv.Set(pv)
 		}
 		return present, err
+	case reflect.Bool:
+		if src == 0 || src == 1 {
+			dv.SetBool(src == 1)
+			return true, nil
+		}
 	case reflect.Float32:
 		var fv float64
 		s := strconv.FormatFloat(src, 'g', -1, 64)
@@ -811,8 +2326,12 @@ func scanFloat(src float64, dest any) (bool, error) {
 //   - string
 //   - uint, uint8, uint16, uint32, uint64
 //   - []byte
+//   - time.Duration (as nanoseconds)
 //   - any
 //
+// If the type of dest has been registered using RegisterEnum, src is treated as an ordinal and the registered value is
+// assigned instead, with an error being returned if src has not been registered.
+//
 // An error is returned if dest is not a pointer, is nil, or src could not be assigned to dest.
 func scanInt(src int64, dest any) (bool, error) {
 	switch d := dest.(type) {
@@ -828,6 +2347,9 @@ func scanInt(src int64, dest any) (bool, error) {
 	case *sql.RawBytes:
 		*d = strconv.AppendInt([]byte(*d)[:0], src, 10)
 		return true, nil
+	case *time.Duration:
+		*d = time.Duration(src)
+		return true, nil
 	case *any:
 		*d = src
 		return true, nil
@@ -874,6 +2396,15 @@ func scanInt(src int64, dest any) (bool, error) {
 			return true, nil
 		}
 	case reflect.String:
+		if values, ok := lookupEnum(dv.Type()); ok {
+			ev, ok := values[src]
+			if !ok {
+				s := strconv.FormatInt(src, 10)
+				return false, fmtConversionErr(src, s, dest, dv.Kind(), errors.New("ordinal not registered"))
+			}
+			dv.SetString(ev)
+			return true, nil
+		}
 		dv.SetString(strconv.FormatInt(src, 10))
 		return true, nil
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
@@ -901,6 +2432,8 @@ func scanInt(src int64, dest any) (bool, error) {
 //   - int, int8, int16, int32, int64
 //   - uint, uint8, uint16, uint32, uint64
 //   - []byte
+//   - time.Duration (parsed using time.ParseDuration or as nanoseconds)
+//   - other slices (parsed from JSON array text, e.g. ["a","b"])
 //   - any
 //
 // An error is returned if dest is not a pointer, is nil, or src could not be assigned to dest.
@@ -915,6 +2448,13 @@ func scanString(src string, dest any) (bool, error) {
 	case *sql.RawBytes:
 		*d = append((*d)[:0], src...)
 		return true, nil
+	case *time.Duration:
+		dur, err := parseDuration(src)
+		if err != nil {
+			return false, fmtConversionErr(src, src, dest, reflect.Int64, err)
+		}
+		*d = dur
+		return true, nil
 	case *any:
 		*d = src
 		return true, nil
@@ -960,6 +2500,14 @@ func scanString(src string, dest any) (bool, error) {
 			dv.SetBytes([]byte(src))
 			return true, nil
 		}
+		if strings.HasPrefix(strings.TrimSpace(src), "[") {
+			sv := reflect.New(dv.Type())
+			if err = json.Unmarshal([]byte(src), sv.Interface()); err != nil {
+				return false, fmtConversionErr(src, src, dest, dv.Kind(), err)
+			}
+			dv.Set(sv.Elem())
+			return true, nil
+		}
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		var uv uint64
 		if uv, err = strconv.ParseUint(src, 10, dv.Type().Bits()); err != nil {
@@ -1030,6 +2578,96 @@ func scanTime(src time.Time, dest any) (bool, error) {
 	return false, fmtUnsupportedScanTypeErr(src, dest, dv.Kind())
 }
 
+// scanUint assigns the src uint64 value provided from a database driver into the given dest pointer.
+//
+// The value that dest points to can be any type but only the following are supported (incl. pointers and convertible
+// types):
+//
+//   - uint, uint8, uint16, uint32, uint64
+//   - bool (only if src is 0 or 1)
+//   - float32, float64
+//   - int, int8, int16, int32, int64
+//   - string
+//   - []byte
+//   - any
+//
+// An error is returned if dest is not a pointer, is nil, or src could not be assigned to dest.
+func scanUint(src uint64, dest any) (bool, error) {
+	switch d := dest.(type) {
+	case *uint64:
+		*d = src
+		return true, nil
+	case *string:
+		*d = strconv.FormatUint(src, 10)
+		return true, nil
+	case *[]byte:
+		*d = strconv.AppendUint(nil, src, 10)
+		return true, nil
+	case *sql.RawBytes:
+		*d = strconv.AppendUint([]byte(*d)[:0], src, 10)
+		return true, nil
+	case *any:
+		*d = src
+		return true, nil
+	}
+	dv, err := indirectDestPtr(dest)
+	if err != nil {
+		return false, err
+	}
+	if tryFastSetDest(src, dv) {
+		return true, nil
+	}
+	switch dv.Kind() {
+	case reflect.Pointer:
+		pv := reflect.New(dv.Type().Elem())
+		var present bool
+		if present, err = scanUint(src, pv.Interface()); err == nil {
+			dv.Set(pv)
+		}
+		return present, err
+	case reflect.Bool:
+		if src == 0 || src == 1 {
+			dv.SetBool(src == 1)
+			return true, nil
+		}
+	case reflect.Float32, reflect.Float64:
+		var fv float64
+		s := strconv.FormatUint(src, 10)
+		if fv, err = strconv.ParseFloat(s, dv.Type().Bits()); err != nil {
+			return false, fmtConversionErr(src, s, dest, dv.Kind(), err)
+		}
+		dv.SetFloat(fv)
+		return true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var iv int64
+		s := strconv.FormatUint(src, 10)
+		if iv, err = strconv.ParseInt(s, 10, dv.Type().Bits()); err != nil {
+			return false, fmtConversionErr(src, s, dest, dv.Kind(), err)
+		}
+		dv.SetInt(iv)
+		return true, nil
+	case reflect.Slice:
+		if dv.Type().Elem().Kind() == reflect.Uint8 {
+			dv.SetBytes(strconv.AppendUint(nil, src, 10))
+			return true, nil
+		}
+	case reflect.String:
+		dv.SetString(strconv.FormatUint(src, 10))
+		return true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		var uv uint64
+		s := strconv.FormatUint(src, 10)
+		if uv, err = strconv.ParseUint(s, 10, dv.Type().Bits()); err != nil {
+			return false, fmtConversionErr(src, s, dest, dv.Kind(), err)
+		}
+		dv.SetUint(uv)
+		return true, nil
+	default:
+		// Do nothing
+	}
+	return false, fmtUnsupportedScanTypeErr(src, dest, dv.Kind())
+}
+
 // tryFastSetDest attempts to assign the value of src directly to the given destination value, where possible, and
 // returns whether it was successful.
 //
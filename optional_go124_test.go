@@ -0,0 +1,97 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build go1.24
+
+package optional
+
+import (
+	"github.com/neocotic/go-optional/internal/test"
+	"testing"
+)
+
+func TestOptional_MarshalJSON_omitZero(t *testing.T) {
+	type Example struct {
+		Int    Optional[int]    `json:"int,omitzero"`
+		String Optional[string] `json:"string,omitzero"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on struct with empty Optionals": optionalMarshalJSONTC{
+			value:      Example{},
+			expectJSON: `{}`,
+		},
+		"on struct with non-empty Optionals and zero field values": optionalMarshalJSONTC{
+			value: Example{
+				Int:    Of(0),
+				String: Of(""),
+			},
+			expectJSON: `{"int":0,"string":""}`,
+		},
+		"on struct with non-empty Optionals and non-zero field values": optionalMarshalJSONTC{
+			value: Example{
+				Int:    Of(123),
+				String: Of("abc"),
+			},
+			expectJSON: `{"int":123,"string":"abc"}`,
+		},
+		"on struct with mixed Optionals": optionalMarshalJSONTC{
+			value: Example{
+				String: Of("abc"),
+			},
+			expectJSON: `{"string":"abc"}`,
+		},
+	})
+}
+
+func TestOmitZero_MarshalJSON_omitZero(t *testing.T) {
+	type Example struct {
+		Int    OmitZero[int]    `json:"int,omitzero"`
+		String OmitZero[string] `json:"string,omitzero"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on struct with empty OmitZeros": optionalMarshalJSONTC{
+			value:      Example{},
+			expectJSON: `{}`,
+		},
+		"on struct with non-empty OmitZeros and zero field values": optionalMarshalJSONTC{
+			value: Example{
+				Int:    OmitZero[int](Of(0)),
+				String: OmitZero[string](Of("")),
+			},
+			expectJSON: `{}`,
+		},
+		"on struct with non-empty OmitZeros and non-zero field values": optionalMarshalJSONTC{
+			value: Example{
+				Int:    OmitZero[int](Of(123)),
+				String: OmitZero[string](Of("abc")),
+			},
+			expectJSON: `{"int":123,"string":"abc"}`,
+		},
+		"on struct with mixed OmitZeros": optionalMarshalJSONTC{
+			value: Example{
+				Int:    OmitZero[int](Of(0)),
+				String: OmitZero[string](Of("abc")),
+			},
+			expectJSON: `{"string":"abc"}`,
+		},
+	})
+}
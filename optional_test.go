@@ -21,5608 +21,11654 @@
 package optional
 
 import (
+	"bufio"
 	"cmp"
+	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/csv"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"github.com/neocotic/go-optional/internal/test"
 	ptrs "github.com/neocotic/go-pointers"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
+	"io"
 	"math"
+	"math/big"
+	"net/url"
+	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"text/template"
 	"time"
 	"unicode"
 )
 
-func BenchmarkOptional_Equal(b *testing.B) {
+func BenchmarkOptional_AddQuery(b *testing.B) {
+	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		Of(123).Equal(Of(123))
+		values := url.Values{}
+		opt.AddQuery(values, "abc")
 	}
 }
 
-type optionalEqualTC[T any] struct {
+type optionalAddQueryTC[T any] struct {
 	opt    Optional[T]
-	other  Optional[T]
-	expect bool
+	values url.Values
+	key    string
+	expect url.Values
 	test.Control
 }
 
-func (tc optionalEqualTC[T]) Test(t *testing.T) {
-	actual := tc.opt.Equal(tc.other)
-	assert.Equal(t, tc.expect, actual, "unexpected equality")
+func (tc optionalAddQueryTC[T]) Test(t *testing.T) {
+	tc.opt.AddQuery(tc.values, tc.key)
+	assert.Equal(t, tc.expect, tc.values, "unexpected values")
 }
 
-func TestOptional_Equal(t *testing.T) {
+func TestOptional_AddQuery(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional given empty int Optional": optionalEqualTC[int]{
-			opt:    Empty[int](),
-			other:  Empty[int](),
-			expect: true,
-		},
-		"on empty int Optional given non-empty int Optional with zero value": optionalEqualTC[int]{
+		"on empty int Optional": optionalAddQueryTC[int]{
 			opt:    Empty[int](),
-			other:  Of(0),
-			expect: false,
-		},
-		"on non-empty int Optional with zero value given empty int Optional": optionalEqualTC[int]{
-			opt:    Of(0),
-			other:  Empty[int](),
-			expect: false,
-		},
-		"on non-empty int Optional with zero value given non-empty int Optional with zero value": optionalEqualTC[int]{
-			opt:    Of(0),
-			other:  Of(0),
-			expect: true,
+			values: url.Values{"q": {"xyz"}},
+			key:    "page",
+			expect: url.Values{"q": {"xyz"}},
 		},
-		"on non-empty int Optional with zero value given non-empty int Optional with non-zero value": optionalEqualTC[int]{
+		"on non-empty int Optional with zero value": optionalAddQueryTC[int]{
 			opt:    Of(0),
-			other:  Of(123),
-			expect: false,
+			values: url.Values{"q": {"xyz"}},
+			key:    "page",
+			expect: url.Values{"page": {"0"}, "q": {"xyz"}},
 		},
-		"on non-empty int Optional with non-zero value given non-empty int Optional with zero value": optionalEqualTC[int]{
+		"on non-empty int Optional with non-zero value": optionalAddQueryTC[int]{
 			opt:    Of(123),
-			other:  Of(0),
-			expect: false,
+			values: url.Values{"q": {"xyz"}},
+			key:    "page",
+			expect: url.Values{"page": {"123"}, "q": {"xyz"}},
 		},
-		"on non-empty int Optional with non-zero value given non-empty int Optional with equal non-zero value": optionalEqualTC[int]{
-			opt:    Of(123),
-			other:  Of(123),
-			expect: true,
+		"on empty string Optional": optionalAddQueryTC[string]{
+			opt:    Empty[string](),
+			values: url.Values{"q": {"xyz"}},
+			key:    "sort",
+			expect: url.Values{"q": {"xyz"}},
 		},
-		"on non-empty int Optional with non-zero value given non-empty int Optional with similar but not equal non-zero value": optionalEqualTC[int]{
-			opt:    Of(123),
-			other:  Of(-123),
-			expect: false,
+		"on non-empty string Optional with zero value": optionalAddQueryTC[string]{
+			opt:    Of(""),
+			values: url.Values{"q": {"xyz"}},
+			key:    "sort",
+			expect: url.Values{"q": {"xyz"}, "sort": {""}},
 		},
-		"on non-empty int Optional with non-zero value given empty int Optional": optionalEqualTC[int]{
-			opt:    Of(123),
-			other:  Empty[int](),
-			expect: false,
+		"on non-empty string Optional with non-zero value": optionalAddQueryTC[string]{
+			opt:    Of("abc"),
+			values: url.Values{"q": {"xyz"}},
+			key:    "sort",
+			expect: url.Values{"q": {"xyz"}, "sort": {"abc"}},
 		},
-		"on empty string Optional given empty string Optional": optionalEqualTC[string]{
-			opt:    Empty[string](),
-			other:  Empty[string](),
-			expect: true,
+		// Other test cases...
+		"on non-empty string Optional given existing key": optionalAddQueryTC[string]{
+			opt:    Of("abc"),
+			values: url.Values{"q": {"xyz"}},
+			key:    "q",
+			expect: url.Values{"q": {"xyz", "abc"}},
 		},
-		"on empty string Optional given non-empty string Optional with zero value": optionalEqualTC[string]{
-			opt:    Empty[string](),
-			other:  Of(""),
-			expect: false,
+	})
+}
+
+func BenchmarkOptional_AssignTo(b *testing.B) {
+	opt := Of(123)
+	var dst int
+	for i := 0; i < b.N; i++ {
+		_ = opt.AssignTo(&dst)
+	}
+}
+
+type optionalAssignToTC[T any] struct {
+	opt         Optional[T]
+	dst         *T
+	expect      bool
+	expectValue T
+	test.Control
+}
+
+func (tc optionalAssignToTC[T]) Test(t *testing.T) {
+	assigned := tc.opt.AssignTo(tc.dst)
+	assert.Equal(t, tc.expect, assigned, "unexpected assignment")
+	if tc.dst != nil {
+		assert.Equal(t, tc.expectValue, *tc.dst, "unexpected value")
+	}
+}
+
+func TestOptional_AssignTo(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalAssignToTC[int]{
+			opt:         Empty[int](),
+			dst:         ptrs.Value(-1),
+			expect:      false,
+			expectValue: -1,
 		},
-		"on non-empty string Optional with zero value given empty string Optional": optionalEqualTC[string]{
-			opt:    Of(""),
-			other:  Empty[string](),
-			expect: false,
+		"on non-empty int Optional with zero value": optionalAssignToTC[int]{
+			opt:         Of(0),
+			dst:         ptrs.Value(-1),
+			expect:      true,
+			expectValue: 0,
 		},
-		"on non-empty string Optional with zero value given non-empty string Optional with zero value": optionalEqualTC[string]{
-			opt:    Of(""),
-			other:  Of(""),
-			expect: true,
+		"on non-empty int Optional with non-zero value": optionalAssignToTC[int]{
+			opt:         Of(123),
+			dst:         ptrs.Value(-1),
+			expect:      true,
+			expectValue: 123,
 		},
-		"on non-empty string Optional with zero value given non-empty string Optional with non-zero value": optionalEqualTC[string]{
-			opt:    Of(""),
-			other:  Of("abc"),
-			expect: false,
+		"on empty string Optional": optionalAssignToTC[string]{
+			opt:         Empty[string](),
+			dst:         ptrs.Value("xyz"),
+			expect:      false,
+			expectValue: "xyz",
 		},
-		"on non-empty string Optional with non-zero value given non-empty string Optional with zero value": optionalEqualTC[string]{
-			opt:    Of("abc"),
-			other:  Of(""),
-			expect: false,
+		"on non-empty string Optional with zero value": optionalAssignToTC[string]{
+			opt:         Of(""),
+			dst:         ptrs.Value("xyz"),
+			expect:      true,
+			expectValue: "",
 		},
-		"on non-empty string Optional with non-zero value given non-empty string Optional with equal non-zero value": optionalEqualTC[string]{
-			opt:    Of("abc"),
-			other:  Of("abc"),
-			expect: true,
+		"on non-empty string Optional with non-zero value": optionalAssignToTC[string]{
+			opt:         Of("abc"),
+			dst:         ptrs.Value("xyz"),
+			expect:      true,
+			expectValue: "abc",
 		},
-		"on non-empty string Optional with non-zero value given non-empty string Optional with similar but not equal non-zero value": optionalEqualTC[string]{
-			opt:    Of("abc"),
-			other:  Of("ABC"),
+		// Other test cases...
+		"on non-empty int Optional given nil pointer": optionalAssignToTC[int]{
+			opt:    Of(123),
+			dst:    nil,
 			expect: false,
 		},
-		"on non-empty string Optional with non-zero value given empty string Optional": optionalEqualTC[string]{
-			opt:    Of("abc"),
-			other:  Empty[string](),
+		"on empty int Optional given nil pointer": optionalAssignToTC[int]{
+			opt:    Empty[int](),
+			dst:    nil,
 			expect: false,
 		},
-		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_Filter(b *testing.B) {
-	isPos := func(value int) bool {
-		return value >= 0
-	}
+func BenchmarkOptional_CSVField(b *testing.B) {
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		_ = opt.Filter(isPos)
+		_ = opt.CSVField()
 	}
 }
 
-type optionalFilterTC[T any] struct {
+type optionalCSVFieldTC[T any] struct {
 	opt    Optional[T]
-	fn     func(value T) bool
-	expect Optional[T]
+	expect string
 	test.Control
 }
 
-func (tc optionalFilterTC[T]) Test(t *testing.T) {
-	actual := tc.opt.Filter(tc.fn)
-	assert.Equal(t, tc.expect, actual, "unexpected optional")
+func (tc optionalCSVFieldTC[T]) Test(t *testing.T) {
+	actual := tc.opt.CSVField()
+	assert.Equal(t, tc.expect, actual, "unexpected CSV field")
 }
 
-func TestOptional_Filter(t *testing.T) {
-	isPos := func(value int) bool {
-		return value >= 0
-	}
-	isLower := func(value string) bool {
-		return !strings.ContainsFunc(value, unicode.IsUpper)
-	}
-
+func TestOptional_CSVField(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalFilterTC[int]{
+		"on empty int Optional": optionalCSVFieldTC[int]{
 			opt:    Empty[int](),
-			fn:     isPos,
-			expect: Empty[int](),
-		},
-		"on non-empty int Optional with non-zero non-matching value": optionalFilterTC[int]{
-			opt:    Of(-123),
-			fn:     isPos,
-			expect: Empty[int](),
+			expect: "",
 		},
-		"on non-empty int Optional with zero matching value": optionalFilterTC[int]{
+		"on non-empty int Optional with zero value": optionalCSVFieldTC[int]{
 			opt:    Of(0),
-			fn:     isPos,
-			expect: Of(0),
+			expect: "0",
 		},
-		"on non-empty int Optional with non-zero matching value": optionalFilterTC[int]{
+		"on non-empty int Optional with non-zero value": optionalCSVFieldTC[int]{
 			opt:    Of(123),
-			fn:     isPos,
-			expect: Of(123),
+			expect: "123",
 		},
-		"on empty string Optional": optionalFilterTC[string]{
+		"on empty string Optional": optionalCSVFieldTC[string]{
 			opt:    Empty[string](),
-			fn:     isLower,
-			expect: Empty[string](),
-		},
-		"on non-empty string Optional with non-zero non-matching value": optionalFilterTC[string]{
-			opt:    Of("ABC"),
-			fn:     isLower,
-			expect: Empty[string](),
+			expect: "",
 		},
-		"on non-empty string Optional with zero value": optionalFilterTC[string]{
+		"on non-empty string Optional with zero value": optionalCSVFieldTC[string]{
 			opt:    Of(""),
-			fn:     isLower,
-			expect: Of(""),
+			expect: "",
 		},
-		"on non-empty string Optional with non-zero value": optionalFilterTC[string]{
+		"on non-empty string Optional with non-zero value": optionalCSVFieldTC[string]{
 			opt:    Of("abc"),
-			fn:     isLower,
-			expect: Of("abc"),
+			expect: "abc",
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_Get(b *testing.B) {
+func BenchmarkOptional_Chan(b *testing.B) {
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		_, _ = opt.Get()
+		for range opt.Chan() {
+		}
 	}
 }
 
-type optionalGetTC[T any] struct {
-	opt           Optional[T]
-	expectPresent bool
-	expectValue   T
+type optionalChanTC[T any] struct {
+	opt          Optional[T]
+	expectValues []T
 	test.Control
 }
 
-func (tc optionalGetTC[T]) Test(t *testing.T) {
-	value, present := tc.opt.Get()
-	assert.Equal(t, tc.expectValue, value, "unexpected value")
-	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+func (tc optionalChanTC[T]) Test(t *testing.T) {
+	var values []T
+	for value := range tc.opt.Chan() {
+		values = append(values, value)
+	}
+	assert.Equal(t, tc.expectValues, values, "unexpected values")
 }
 
-func TestOptional_Get(t *testing.T) {
+func TestOptional_Chan(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalGetTC[int]{
-			opt:           Empty[int](),
-			expectPresent: false,
-			expectValue:   0,
+		"on empty int Optional": optionalChanTC[int]{
+			opt:          Empty[int](),
+			expectValues: nil,
 		},
-		"on non-empty int Optional with zero value": optionalGetTC[int]{
-			opt:           Of(0),
-			expectPresent: true,
-			expectValue:   0,
+		"on non-empty int Optional with zero value": optionalChanTC[int]{
+			opt:          Of(0),
+			expectValues: []int{0},
 		},
-		"on non-empty int Optional with non-zero value": optionalGetTC[int]{
-			opt:           Of(123),
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty int Optional with non-zero value": optionalChanTC[int]{
+			opt:          Of(123),
+			expectValues: []int{123},
 		},
-		"on empty string Optional": optionalGetTC[string]{
-			opt:           Empty[string](),
-			expectPresent: false,
-			expectValue:   "",
+		"on empty string Optional": optionalChanTC[string]{
+			opt:          Empty[string](),
+			expectValues: nil,
 		},
-		"on non-empty string Optional with zero value": optionalGetTC[string]{
-			opt:           Of(""),
-			expectPresent: true,
-			expectValue:   "",
+		"on non-empty string Optional with zero value": optionalChanTC[string]{
+			opt:          Of(""),
+			expectValues: []string{""},
 		},
-		"on non-empty string Optional with non-zero value": optionalGetTC[string]{
-			opt:           Of("abc"),
-			expectPresent: true,
-			expectValue:   "abc",
+		"on non-empty string Optional with non-zero value": optionalChanTC[string]{
+			opt:          Of("abc"),
+			expectValues: []string{"abc"},
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_IfPresent(b *testing.B) {
+func BenchmarkOptional_Close(b *testing.B) {
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		opt.IfPresent(func(_ int) {})
+		if err := opt.Close(func(_ int) error { return nil }); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
 
-type optionalIfPresentTC[T any] struct {
+type optionalCloseTC[T any] struct {
 	opt             Optional[T]
+	err             error
 	expectCallCount uint
+	expectError     bool
 	test.Control
 }
 
-func (tc optionalIfPresentTC[T]) Test(t *testing.T) {
+func (tc optionalCloseTC[T]) Test(t *testing.T) {
 	var callCount uint
-	tc.opt.IfPresent(func(value T) {
+	err := tc.opt.Close(func(value T) error {
 		callCount++
 		assert.Equal(t, tc.opt.value, value)
+		return tc.err
 	})
+	if tc.expectError {
+		assert.ErrorIs(t, err, tc.err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
 	assert.Equalf(t, tc.expectCallCount, callCount, "expected function to be called %v times", tc.expectCallCount)
 }
 
-func TestOptional_IfPresent(t *testing.T) {
+func TestOptional_Close(t *testing.T) {
+	err := errors.New("already closed")
+
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalIfPresentTC[int]{
+		"on empty int Optional": optionalCloseTC[int]{
 			opt:             Empty[int](),
 			expectCallCount: 0,
 		},
-		"on non-empty int Optional with zero value": optionalIfPresentTC[int]{
+		"on empty int Optional given erroneous function": optionalCloseTC[int]{
+			opt:             Empty[int](),
+			err:             err,
+			expectCallCount: 0,
+		},
+		"on non-empty int Optional with zero value": optionalCloseTC[int]{
 			opt:             Of(0),
 			expectCallCount: 1,
 		},
-		"on non-empty int Optional with non-zero value": optionalIfPresentTC[int]{
+		"on non-empty int Optional with non-zero value": optionalCloseTC[int]{
 			opt:             Of(123),
 			expectCallCount: 1,
 		},
-		"on empty string Optional": optionalIfPresentTC[string]{
+		"on non-empty int Optional given erroneous function": optionalCloseTC[int]{
+			opt:             Of(123),
+			err:             err,
+			expectCallCount: 1,
+			expectError:     true,
+		},
+		"on empty string Optional": optionalCloseTC[string]{
 			opt:             Empty[string](),
 			expectCallCount: 0,
 		},
-		"on non-empty string Optional with zero value": optionalIfPresentTC[string]{
-			opt:             Of(""),
+		"on non-empty string Optional with non-zero value": optionalCloseTC[string]{
+			opt:             Of("abc"),
 			expectCallCount: 1,
 		},
-		"on non-empty string Optional with non-zero value": optionalIfPresentTC[string]{
+		"on non-empty string Optional given erroneous function": optionalCloseTC[string]{
 			opt:             Of("abc"),
+			err:             err,
 			expectCallCount: 1,
+			expectError:     true,
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_IsEmpty(b *testing.B) {
+func BenchmarkOptional_Display(b *testing.B) {
 	opt := Of(123)
+	fn := func(value int) string {
+		return fmt.Sprintf("$%d.00", value)
+	}
 	for i := 0; i < b.N; i++ {
-		_ = opt.IsEmpty()
+		_ = opt.Display(fn, "N/A")
 	}
 }
 
-type optionalIsEmptyTC[T any] struct {
+type optionalDisplayTC[T any] struct {
 	opt    Optional[T]
-	expect bool
+	fn     func(value T) string
+	absent string
+	expect string
 	test.Control
 }
 
-func (tc optionalIsEmptyTC[T]) Test(t *testing.T) {
-	absent := tc.opt.IsEmpty()
-	assert.Equal(t, tc.expect, absent, "unexpected value absence")
+func (tc optionalDisplayTC[T]) Test(t *testing.T) {
+	s := tc.opt.Display(tc.fn, tc.absent)
+	assert.Equal(t, tc.expect, s, "unexpected string")
 }
 
-func TestOptional_IsEmpty(t *testing.T) {
+func TestOptional_Display(t *testing.T) {
+	currency := func(value int) string {
+		return fmt.Sprintf("$%d.%02d", value/100, value%100)
+	}
+	quote := func(value string) string {
+		return "'" + value + "'"
+	}
+
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalIsEmptyTC[int]{
+		"on empty int Optional": optionalDisplayTC[int]{
 			opt:    Empty[int](),
-			expect: true,
+			fn:     currency,
+			absent: "N/A",
+			expect: "N/A",
 		},
-		"on non-empty int Optional with zero value": optionalIsEmptyTC[int]{
+		"on non-empty int Optional with zero value": optionalDisplayTC[int]{
 			opt:    Of(0),
-			expect: false,
+			fn:     currency,
+			absent: "N/A",
+			expect: "$0.00",
 		},
-		"on non-empty int Optional with non-zero value": optionalIsEmptyTC[int]{
-			opt:    Of(123),
-			expect: false,
+		"on non-empty int Optional with non-zero value": optionalDisplayTC[int]{
+			opt:    Of(12345),
+			fn:     currency,
+			absent: "N/A",
+			expect: "$123.45",
 		},
-		"on empty string Optional": optionalIsEmptyTC[string]{
+		"on empty string Optional": optionalDisplayTC[string]{
 			opt:    Empty[string](),
-			expect: true,
+			fn:     quote,
+			absent: "N/A",
+			expect: "N/A",
 		},
-		"on non-empty string Optional with zero value": optionalIsEmptyTC[string]{
+		"on non-empty string Optional with zero value": optionalDisplayTC[string]{
 			opt:    Of(""),
-			expect: false,
+			fn:     quote,
+			absent: "N/A",
+			expect: "''",
 		},
-		"on non-empty string Optional with non-zero value": optionalIsEmptyTC[string]{
+		"on non-empty string Optional with non-zero value": optionalDisplayTC[string]{
 			opt:    Of("abc"),
-			expect: false,
+			fn:     quote,
+			absent: "N/A",
+			expect: "'abc'",
 		},
 		// Other test cases...
+		"on empty int Optional given empty absent string": optionalDisplayTC[int]{
+			opt:    Empty[int](),
+			fn:     currency,
+			absent: "",
+			expect: "",
+		},
 	})
 }
 
-func BenchmarkOptional_IsPresent(b *testing.B) {
-	opt := Of(123)
+func BenchmarkOptional_EnvLine(b *testing.B) {
+	opt := Of("abc def")
 	for i := 0; i < b.N; i++ {
-		_ = opt.IsPresent()
+		_ = opt.EnvLine("KEY")
 	}
 }
 
-type optionalIsPresentTC[T any] struct {
+type optionalEnvLineTC[T any] struct {
 	opt    Optional[T]
-	expect bool
+	key    string
+	expect string
 	test.Control
 }
 
-func (tc optionalIsPresentTC[T]) Test(t *testing.T) {
-	present := tc.opt.IsPresent()
-	assert.Equal(t, tc.expect, present, "unexpected value presence")
+func (tc optionalEnvLineTC[T]) Test(t *testing.T) {
+	actual := tc.opt.EnvLine(tc.key)
+	assert.Equal(t, tc.expect, actual, "unexpected line")
 }
 
-func TestOptional_IsPresent(t *testing.T) {
+func TestOptional_EnvLine(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalIsPresentTC[int]{
+		"on empty int Optional": optionalEnvLineTC[int]{
 			opt:    Empty[int](),
-			expect: false,
+			key:    "PORT",
+			expect: "",
 		},
-		"on non-empty int Optional with zero value": optionalIsPresentTC[int]{
+		"on non-empty int Optional with zero value": optionalEnvLineTC[int]{
 			opt:    Of(0),
-			expect: true,
+			key:    "PORT",
+			expect: "PORT=0\n",
 		},
-		"on non-empty int Optional with non-zero value": optionalIsPresentTC[int]{
-			opt:    Of(123),
-			expect: true,
+		"on non-empty int Optional with non-zero value": optionalEnvLineTC[int]{
+			opt:    Of(8080),
+			key:    "PORT",
+			expect: "PORT=8080\n",
 		},
-		"on empty string Optional": optionalIsPresentTC[string]{
+		"on empty string Optional": optionalEnvLineTC[string]{
 			opt:    Empty[string](),
-			expect: false,
+			key:    "NAME",
+			expect: "",
 		},
-		"on non-empty string Optional with zero value": optionalIsPresentTC[string]{
+		"on non-empty string Optional with zero value": optionalEnvLineTC[string]{
 			opt:    Of(""),
-			expect: true,
+			key:    "NAME",
+			expect: "NAME=\n",
 		},
-		"on non-empty string Optional with non-zero value": optionalIsPresentTC[string]{
+		"on non-empty string Optional with simple value": optionalEnvLineTC[string]{
 			opt:    Of("abc"),
-			expect: true,
+			key:    "NAME",
+			expect: "NAME=abc\n",
+		},
+		"on non-empty string Optional with value containing spaces": optionalEnvLineTC[string]{
+			opt:    Of("abc def"),
+			key:    "NAME",
+			expect: "NAME=\"abc def\"\n",
 		},
 		// Other test cases...
+		"on non-empty string Optional with value containing double quotes": optionalEnvLineTC[string]{
+			opt:    Of(`say "hi"`),
+			key:    "NAME",
+			expect: `NAME="say \"hi\""` + "\n",
+		},
+		"on non-empty string Optional with value containing newline": optionalEnvLineTC[string]{
+			opt:    Of("abc\ndef"),
+			key:    "NAME",
+			expect: `NAME="abc\ndef"` + "\n",
+		},
+		"on non-empty string Optional with value containing hash": optionalEnvLineTC[string]{
+			opt:    Of("abc#def"),
+			key:    "NAME",
+			expect: `NAME="abc#def"` + "\n",
+		},
+		"on non-empty string Optional with value containing dollar": optionalEnvLineTC[string]{
+			opt:    Of("$HOME"),
+			key:    "NAME",
+			expect: `NAME="$HOME"` + "\n",
+		},
+		"on non-empty string Optional with value containing backslash": optionalEnvLineTC[string]{
+			opt:    Of(`C:\dir`),
+			key:    "NAME",
+			expect: `NAME="C:\\dir"` + "\n",
+		},
+		"on non-empty string Optional with value containing tab": optionalEnvLineTC[string]{
+			opt:    Of("abc\tdef"),
+			key:    "NAME",
+			expect: `NAME="abc\tdef"` + "\n",
+		},
+		"on non-empty bool Optional with zero value": optionalEnvLineTC[bool]{
+			opt:    Of(false),
+			key:    "DEBUG",
+			expect: "DEBUG=false\n",
+		},
 	})
 }
 
-func BenchmarkOptional_IsZero(b *testing.B) {
-	opt := Of(123)
+func BenchmarkOptional_Equal(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		_ = opt.IsZero()
+		Of(123).Equal(Of(123))
 	}
 }
 
-type optionalIsZeroTC[T any] struct {
+type optionalEqualTC[T any] struct {
 	opt    Optional[T]
+	other  Optional[T]
 	expect bool
 	test.Control
 }
 
-func (tc optionalIsZeroTC[T]) Test(t *testing.T) {
-	absent := tc.opt.IsZero()
-	assert.Equal(t, tc.expect, absent, "unexpected value absence")
+func (tc optionalEqualTC[T]) Test(t *testing.T) {
+	actual := tc.opt.Equal(tc.other)
+	assert.Equal(t, tc.expect, actual, "unexpected equality")
 }
 
-func TestOptional_IsZero(t *testing.T) {
+func TestOptional_Equal(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalIsZeroTC[int]{
+		"on empty int Optional given empty int Optional": optionalEqualTC[int]{
 			opt:    Empty[int](),
+			other:  Empty[int](),
 			expect: true,
 		},
-		"on non-empty int Optional with zero value": optionalIsZeroTC[int]{
+		"on empty int Optional given non-empty int Optional with zero value": optionalEqualTC[int]{
+			opt:    Empty[int](),
+			other:  Of(0),
+			expect: false,
+		},
+		"on non-empty int Optional with zero value given empty int Optional": optionalEqualTC[int]{
 			opt:    Of(0),
+			other:  Empty[int](),
 			expect: false,
 		},
-		"on non-empty int Optional with non-zero value": optionalIsZeroTC[int]{
+		"on non-empty int Optional with zero value given non-empty int Optional with zero value": optionalEqualTC[int]{
+			opt:    Of(0),
+			other:  Of(0),
+			expect: true,
+		},
+		"on non-empty int Optional with zero value given non-empty int Optional with non-zero value": optionalEqualTC[int]{
+			opt:    Of(0),
+			other:  Of(123),
+			expect: false,
+		},
+		"on non-empty int Optional with non-zero value given non-empty int Optional with zero value": optionalEqualTC[int]{
 			opt:    Of(123),
+			other:  Of(0),
 			expect: false,
 		},
-		"on empty string Optional": optionalIsZeroTC[string]{
+		"on non-empty int Optional with non-zero value given non-empty int Optional with equal non-zero value": optionalEqualTC[int]{
+			opt:    Of(123),
+			other:  Of(123),
+			expect: true,
+		},
+		"on non-empty int Optional with non-zero value given non-empty int Optional with similar but not equal non-zero value": optionalEqualTC[int]{
+			opt:    Of(123),
+			other:  Of(-123),
+			expect: false,
+		},
+		"on non-empty int Optional with non-zero value given empty int Optional": optionalEqualTC[int]{
+			opt:    Of(123),
+			other:  Empty[int](),
+			expect: false,
+		},
+		"on empty string Optional given empty string Optional": optionalEqualTC[string]{
 			opt:    Empty[string](),
+			other:  Empty[string](),
 			expect: true,
 		},
-		"on non-empty string Optional with zero value": optionalIsZeroTC[string]{
+		"on empty string Optional given non-empty string Optional with zero value": optionalEqualTC[string]{
+			opt:    Empty[string](),
+			other:  Of(""),
+			expect: false,
+		},
+		"on non-empty string Optional with zero value given empty string Optional": optionalEqualTC[string]{
 			opt:    Of(""),
+			other:  Empty[string](),
 			expect: false,
 		},
-		"on non-empty string Optional with non-zero value": optionalIsZeroTC[string]{
+		"on non-empty string Optional with zero value given non-empty string Optional with zero value": optionalEqualTC[string]{
+			opt:    Of(""),
+			other:  Of(""),
+			expect: true,
+		},
+		"on non-empty string Optional with zero value given non-empty string Optional with non-zero value": optionalEqualTC[string]{
+			opt:    Of(""),
+			other:  Of("abc"),
+			expect: false,
+		},
+		"on non-empty string Optional with non-zero value given non-empty string Optional with zero value": optionalEqualTC[string]{
+			opt:    Of("abc"),
+			other:  Of(""),
+			expect: false,
+		},
+		"on non-empty string Optional with non-zero value given non-empty string Optional with equal non-zero value": optionalEqualTC[string]{
+			opt:    Of("abc"),
+			other:  Of("abc"),
+			expect: true,
+		},
+		"on non-empty string Optional with non-zero value given non-empty string Optional with similar but not equal non-zero value": optionalEqualTC[string]{
+			opt:    Of("abc"),
+			other:  Of("ABC"),
+			expect: false,
+		},
+		"on non-empty string Optional with non-zero value given empty string Optional": optionalEqualTC[string]{
 			opt:    Of("abc"),
+			other:  Empty[string](),
 			expect: false,
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_MarshalJSON(b *testing.B) {
-	opt := Of(123)
+func BenchmarkOptional_FillGet(b *testing.B) {
+	opt := Empty[int]()
+	fn := func() int {
+		return 123
+	}
 	for i := 0; i < b.N; i++ {
-		if _, err := json.Marshal(opt); err != nil {
-			b.Fatal(err)
-		}
+		_, _ = opt.FillGet(fn)
 	}
 }
 
-type optionalMarshalJSONTC struct {
-	value      any
-	expectJSON string
+type optionalFillGetTC[T any] struct {
+	opt             Optional[T]
+	fn              func() T
+	expectCallCount uint
+	expectValue     T
 	test.Control
 }
 
-func (tc optionalMarshalJSONTC) Test(t *testing.T) {
-	b, err := json.Marshal(tc.value)
-	assert.NoError(t, err, "unexpected error")
-	assert.Equal(t, tc.expectJSON, string(b), "unexpected JSON")
+func (tc optionalFillGetTC[T]) Test(t *testing.T) {
+	original := tc.opt
+	var callCount uint
+	value, filled := tc.opt.FillGet(func() T {
+		callCount++
+		return tc.fn()
+	})
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, Of(tc.expectValue), filled, "unexpected filled Optional")
+	assert.Equal(t, original, tc.opt, "unexpected modification of Optional")
+	assert.Equalf(t, tc.expectCallCount, callCount, "expected function to be called %v times", tc.expectCallCount)
 }
 
-func TestOptional_MarshalJSON(t *testing.T) {
-	type Example struct {
-		Int           Optional[int]     `json:"int"`
-		String        Optional[string]  `json:"string"`
-		IntOmit       Optional[int]     `json:"intOmit,omitempty"`
-		StringOmit    Optional[string]  `json:"stringOmit,omitempty"`
-		IntOmitPtr    *Optional[int]    `json:"intOmitPtr,omitempty"`
-		StringOmitPtr *Optional[string] `json:"stringOmitPtr,omitempty"`
-	}
-
+func TestOptional_FillGet(t *testing.T) {
 	test.RunCases(t, test.Cases{
-		"on empty int Optional": optionalMarshalJSONTC{
-			value:      Empty[int](),
-			expectJSON: `null`,
-		},
-		"on non-empty int Optional with zero value": optionalMarshalJSONTC{
-			value:      Of(0),
-			expectJSON: `0`,
-		},
-		"on non-empty int Optional with non-zero value": optionalMarshalJSONTC{
-			value:      Of(123),
-			expectJSON: `123`,
-		},
-		"on empty string Optional": optionalMarshalJSONTC{
-			value:      Empty[string](),
-			expectJSON: `null`,
+		// Test cases for documented examples
+		"on empty int Optional": optionalFillGetTC[int]{
+			opt:             Empty[int](),
+			fn:              func() int { return -1 },
+			expectCallCount: 1,
+			expectValue:     -1,
 		},
-		"on non-empty string Optional with zero value": optionalMarshalJSONTC{
-			value:      Of(""),
-			expectJSON: `""`,
+		"on non-empty int Optional with zero value": optionalFillGetTC[int]{
+			opt:             Of(0),
+			fn:              func() int { return -1 },
+			expectCallCount: 0,
+			expectValue:     0,
 		},
-		"on non-empty string Optional with non-zero value": optionalMarshalJSONTC{
-			value:      Of("abc"),
-			expectJSON: `"abc"`,
+		"on non-empty int Optional with non-zero value": optionalFillGetTC[int]{
+			opt:             Of(123),
+			fn:              func() int { return -1 },
+			expectCallCount: 0,
+			expectValue:     123,
 		},
-		"on struct with empty Optionals": optionalMarshalJSONTC{
-			value:      Example{},
-			expectJSON: `{"int":null,"string":null,"intOmit":null,"stringOmit":null}`,
-			// json omitempty option does not apply to zero value structs
+		"on empty string Optional": optionalFillGetTC[string]{
+			opt:             Empty[string](),
+			fn:              func() string { return "unknown" },
+			expectCallCount: 1,
+			expectValue:     "unknown",
 		},
-		"on struct with non-empty Optionals and zero field values": optionalMarshalJSONTC{
-			value: Example{
-				Int:           Of(0),
-				String:        Of(""),
-				IntOmit:       Of(0),
-				StringOmit:    Of(""),
-				IntOmitPtr:    ptrs.Value(Of(0)),
-				StringOmitPtr: ptrs.Value(Of("")),
-			},
-			expectJSON: `{"int":0,"string":"","intOmit":0,"stringOmit":"","intOmitPtr":0,"stringOmitPtr":""}`,
+		"on non-empty string Optional with zero value": optionalFillGetTC[string]{
+			opt:             Of(""),
+			fn:              func() string { return "unknown" },
+			expectCallCount: 0,
+			expectValue:     "",
 		},
-		"on struct with non-empty Optionals and non-zero field values": optionalMarshalJSONTC{
-			value: Example{
-				Int:           Of(123),
-				String:        Of("abc"),
-				IntOmit:       Of(123),
-				StringOmit:    Of("abc"),
-				IntOmitPtr:    ptrs.Value(Of(123)),
-				StringOmitPtr: ptrs.Value(Of("abc")),
-			},
-			expectJSON: `{"int":123,"string":"abc","intOmit":123,"stringOmit":"abc","intOmitPtr":123,"stringOmitPtr":"abc"}`,
+		"on non-empty string Optional with non-zero value": optionalFillGetTC[string]{
+			opt:             Of("abc"),
+			fn:              func() string { return "unknown" },
+			expectCallCount: 0,
+			expectValue:     "abc",
 		},
+		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_MarshalXML(b *testing.B) {
+func BenchmarkOptional_Filter(b *testing.B) {
+	isPos := func(value int) bool {
+		return value >= 0
+	}
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		if _, err := xml.Marshal(opt); err != nil {
-			b.Fatal(err)
-		}
+		_ = opt.Filter(isPos)
 	}
 }
 
-type optionalMarshalXMLTC struct {
-	value     any
-	expectXML string
+type optionalFilterTC[T any] struct {
+	opt    Optional[T]
+	fn     func(value T) bool
+	expect Optional[T]
 	test.Control
 }
 
-func (tc optionalMarshalXMLTC) Test(t *testing.T) {
-	b, err := xml.Marshal(tc.value)
-	assert.NoError(t, err, "unexpected error")
-	assert.Equal(t, tc.expectXML, string(b), "unexpected XML")
+func (tc optionalFilterTC[T]) Test(t *testing.T) {
+	actual := tc.opt.Filter(tc.fn)
+	assert.Equal(t, tc.expect, actual, "unexpected optional")
 }
 
-func TestOptional_MarshalXML(t *testing.T) {
-	type Example struct {
-		Int           Optional[int]     `xml:"int"`
-		String        Optional[string]  `xml:"string"`
-		IntOmit       Optional[int]     `xml:"intOmit,omitempty"`
-		StringOmit    Optional[string]  `xml:"stringOmit,omitempty"`
-		IntOmitPtr    *Optional[int]    `xml:"intOmitPtr,omitempty"`
-		StringOmitPtr *Optional[string] `xml:"stringOmitPtr,omitempty"`
+func TestOptional_Filter(t *testing.T) {
+	isPos := func(value int) bool {
+		return value >= 0
+	}
+	isLower := func(value string) bool {
+		return !strings.ContainsFunc(value, unicode.IsUpper)
 	}
 
 	test.RunCases(t, test.Cases{
-		"on empty int Optional": optionalMarshalXMLTC{
-			value:     Empty[int](),
-			expectXML: ``,
-		},
-		"on non-empty int Optional with zero value": optionalMarshalXMLTC{
-			value:     Of(0),
-			expectXML: `<int>0</int>`,
+		// Test cases for documented examples
+		"on empty int Optional": optionalFilterTC[int]{
+			opt:    Empty[int](),
+			fn:     isPos,
+			expect: Empty[int](),
 		},
-		"on non-empty int Optional with non-zero value": optionalMarshalXMLTC{
-			value:     Of(123),
-			expectXML: `<int>123</int>`,
+		"on non-empty int Optional with non-zero non-matching value": optionalFilterTC[int]{
+			opt:    Of(-123),
+			fn:     isPos,
+			expect: Empty[int](),
 		},
-		"on empty string Optional": optionalMarshalXMLTC{
-			value:     Empty[string](),
-			expectXML: ``,
+		"on non-empty int Optional with zero matching value": optionalFilterTC[int]{
+			opt:    Of(0),
+			fn:     isPos,
+			expect: Of(0),
 		},
-		"on non-empty string Optional with zero value": optionalMarshalXMLTC{
-			value:     Of(""),
-			expectXML: `<string></string>`,
+		"on non-empty int Optional with non-zero matching value": optionalFilterTC[int]{
+			opt:    Of(123),
+			fn:     isPos,
+			expect: Of(123),
 		},
-		"on non-empty string Optional with non-zero value": optionalMarshalXMLTC{
-			value:     Of("abc"),
-			expectXML: `<string>abc</string>`,
+		"on empty string Optional": optionalFilterTC[string]{
+			opt:    Empty[string](),
+			fn:     isLower,
+			expect: Empty[string](),
 		},
-		"on struct with empty Optionals": optionalMarshalXMLTC{
-			value:     Example{},
-			expectXML: `<Example></Example>`,
+		"on non-empty string Optional with non-zero non-matching value": optionalFilterTC[string]{
+			opt:    Of("ABC"),
+			fn:     isLower,
+			expect: Empty[string](),
 		},
-		"on struct with non-empty Optionals and zero field values": optionalMarshalXMLTC{
-			value: Example{
-				Int:           Of(0),
-				String:        Of(""),
-				IntOmit:       Of(0),
-				StringOmit:    Of(""),
-				IntOmitPtr:    ptrs.Value(Of(0)),
-				StringOmitPtr: ptrs.Value(Of("")),
-			},
-			expectXML: `<Example><int>0</int><string></string><intOmit>0</intOmit><stringOmit></stringOmit><intOmitPtr>0</intOmitPtr><stringOmitPtr></stringOmitPtr></Example>`,
+		"on non-empty string Optional with zero value": optionalFilterTC[string]{
+			opt:    Of(""),
+			fn:     isLower,
+			expect: Of(""),
 		},
-		"on struct with non-empty Optionals and non-zero field values": optionalMarshalXMLTC{
-			value: Example{
-				Int:           Of(123),
-				String:        Of("abc"),
-				IntOmit:       Of(123),
-				StringOmit:    Of("abc"),
-				IntOmitPtr:    ptrs.Value(Of(123)),
-				StringOmitPtr: ptrs.Value(Of("abc")),
-			},
-			expectXML: `<Example><int>123</int><string>abc</string><intOmit>123</intOmit><stringOmit>abc</stringOmit><intOmitPtr>123</intOmitPtr><stringOmitPtr>abc</stringOmitPtr></Example>`,
+		"on non-empty string Optional with non-zero value": optionalFilterTC[string]{
+			opt:    Of("abc"),
+			fn:     isLower,
+			expect: Of("abc"),
 		},
+		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_MarshalYAML(b *testing.B) {
+func BenchmarkOptional_Frozen(b *testing.B) {
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		if _, err := yaml.Marshal(opt); err != nil {
-			b.Fatal(err)
-		}
+		_ = opt.Frozen()
 	}
 }
 
-type optionalMarshalYAMLTC struct {
-	value      any
-	expectYAML string
+type optionalFrozenTC[T any] struct {
+	opt Optional[T]
 	test.Control
 }
 
-func (tc optionalMarshalYAMLTC) Test(t *testing.T) {
-	b, err := yaml.Marshal(tc.value)
-	assert.NoError(t, err, "unexpected error")
-	assert.Equal(t, tc.expectYAML, strings.TrimSpace(string(b)), "unexpected YAML")
-}
-
-func TestOptional_MarshalYAML(t *testing.T) {
-	type Example struct {
-		Int           Optional[int]     `yaml:"int"`
-		String        Optional[string]  `yaml:"string"`
-		IntOmit       Optional[int]     `yaml:"intOmit,omitempty"`
-		StringOmit    Optional[string]  `yaml:"stringOmit,omitempty"`
-		IntOmitPtr    *Optional[int]    `yaml:"intOmitPtr,omitempty"`
-		StringOmitPtr *Optional[string] `yaml:"stringOmitPtr,omitempty"`
+func (tc optionalFrozenTC[T]) Test(t *testing.T) {
+	opt := tc.opt
+	frozen := opt.Frozen()
+	assert.Equal(t, tc.opt, frozen, "unexpected Optional")
+	if err := opt.UnmarshalJSON([]byte("null")); !assert.NoError(t, err, "unexpected error") {
+		return
 	}
+	assert.Equal(t, tc.opt, frozen, "unexpected Optional after mutating original")
+}
 
+func TestOptional_Frozen(t *testing.T) {
 	test.RunCases(t, test.Cases{
-		"on empty int Optional": optionalMarshalYAMLTC{
-			value:      Empty[int](),
-			expectYAML: `null`,
-		},
-		"on non-empty int Optional with zero value": optionalMarshalYAMLTC{
-			value:      Of(0),
-			expectYAML: `0`,
-		},
-		"on non-empty int Optional with non-zero value": optionalMarshalYAMLTC{
-			value:      Of(123),
-			expectYAML: `123`,
-		},
-		"on empty string Optional": optionalMarshalYAMLTC{
-			value:      Empty[string](),
-			expectYAML: `null`,
+		// Test cases for documented examples
+		"on empty int Optional": optionalFrozenTC[int]{
+			opt: Empty[int](),
 		},
-		"on non-empty string Optional with zero value": optionalMarshalYAMLTC{
-			value:      Of(""),
-			expectYAML: `""`,
+		"on non-empty int Optional with zero value": optionalFrozenTC[int]{
+			opt: Of(0),
 		},
-		"on non-empty string Optional with non-zero value": optionalMarshalYAMLTC{
-			value:      Of("abc"),
-			expectYAML: `abc`,
+		"on non-empty int Optional with non-zero value": optionalFrozenTC[int]{
+			opt: Of(123),
 		},
-		"on struct with empty Optionals": optionalMarshalYAMLTC{
-			value: Example{},
-			expectYAML: `int: null
-string: null`,
+		"on empty string Optional": optionalFrozenTC[string]{
+			opt: Empty[string](),
 		},
-		"on struct with non-empty Optionals and zero field values": optionalMarshalYAMLTC{
-			value: Example{
-				Int:           Of(0),
-				String:        Of(""),
-				IntOmit:       Of(0),
-				StringOmit:    Of(""),
-				IntOmitPtr:    ptrs.Value(Of(0)),
-				StringOmitPtr: ptrs.Value(Of("")),
-			},
-			expectYAML: `int: 0
-string: ""
-intOmit: 0
-stringOmit: ""
-intOmitPtr: 0
-stringOmitPtr: ""`,
+		"on non-empty string Optional with zero value": optionalFrozenTC[string]{
+			opt: Of(""),
 		},
-		"on struct with non-empty Optionals and non-zero field values": optionalMarshalYAMLTC{
-			value: Example{
-				Int:           Of(123),
-				String:        Of("abc"),
-				IntOmit:       Of(123),
-				StringOmit:    Of("abc"),
-				IntOmitPtr:    ptrs.Value(Of(123)),
-				StringOmitPtr: ptrs.Value(Of("abc")),
-			},
-			expectYAML: `int: 123
-string: abc
-intOmit: 123
-stringOmit: abc
-intOmitPtr: 123
-stringOmitPtr: abc`,
+		"on non-empty string Optional with non-zero value": optionalFrozenTC[string]{
+			opt: Of("abc"),
 		},
+		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_OrElse(b *testing.B) {
+func BenchmarkOptional_GaugeValue(b *testing.B) {
 	opt := Of(123)
+	valueFn := func(value int) float64 {
+		return float64(value)
+	}
 	for i := 0; i < b.N; i++ {
-		_ = opt.OrElse(-1)
+		_, _ = opt.GaugeValue(valueFn)
 	}
 }
 
-type optionalOrElseTC[T any] struct {
-	opt    Optional[T]
-	other  T
-	expect T
+type optionalGaugeValueTC[T any] struct {
+	opt           Optional[T]
+	valueFn       func(value T) float64
+	expectPresent bool
+	expectValue   float64
 	test.Control
 }
 
-func (tc optionalOrElseTC[T]) Test(t *testing.T) {
-	value := tc.opt.OrElse(tc.other)
-	assert.Equal(t, tc.expect, value, "unexpected value")
+func (tc optionalGaugeValueTC[T]) Test(t *testing.T) {
+	value, present := tc.opt.GaugeValue(tc.valueFn)
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestOptional_OrElse(t *testing.T) {
-	defaultInt := -1
-	defaultString := "unknown"
+func TestOptional_GaugeValue(t *testing.T) {
+	intToFloat := func(value int) float64 {
+		return float64(value)
+	}
+	strLen := func(value string) float64 {
+		return float64(len(value))
+	}
 
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalOrElseTC[int]{
-			opt:    Empty[int](),
-			other:  defaultInt,
-			expect: defaultInt,
+		"on empty int Optional": optionalGaugeValueTC[int]{
+			opt:           Empty[int](),
+			valueFn:       intToFloat,
+			expectPresent: false,
+			expectValue:   0,
 		},
-		"on non-empty int Optional with zero value": optionalOrElseTC[int]{
-			opt:    Of(0),
-			other:  defaultInt,
-			expect: 0,
+		"on non-empty int Optional with zero value": optionalGaugeValueTC[int]{
+			opt:           Of(0),
+			valueFn:       intToFloat,
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"on non-empty int Optional with non-zero value": optionalOrElseTC[int]{
-			opt:    Of(123),
-			other:  defaultInt,
-			expect: 123,
+		"on non-empty int Optional with non-zero value": optionalGaugeValueTC[int]{
+			opt:           Of(123),
+			valueFn:       intToFloat,
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"on empty string Optional": optionalOrElseTC[string]{
-			opt:    Empty[string](),
-			other:  defaultString,
-			expect: defaultString,
+		"on empty string Optional": optionalGaugeValueTC[string]{
+			opt:           Empty[string](),
+			valueFn:       strLen,
+			expectPresent: false,
+			expectValue:   0,
 		},
-		"on non-empty string Optional with zero value": optionalOrElseTC[string]{
-			opt:    Of(""),
-			other:  defaultString,
-			expect: "",
+		"on non-empty string Optional with zero value": optionalGaugeValueTC[string]{
+			opt:           Of(""),
+			valueFn:       strLen,
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"on non-empty string Optional with non-zero value": optionalOrElseTC[string]{
-			opt:    Of("abc"),
-			other:  defaultString,
-			expect: "abc",
+		"on non-empty string Optional with non-zero value": optionalGaugeValueTC[string]{
+			opt:           Of("abc"),
+			valueFn:       strLen,
+			expectPresent: true,
+			expectValue:   3,
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_OrElseGet(b *testing.B) {
+func BenchmarkOptional_Get(b *testing.B) {
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		_ = opt.OrElseGet(func() int {
-			return -1
-		})
+		_, _ = opt.Get()
 	}
 }
 
-type optionalOrElseGetTC[T any] struct {
-	opt    Optional[T]
-	other  func() T
-	expect T
+type optionalGetTC[T any] struct {
+	opt           Optional[T]
+	expectPresent bool
+	expectValue   T
 	test.Control
 }
 
-func (tc optionalOrElseGetTC[T]) Test(t *testing.T) {
-	value := tc.opt.OrElseGet(tc.other)
-	assert.Equal(t, tc.expect, value, "unexpected value")
+func (tc optionalGetTC[T]) Test(t *testing.T) {
+	value, present := tc.opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestOptional_OrElseGet(t *testing.T) {
-	defaultInt := -1
-	defaultIntFunc := func() int {
-		return defaultInt
-	}
-	defaultString := "unknown"
-	defaultStringFunc := func() string {
-		return defaultString
-	}
-
+func TestOptional_Get(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalOrElseGetTC[int]{
-			opt:    Empty[int](),
-			other:  defaultIntFunc,
-			expect: defaultInt,
+		"on empty int Optional": optionalGetTC[int]{
+			opt:           Empty[int](),
+			expectPresent: false,
+			expectValue:   0,
 		},
-		"on non-empty int Optional with zero value": optionalOrElseGetTC[int]{
-			opt:    Of(0),
-			other:  defaultIntFunc,
-			expect: 0,
+		"on non-empty int Optional with zero value": optionalGetTC[int]{
+			opt:           Of(0),
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"on non-empty int Optional with non-zero value": optionalOrElseGetTC[int]{
-			opt:    Of(123),
-			other:  defaultIntFunc,
-			expect: 123,
+		"on non-empty int Optional with non-zero value": optionalGetTC[int]{
+			opt:           Of(123),
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"on empty string Optional": optionalOrElseGetTC[string]{
-			opt:    Empty[string](),
-			other:  defaultStringFunc,
-			expect: defaultString,
+		"on empty string Optional": optionalGetTC[string]{
+			opt:           Empty[string](),
+			expectPresent: false,
+			expectValue:   "",
 		},
-		"on non-empty string Optional with zero value": optionalOrElseGetTC[string]{
-			opt:    Of(""),
-			other:  defaultStringFunc,
-			expect: "",
+		"on non-empty string Optional with zero value": optionalGetTC[string]{
+			opt:           Of(""),
+			expectPresent: true,
+			expectValue:   "",
 		},
-		"on non-empty string Optional with non-zero value": optionalOrElseGetTC[string]{
-			opt:    Of("abc"),
-			other:  defaultStringFunc,
-			expect: "abc",
+		"on non-empty string Optional with non-zero value": optionalGetTC[string]{
+			opt:           Of("abc"),
+			expectPresent: true,
+			expectValue:   "abc",
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_OrElseTryGet(b *testing.B) {
-	defaultFunc := func() (int, error) {
-		return -1, nil
-	}
+func BenchmarkOptional_GetOrNotFound(b *testing.B) {
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		if _, err := opt.OrElseTryGet(defaultFunc); err != nil {
+		if _, err := opt.GetOrNotFound(); err != nil {
 			b.Fatal(err)
 		}
 	}
 }
 
-type optionalOrElseTryGetTC[T any] struct {
+type optionalGetOrNotFoundTC[T any] struct {
 	opt         Optional[T]
-	other       func() (T, error)
-	expectError bool
+	expectError error
 	expectValue T
 	test.Control
 }
 
-func (tc optionalOrElseTryGetTC[T]) Test(t *testing.T) {
-	value, err := tc.opt.OrElseTryGet(tc.other)
-	if tc.expectError {
-		assert.Error(t, err, "expected error")
+func (tc optionalGetOrNotFoundTC[T]) Test(t *testing.T) {
+	value, err := tc.opt.GetOrNotFound()
+	if tc.expectError != nil {
+		assert.ErrorIs(t, err, tc.expectError, "expected error")
 	} else {
 		assert.NoError(t, err, "unexpected error")
 	}
 	assert.Equal(t, tc.expectValue, value, "unexpected value")
 }
 
-func TestOptional_OrElseTryGet(t *testing.T) {
-	defaultInt := -1
-	defaultIntFunc := func() (int, error) {
-		return defaultInt, nil
-	}
-	defaultString := "unknown"
-	defaultStringFunc := func(err error) func() (string, error) {
-		return func() (string, error) {
-			if err != nil {
-				return "", err
-			}
-			return defaultString, nil
-		}
-	}
-
+func TestOptional_GetOrNotFound(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalOrElseTryGetTC[int]{
+		"on empty int Optional": optionalGetOrNotFoundTC[int]{
 			opt:         Empty[int](),
-			other:       defaultIntFunc,
-			expectValue: defaultInt,
+			expectError: ErrNotPresent,
 		},
-		"on non-empty int Optional with zero value": optionalOrElseTryGetTC[int]{
+		"on non-empty int Optional with zero value": optionalGetOrNotFoundTC[int]{
 			opt:         Of(0),
-			other:       defaultIntFunc,
 			expectValue: 0,
 		},
-		"on non-empty int Optional with non-zero value": optionalOrElseTryGetTC[int]{
+		"on non-empty int Optional with non-zero value": optionalGetOrNotFoundTC[int]{
 			opt:         Of(123),
-			other:       defaultIntFunc,
 			expectValue: 123,
 		},
-		"on empty string Optional": optionalOrElseTryGetTC[string]{
+		"on empty string Optional": optionalGetOrNotFoundTC[string]{
 			opt:         Empty[string](),
-			other:       defaultStringFunc(nil),
-			expectValue: defaultString,
+			expectError: ErrNotPresent,
 		},
-		"on non-empty string Optional with zero value": optionalOrElseTryGetTC[string]{
+		"on non-empty string Optional with zero value": optionalGetOrNotFoundTC[string]{
 			opt:         Of(""),
-			other:       defaultStringFunc(nil),
 			expectValue: "",
 		},
-		"on non-empty string Optional with non-zero value": optionalOrElseTryGetTC[string]{
+		"on non-empty string Optional with non-zero value": optionalGetOrNotFoundTC[string]{
 			opt:         Of("abc"),
-			other:       defaultStringFunc(nil),
 			expectValue: "abc",
 		},
-		"on empty string Optional given function triggering erroneous default call": optionalOrElseTryGetTC[string]{
-			opt:         Empty[string](),
-			other:       defaultStringFunc(errors.New("default string already used")),
-			expectError: true,
-		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_Require(b *testing.B) {
+func BenchmarkOptional_GetValid(b *testing.B) {
 	opt := Of(123)
+	validate := func(_ int) error {
+		return nil
+	}
 	for i := 0; i < b.N; i++ {
-		_ = opt.Require()
+		if _, err := opt.GetValid(validate); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
 
-type optionalRequireTC[T any] struct {
+type optionalGetValidTC[T any] struct {
 	opt         Optional[T]
-	expectPanic bool
+	validate    func(value T) error
+	expectError error
 	expectValue T
 	test.Control
 }
 
-func (tc optionalRequireTC[T]) Test(t *testing.T) {
-	if tc.expectPanic {
-		assert.Panics(t, func() {
-			tc.opt.Require()
-		}, "expected panic")
+func (tc optionalGetValidTC[T]) Test(t *testing.T) {
+	value, err := tc.opt.GetValid(tc.validate)
+	if tc.expectError != nil {
+		assert.ErrorIs(t, err, tc.expectError, "expected error")
 	} else {
-		var value T
-		assert.NotPanics(t, func() {
-			value = tc.opt.Require()
-		}, "unexpected panic")
-		assert.Equal(t, tc.expectValue, value, "unexpected value")
+		assert.NoError(t, err, "unexpected error")
 	}
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
 }
 
-func TestOptional_Require(t *testing.T) {
+func TestOptional_GetValid(t *testing.T) {
+	errNegative := errors.New("negative")
+	errUpper := errors.New("uppercase")
+	isPos := func(value int) error {
+		if value < 0 {
+			return errNegative
+		}
+		return nil
+	}
+	isLower := func(value string) error {
+		if strings.ContainsFunc(value, unicode.IsUpper) {
+			return errUpper
+		}
+		return nil
+	}
+
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalRequireTC[int]{
+		"on empty int Optional": optionalGetValidTC[int]{
 			opt:         Empty[int](),
-			expectPanic: true,
+			validate:    isPos,
+			expectError: ErrNotPresent,
 		},
-		"on non-empty int Optional with zero value": optionalRequireTC[int]{
+		"on non-empty int Optional with zero value": optionalGetValidTC[int]{
 			opt:         Of(0),
+			validate:    isPos,
 			expectValue: 0,
 		},
-		"on non-empty int Optional with non-zero value": optionalRequireTC[int]{
+		"on non-empty int Optional with valid value": optionalGetValidTC[int]{
 			opt:         Of(123),
+			validate:    isPos,
 			expectValue: 123,
 		},
-		"on empty string Optional": optionalRequireTC[string]{
+		"on non-empty int Optional with invalid value": optionalGetValidTC[int]{
+			opt:         Of(-123),
+			validate:    isPos,
+			expectError: errNegative,
+		},
+		"on empty string Optional": optionalGetValidTC[string]{
 			opt:         Empty[string](),
-			expectPanic: true,
+			validate:    isLower,
+			expectError: ErrNotPresent,
 		},
-		"on non-empty string Optional with zero value": optionalRequireTC[string]{
+		"on non-empty string Optional with zero value": optionalGetValidTC[string]{
 			opt:         Of(""),
+			validate:    isLower,
 			expectValue: "",
 		},
-		"on non-empty string Optional with non-zero value": optionalRequireTC[string]{
+		"on non-empty string Optional with valid value": optionalGetValidTC[string]{
 			opt:         Of("abc"),
+			validate:    isLower,
 			expectValue: "abc",
 		},
+		"on non-empty string Optional with invalid value": optionalGetValidTC[string]{
+			opt:         Of("ABC"),
+			validate:    isLower,
+			expectError: errUpper,
+		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_Scan(b *testing.B) {
+func BenchmarkOptional_If(b *testing.B) {
+	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		var opt Optional[int]
-		if err := opt.Scan(int64(123)); err != nil {
-			b.Fatal(err)
-		}
+		_, _ = opt.If()
 	}
 }
 
-type optionalScanTC[S, T any] struct {
+type optionalIfTC[T any] struct {
 	opt           Optional[T]
-	src           S
-	expectError   bool
 	expectPresent bool
 	expectValue   T
 	test.Control
 }
 
-func (tc optionalScanTC[S, T]) Test(t *testing.T) {
-	err := tc.opt.Scan(tc.src)
-	value, present := tc.opt.Get()
-	if tc.expectError {
-		assert.Error(t, err, "expected error")
-	} else {
-		assert.NoError(t, err, "unexpected error")
-	}
+func (tc optionalIfTC[T]) Test(t *testing.T) {
+	value, present := tc.opt.If()
 	assert.Equal(t, tc.expectValue, value, "unexpected value")
 	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+	getValue, getPresent := tc.opt.Get()
+	assert.Equal(t, getValue, value, "unexpected value mismatch with Get")
+	assert.Equal(t, getPresent, present, "unexpected value presence mismatch with Get")
 }
 
-func TestOptional_Scan(t *testing.T) {
-	type (
-		Bool    bool
-		Bytes   []byte
-		Float32 float32
-		Float64 float64
-		Int     int
-		Int8    int8
-		Int16   int16
-		Int32   int32
-		Int64   int64
-		String  string
-		Time    time.Time
-		Uint    uint
-		Uint8   uint8
-		Uint16  uint16
-		Uint32  uint32
-		Uint64  uint64
-	)
-
-	var (
-		maxFloat64String = strconv.FormatFloat(math.MaxFloat64, 'g', -1, 64)
-		maxInt64String   = strconv.FormatInt(math.MaxInt64, 10)
-		maxUint64String  = strconv.FormatUint(math.MaxUint64, 10)
-		minFloat64String = strconv.FormatFloat(-math.MaxFloat64, 'g', -1, 64)
-		minInt64String   = strconv.FormatInt(math.MinInt64, 10)
-		timeNow          = time.Now().UTC()
-		timeNowString    = timeNow.Format(time.RFC3339Nano)
-		timeZeroString   = time.Time{}.Format(time.RFC3339Nano)
-	)
-
+func TestOptional_If(t *testing.T) {
 	test.RunCases(t, test.Cases{
-		// Test cases for bool source
-		// Supported destination types (incl. pointers and convertible types):
-		// bool, string, []byte, sql.RawBytes, any
-		"on empty bool Optional given zero bool source": optionalScanTC[bool, bool]{
-			src:           false,
-			expectPresent: true,
-			expectValue:   false,
+		// Test cases for documented examples
+		"on empty int Optional": optionalIfTC[int]{
+			opt:           Empty[int](),
+			expectPresent: false,
+			expectValue:   0,
 		},
-		"on empty bool Optional given non-zero bool source": optionalScanTC[bool, bool]{
-			src:           true,
+		"on non-empty int Optional with zero value": optionalIfTC[int]{
+			opt:           Of(0),
 			expectPresent: true,
-			expectValue:   true,
+			expectValue:   0,
 		},
-		"on empty *bool Optional given zero bool source": optionalScanTC[bool, *bool]{
-			src:           false,
+		"on non-empty int Optional with non-zero value": optionalIfTC[int]{
+			opt:           Of(123),
 			expectPresent: true,
-			expectValue:   ptrs.False(),
+			expectValue:   123,
 		},
-		"on empty *bool Optional given non-zero bool source": optionalScanTC[bool, *bool]{
-			src:           true,
-			expectPresent: true,
-			expectValue:   ptrs.True(),
+		"on empty string Optional": optionalIfTC[string]{
+			opt:           Empty[string](),
+			expectPresent: false,
+			expectValue:   "",
 		},
-		"on empty Bool Optional given non-zero bool source": optionalScanTC[bool, Bool]{
-			src:           true,
+		"on non-empty string Optional with zero value": optionalIfTC[string]{
+			opt:           Of(""),
 			expectPresent: true,
-			expectValue:   true,
+			expectValue:   "",
 		},
-		"on empty *Bool Optional given non-zero bool source": optionalScanTC[bool, *Bool]{
-			src:           true,
+		"on non-empty string Optional with non-zero value": optionalIfTC[string]{
+			opt:           Of("abc"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Bool](true),
+			expectValue:   "abc",
 		},
-		"on empty string Optional given zero bool source": optionalScanTC[bool, string]{
-			src:           false,
-			expectPresent: true,
-			expectValue:   "false",
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_IfPresent(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		opt.IfPresent(func(_ int) {})
+	}
+}
+
+type optionalIfPresentTC[T any] struct {
+	opt             Optional[T]
+	expectCallCount uint
+	test.Control
+}
+
+func (tc optionalIfPresentTC[T]) Test(t *testing.T) {
+	var callCount uint
+	tc.opt.IfPresent(func(value T) {
+		callCount++
+		assert.Equal(t, tc.opt.value, value)
+	})
+	assert.Equalf(t, tc.expectCallCount, callCount, "expected function to be called %v times", tc.expectCallCount)
+}
+
+func TestOptional_IfPresent(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalIfPresentTC[int]{
+			opt:             Empty[int](),
+			expectCallCount: 0,
 		},
-		"on empty string Optional given non-zero bool source": optionalScanTC[bool, string]{
-			src:           true,
-			expectPresent: true,
-			expectValue:   "true",
+		"on non-empty int Optional with zero value": optionalIfPresentTC[int]{
+			opt:             Of(0),
+			expectCallCount: 1,
 		},
-		"on empty *string Optional given zero bool source": optionalScanTC[bool, *string]{
-			src:           false,
-			expectPresent: true,
-			expectValue:   ptrs.String("false"),
+		"on non-empty int Optional with non-zero value": optionalIfPresentTC[int]{
+			opt:             Of(123),
+			expectCallCount: 1,
+		},
+		"on empty string Optional": optionalIfPresentTC[string]{
+			opt:             Empty[string](),
+			expectCallCount: 0,
+		},
+		"on non-empty string Optional with zero value": optionalIfPresentTC[string]{
+			opt:             Of(""),
+			expectCallCount: 1,
+		},
+		"on non-empty string Optional with non-zero value": optionalIfPresentTC[string]{
+			opt:             Of("abc"),
+			expectCallCount: 1,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_IfPresentOrElse(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		opt.IfPresentOrElse(func(_ int) {}, func() {})
+	}
+}
+
+type optionalIfPresentOrElseTC[T any] struct {
+	opt                  Optional[T]
+	expectCallCount      uint
+	expectEmptyCallCount uint
+	test.Control
+}
+
+func (tc optionalIfPresentOrElseTC[T]) Test(t *testing.T) {
+	var callCount, emptyCallCount uint
+	tc.opt.IfPresentOrElse(func(value T) {
+		callCount++
+		assert.Equal(t, tc.opt.value, value)
+	}, func() {
+		emptyCallCount++
+	})
+	assert.Equalf(t, tc.expectCallCount, callCount, "expected function to be called %v times", tc.expectCallCount)
+	assert.Equalf(t, tc.expectEmptyCallCount, emptyCallCount, "expected empty function to be called %v times", tc.expectEmptyCallCount)
+}
+
+func TestOptional_IfPresentOrElse(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalIfPresentOrElseTC[int]{
+			opt:                  Empty[int](),
+			expectCallCount:      0,
+			expectEmptyCallCount: 1,
+		},
+		"on non-empty int Optional with zero value": optionalIfPresentOrElseTC[int]{
+			opt:                  Of(0),
+			expectCallCount:      1,
+			expectEmptyCallCount: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalIfPresentOrElseTC[int]{
+			opt:                  Of(123),
+			expectCallCount:      1,
+			expectEmptyCallCount: 0,
+		},
+		"on empty string Optional": optionalIfPresentOrElseTC[string]{
+			opt:                  Empty[string](),
+			expectCallCount:      0,
+			expectEmptyCallCount: 1,
+		},
+		"on non-empty string Optional with zero value": optionalIfPresentOrElseTC[string]{
+			opt:                  Of(""),
+			expectCallCount:      1,
+			expectEmptyCallCount: 0,
+		},
+		"on non-empty string Optional with non-zero value": optionalIfPresentOrElseTC[string]{
+			opt:                  Of("abc"),
+			expectCallCount:      1,
+			expectEmptyCallCount: 0,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_IsEmpty(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.IsEmpty()
+	}
+}
+
+type optionalIsEmptyTC[T any] struct {
+	opt    Optional[T]
+	expect bool
+	test.Control
+}
+
+func (tc optionalIsEmptyTC[T]) Test(t *testing.T) {
+	absent := tc.opt.IsEmpty()
+	assert.Equal(t, tc.expect, absent, "unexpected value absence")
+}
+
+func TestOptional_IsEmpty(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalIsEmptyTC[int]{
+			opt:    Empty[int](),
+			expect: true,
+		},
+		"on non-empty int Optional with zero value": optionalIsEmptyTC[int]{
+			opt:    Of(0),
+			expect: false,
+		},
+		"on non-empty int Optional with non-zero value": optionalIsEmptyTC[int]{
+			opt:    Of(123),
+			expect: false,
+		},
+		"on empty string Optional": optionalIsEmptyTC[string]{
+			opt:    Empty[string](),
+			expect: true,
+		},
+		"on non-empty string Optional with zero value": optionalIsEmptyTC[string]{
+			opt:    Of(""),
+			expect: false,
+		},
+		"on non-empty string Optional with non-zero value": optionalIsEmptyTC[string]{
+			opt:    Of("abc"),
+			expect: false,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_IsPresent(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.IsPresent()
+	}
+}
+
+type optionalIsPresentTC[T any] struct {
+	opt    Optional[T]
+	expect bool
+	test.Control
+}
+
+func (tc optionalIsPresentTC[T]) Test(t *testing.T) {
+	present := tc.opt.IsPresent()
+	assert.Equal(t, tc.expect, present, "unexpected value presence")
+}
+
+func TestOptional_IsPresent(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalIsPresentTC[int]{
+			opt:    Empty[int](),
+			expect: false,
+		},
+		"on non-empty int Optional with zero value": optionalIsPresentTC[int]{
+			opt:    Of(0),
+			expect: true,
+		},
+		"on non-empty int Optional with non-zero value": optionalIsPresentTC[int]{
+			opt:    Of(123),
+			expect: true,
+		},
+		"on empty string Optional": optionalIsPresentTC[string]{
+			opt:    Empty[string](),
+			expect: false,
+		},
+		"on non-empty string Optional with zero value": optionalIsPresentTC[string]{
+			opt:    Of(""),
+			expect: true,
+		},
+		"on non-empty string Optional with non-zero value": optionalIsPresentTC[string]{
+			opt:    Of("abc"),
+			expect: true,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_IsZero(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.IsZero()
+	}
+}
+
+type optionalIsZeroTC[T any] struct {
+	opt    Optional[T]
+	expect bool
+	test.Control
+}
+
+func (tc optionalIsZeroTC[T]) Test(t *testing.T) {
+	absent := tc.opt.IsZero()
+	assert.Equal(t, tc.expect, absent, "unexpected value absence")
+}
+
+func TestOptional_IsZero(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalIsZeroTC[int]{
+			opt:    Empty[int](),
+			expect: true,
+		},
+		"on non-empty int Optional with zero value": optionalIsZeroTC[int]{
+			opt:    Of(0),
+			expect: false,
+		},
+		"on non-empty int Optional with non-zero value": optionalIsZeroTC[int]{
+			opt:    Of(123),
+			expect: false,
+		},
+		"on empty string Optional": optionalIsZeroTC[string]{
+			opt:    Empty[string](),
+			expect: true,
+		},
+		"on non-empty string Optional with zero value": optionalIsZeroTC[string]{
+			opt:    Of(""),
+			expect: false,
+		},
+		"on non-empty string Optional with non-zero value": optionalIsZeroTC[string]{
+			opt:    Of("abc"),
+			expect: false,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_JSONStringOr(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.JSONStringOr("null")
+	}
+}
+
+type optionalJSONStringOrTC[T any] struct {
+	opt      Optional[T]
+	fallback string
+	expect   string
+	test.Control
+}
+
+func (tc optionalJSONStringOrTC[T]) Test(t *testing.T) {
+	s := tc.opt.JSONStringOr(tc.fallback)
+	assert.Equal(t, tc.expect, s, "unexpected string")
+}
+
+func TestOptional_JSONStringOr(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalJSONStringOrTC[int]{
+			opt:      Empty[int](),
+			fallback: "-",
+			expect:   "-",
+		},
+		"on non-empty int Optional with zero value": optionalJSONStringOrTC[int]{
+			opt:      Of(0),
+			fallback: "-",
+			expect:   "0",
+		},
+		"on non-empty int Optional with non-zero value": optionalJSONStringOrTC[int]{
+			opt:      Of(123),
+			fallback: "-",
+			expect:   "123",
+		},
+		"on empty string Optional": optionalJSONStringOrTC[string]{
+			opt:      Empty[string](),
+			fallback: "-",
+			expect:   "-",
+		},
+		"on non-empty string Optional with zero value": optionalJSONStringOrTC[string]{
+			opt:      Of(""),
+			fallback: "-",
+			expect:   `""`,
+		},
+		"on non-empty string Optional with non-zero value": optionalJSONStringOrTC[string]{
+			opt:      Of("abc"),
+			fallback: "-",
+			expect:   `"abc"`,
+		},
+		// Other test cases...
+		"on non-empty struct Optional": optionalJSONStringOrTC[struct {
+			Number int    `json:"number"`
+			Text   string `json:"text"`
+		}]{
+			opt: Of(struct {
+				Number int    `json:"number"`
+				Text   string `json:"text"`
+			}{123, "abc"}),
+			fallback: "-",
+			expect:   `{"number":123,"text":"abc"}`,
+		},
+		"on non-empty float64 Optional with unsupported value": optionalJSONStringOrTC[float64]{
+			opt:      Of(math.Inf(1)),
+			fallback: "-",
+			expect:   "-",
+		},
+		"on non-empty chan Optional": optionalJSONStringOrTC[chan int]{
+			opt:      Of(make(chan int)),
+			fallback: "-",
+			expect:   "-",
+		},
+	})
+}
+
+func BenchmarkOptional_MapIf(b *testing.B) {
+	opt := Of(-123)
+	cond := func(value int) bool {
+		return value < 0
+	}
+	fn := func(value int) int {
+		return -value
+	}
+	for i := 0; i < b.N; i++ {
+		_ = opt.MapIf(cond, fn)
+	}
+}
+
+type optionalMapIfTC[T any] struct {
+	opt               Optional[T]
+	cond              func(value T) bool
+	fn                func(value T) T
+	expectFnCallCount uint
+	expectPresent     bool
+	expectValue       T
+	test.Control
+}
+
+func (tc optionalMapIfTC[T]) Test(t *testing.T) {
+	var fnCallCount uint
+	opt := tc.opt.MapIf(tc.cond, func(value T) T {
+		fnCallCount++
+		return tc.fn(value)
+	})
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+	assert.Equalf(t, tc.expectFnCallCount, fnCallCount, "expected function to be called %v times", tc.expectFnCallCount)
+}
+
+func TestOptional_MapIf(t *testing.T) {
+	isNeg := func(value int) bool {
+		return value < 0
+	}
+	negate := func(value int) int {
+		return -value
+	}
+	isUpper := func(value string) bool {
+		return value != "" && strings.ToUpper(value) == value
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalMapIfTC[int]{
+			opt:               Empty[int](),
+			cond:              isNeg,
+			fn:                negate,
+			expectFnCallCount: 0,
+			expectPresent:     false,
+		},
+		"on non-empty int Optional with zero value": optionalMapIfTC[int]{
+			opt:               Of(0),
+			cond:              isNeg,
+			fn:                negate,
+			expectFnCallCount: 0,
+			expectPresent:     true,
+			expectValue:       0,
+		},
+		"on non-empty int Optional with value failing condition": optionalMapIfTC[int]{
+			opt:               Of(123),
+			cond:              isNeg,
+			fn:                negate,
+			expectFnCallCount: 0,
+			expectPresent:     true,
+			expectValue:       123,
+		},
+		"on non-empty int Optional with value passing condition": optionalMapIfTC[int]{
+			opt:               Of(-123),
+			cond:              isNeg,
+			fn:                negate,
+			expectFnCallCount: 1,
+			expectPresent:     true,
+			expectValue:       123,
+		},
+		"on empty string Optional": optionalMapIfTC[string]{
+			opt:               Empty[string](),
+			cond:              isUpper,
+			fn:                strings.ToLower,
+			expectFnCallCount: 0,
+			expectPresent:     false,
+		},
+		"on non-empty string Optional with zero value": optionalMapIfTC[string]{
+			opt:               Of(""),
+			cond:              isUpper,
+			fn:                strings.ToLower,
+			expectFnCallCount: 0,
+			expectPresent:     true,
+			expectValue:       "",
+		},
+		"on non-empty string Optional with value failing condition": optionalMapIfTC[string]{
+			opt:               Of("abc"),
+			cond:              isUpper,
+			fn:                strings.ToLower,
+			expectFnCallCount: 0,
+			expectPresent:     true,
+			expectValue:       "abc",
+		},
+		"on non-empty string Optional with value passing condition": optionalMapIfTC[string]{
+			opt:               Of("ABC"),
+			cond:              isUpper,
+			fn:                strings.ToLower,
+			expectFnCallCount: 1,
+			expectPresent:     true,
+			expectValue:       "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_MarshalJSON(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalMarshalJSONTC struct {
+	value      any
+	expectJSON string
+	test.Control
+}
+
+func (tc optionalMarshalJSONTC) Test(t *testing.T) {
+	b, err := json.Marshal(tc.value)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expectJSON, string(b), "unexpected JSON")
+}
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	type Example struct {
+		Int           Optional[int]     `json:"int"`
+		String        Optional[string]  `json:"string"`
+		IntOmit       Optional[int]     `json:"intOmit,omitempty"`
+		StringOmit    Optional[string]  `json:"stringOmit,omitempty"`
+		IntOmitPtr    *Optional[int]    `json:"intOmitPtr,omitempty"`
+		StringOmitPtr *Optional[string] `json:"stringOmitPtr,omitempty"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on empty int Optional": optionalMarshalJSONTC{
+			value:      Empty[int](),
+			expectJSON: `null`,
+		},
+		"on non-empty int Optional with zero value": optionalMarshalJSONTC{
+			value:      Of(0),
+			expectJSON: `0`,
+		},
+		"on non-empty int Optional with non-zero value": optionalMarshalJSONTC{
+			value:      Of(123),
+			expectJSON: `123`,
+		},
+		"on empty string Optional": optionalMarshalJSONTC{
+			value:      Empty[string](),
+			expectJSON: `null`,
+		},
+		"on non-empty string Optional with zero value": optionalMarshalJSONTC{
+			value:      Of(""),
+			expectJSON: `""`,
+		},
+		"on non-empty string Optional with non-zero value": optionalMarshalJSONTC{
+			value:      Of("abc"),
+			expectJSON: `"abc"`,
+		},
+		"on struct with empty Optionals": optionalMarshalJSONTC{
+			value:      Example{},
+			expectJSON: `{"int":null,"string":null,"intOmit":null,"stringOmit":null}`,
+			// json omitempty option does not apply to zero value structs
+		},
+		"on struct with non-empty Optionals and zero field values": optionalMarshalJSONTC{
+			value: Example{
+				Int:           Of(0),
+				String:        Of(""),
+				IntOmit:       Of(0),
+				StringOmit:    Of(""),
+				IntOmitPtr:    ptrs.Value(Of(0)),
+				StringOmitPtr: ptrs.Value(Of("")),
+			},
+			expectJSON: `{"int":0,"string":"","intOmit":0,"stringOmit":"","intOmitPtr":0,"stringOmitPtr":""}`,
+		},
+		"on struct with non-empty Optionals and non-zero field values": optionalMarshalJSONTC{
+			value: Example{
+				Int:           Of(123),
+				String:        Of("abc"),
+				IntOmit:       Of(123),
+				StringOmit:    Of("abc"),
+				IntOmitPtr:    ptrs.Value(Of(123)),
+				StringOmitPtr: ptrs.Value(Of("abc")),
+			},
+			expectJSON: `{"int":123,"string":"abc","intOmit":123,"stringOmit":"abc","intOmitPtr":123,"stringOmitPtr":"abc"}`,
+		},
+		"on empty any Optional": optionalMarshalJSONTC{
+			value:      Empty[any](),
+			expectJSON: `null`,
+		},
+		"on non-empty any Optional with integer json.Number value": optionalMarshalJSONTC{
+			value:      Of[any](json.Number("42")),
+			expectJSON: `42`,
+		},
+		"on non-empty any Optional with float json.Number value": optionalMarshalJSONTC{
+			value:      Of[any](json.Number("4.20")),
+			expectJSON: `4.20`,
+		},
+		"on non-empty json.Number Optional with integer value": optionalMarshalJSONTC{
+			value:      Of(json.Number("42")),
+			expectJSON: `42`,
+		},
+	})
+}
+
+func BenchmarkOptional_MarshalXML(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		if _, err := xml.Marshal(opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalMarshalXMLTC struct {
+	value     any
+	expectXML string
+	test.Control
+}
+
+func (tc optionalMarshalXMLTC) Test(t *testing.T) {
+	b, err := xml.Marshal(tc.value)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expectXML, string(b), "unexpected XML")
+}
+
+func TestOptional_MarshalXML(t *testing.T) {
+	type Example struct {
+		Int           Optional[int]     `xml:"int"`
+		String        Optional[string]  `xml:"string"`
+		IntOmit       Optional[int]     `xml:"intOmit,omitempty"`
+		StringOmit    Optional[string]  `xml:"stringOmit,omitempty"`
+		IntOmitPtr    *Optional[int]    `xml:"intOmitPtr,omitempty"`
+		StringOmitPtr *Optional[string] `xml:"stringOmitPtr,omitempty"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on empty int Optional": optionalMarshalXMLTC{
+			value:     Empty[int](),
+			expectXML: ``,
+		},
+		"on non-empty int Optional with zero value": optionalMarshalXMLTC{
+			value:     Of(0),
+			expectXML: `<int>0</int>`,
+		},
+		"on non-empty int Optional with non-zero value": optionalMarshalXMLTC{
+			value:     Of(123),
+			expectXML: `<int>123</int>`,
+		},
+		"on empty string Optional": optionalMarshalXMLTC{
+			value:     Empty[string](),
+			expectXML: ``,
+		},
+		"on non-empty string Optional with zero value": optionalMarshalXMLTC{
+			value:     Of(""),
+			expectXML: `<string></string>`,
+		},
+		"on non-empty string Optional with non-zero value": optionalMarshalXMLTC{
+			value:     Of("abc"),
+			expectXML: `<string>abc</string>`,
+		},
+		"on struct with empty Optionals": optionalMarshalXMLTC{
+			value:     Example{},
+			expectXML: `<Example></Example>`,
+		},
+		"on struct with non-empty Optionals and zero field values": optionalMarshalXMLTC{
+			value: Example{
+				Int:           Of(0),
+				String:        Of(""),
+				IntOmit:       Of(0),
+				StringOmit:    Of(""),
+				IntOmitPtr:    ptrs.Value(Of(0)),
+				StringOmitPtr: ptrs.Value(Of("")),
+			},
+			expectXML: `<Example><int>0</int><string></string><intOmit>0</intOmit><stringOmit></stringOmit><intOmitPtr>0</intOmitPtr><stringOmitPtr></stringOmitPtr></Example>`,
+		},
+		"on struct with non-empty Optionals and non-zero field values": optionalMarshalXMLTC{
+			value: Example{
+				Int:           Of(123),
+				String:        Of("abc"),
+				IntOmit:       Of(123),
+				StringOmit:    Of("abc"),
+				IntOmitPtr:    ptrs.Value(Of(123)),
+				StringOmitPtr: ptrs.Value(Of("abc")),
+			},
+			expectXML: `<Example><int>123</int><string>abc</string><intOmit>123</intOmit><stringOmit>abc</stringOmit><intOmitPtr>123</intOmitPtr><stringOmitPtr>abc</stringOmitPtr></Example>`,
+		},
+	})
+}
+
+func BenchmarkOptional_MarshalYAML(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		if _, err := yaml.Marshal(opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalMarshalYAMLTC struct {
+	value      any
+	expectYAML string
+	test.Control
+}
+
+func (tc optionalMarshalYAMLTC) Test(t *testing.T) {
+	b, err := yaml.Marshal(tc.value)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expectYAML, strings.TrimSpace(string(b)), "unexpected YAML")
+}
+
+func TestOptional_MarshalYAML(t *testing.T) {
+	type Example struct {
+		Int           Optional[int]     `yaml:"int"`
+		String        Optional[string]  `yaml:"string"`
+		IntOmit       Optional[int]     `yaml:"intOmit,omitempty"`
+		StringOmit    Optional[string]  `yaml:"stringOmit,omitempty"`
+		IntOmitPtr    *Optional[int]    `yaml:"intOmitPtr,omitempty"`
+		StringOmitPtr *Optional[string] `yaml:"stringOmitPtr,omitempty"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on empty int Optional": optionalMarshalYAMLTC{
+			value:      Empty[int](),
+			expectYAML: `null`,
+		},
+		"on non-empty int Optional with zero value": optionalMarshalYAMLTC{
+			value:      Of(0),
+			expectYAML: `0`,
+		},
+		"on non-empty int Optional with non-zero value": optionalMarshalYAMLTC{
+			value:      Of(123),
+			expectYAML: `123`,
+		},
+		"on empty string Optional": optionalMarshalYAMLTC{
+			value:      Empty[string](),
+			expectYAML: `null`,
+		},
+		"on non-empty string Optional with zero value": optionalMarshalYAMLTC{
+			value:      Of(""),
+			expectYAML: `""`,
+		},
+		"on non-empty string Optional with non-zero value": optionalMarshalYAMLTC{
+			value:      Of("abc"),
+			expectYAML: `abc`,
+		},
+		"on struct with empty Optionals": optionalMarshalYAMLTC{
+			value: Example{},
+			expectYAML: `int: null
+string: null`,
+		},
+		"on struct with non-empty Optionals and zero field values": optionalMarshalYAMLTC{
+			value: Example{
+				Int:           Of(0),
+				String:        Of(""),
+				IntOmit:       Of(0),
+				StringOmit:    Of(""),
+				IntOmitPtr:    ptrs.Value(Of(0)),
+				StringOmitPtr: ptrs.Value(Of("")),
+			},
+			expectYAML: `int: 0
+string: ""
+intOmit: 0
+stringOmit: ""
+intOmitPtr: 0
+stringOmitPtr: ""`,
+		},
+		"on struct with non-empty Optionals and non-zero field values": optionalMarshalYAMLTC{
+			value: Example{
+				Int:           Of(123),
+				String:        Of("abc"),
+				IntOmit:       Of(123),
+				StringOmit:    Of("abc"),
+				IntOmitPtr:    ptrs.Value(Of(123)),
+				StringOmitPtr: ptrs.Value(Of("abc")),
+			},
+			expectYAML: `int: 123
+string: abc
+intOmit: 123
+stringOmit: abc
+intOmitPtr: 123
+stringOmitPtr: abc`,
+		},
+	})
+}
+
+func BenchmarkOptional_Or(b *testing.B) {
+	opt := Empty[int]()
+	other := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.Or(other)
+	}
+}
+
+type optionalOrTC[T any] struct {
+	opt    Optional[T]
+	other  Optional[T]
+	expect Optional[T]
+	test.Control
+}
+
+func (tc optionalOrTC[T]) Test(t *testing.T) {
+	actual := tc.opt.Or(tc.other)
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestOptional_Or(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional given empty Optional": optionalOrTC[int]{
+			opt:    Empty[int](),
+			other:  Empty[int](),
+			expect: Empty[int](),
+		},
+		"on empty int Optional given non-empty Optional": optionalOrTC[int]{
+			opt:    Empty[int](),
+			other:  Of(-1),
+			expect: Of(-1),
+		},
+		"on non-empty int Optional with zero value": optionalOrTC[int]{
+			opt:    Of(0),
+			other:  Of(-1),
+			expect: Of(0),
+		},
+		"on non-empty int Optional with non-zero value": optionalOrTC[int]{
+			opt:    Of(123),
+			other:  Of(-1),
+			expect: Of(123),
+		},
+		"on empty string Optional given empty Optional": optionalOrTC[string]{
+			opt:    Empty[string](),
+			other:  Empty[string](),
+			expect: Empty[string](),
+		},
+		"on empty string Optional given non-empty Optional": optionalOrTC[string]{
+			opt:    Empty[string](),
+			other:  Of("unknown"),
+			expect: Of("unknown"),
+		},
+		"on non-empty string Optional with zero value": optionalOrTC[string]{
+			opt:    Of(""),
+			other:  Of("unknown"),
+			expect: Of(""),
+		},
+		"on non-empty string Optional with non-zero value": optionalOrTC[string]{
+			opt:    Of("abc"),
+			other:  Of("unknown"),
+			expect: Of("abc"),
+		},
+		// Other test cases...
+		"on non-empty int Optional given empty Optional": optionalOrTC[int]{
+			opt:    Of(123),
+			other:  Empty[int](),
+			expect: Of(123),
+		},
+		"on empty int Optional given non-empty Optional with zero value": optionalOrTC[int]{
+			opt:    Empty[int](),
+			other:  Of(0),
+			expect: Of(0),
+		},
+	})
+}
+
+func BenchmarkOptional_OrDefaultIfZero(b *testing.B) {
+	opt := Of(0)
+	for i := 0; i < b.N; i++ {
+		_ = opt.OrDefaultIfZero(123)
+	}
+}
+
+type optionalOrDefaultIfZeroTC[T any] struct {
+	opt    Optional[T]
+	def    T
+	expect T
+	test.Control
+}
+
+func (tc optionalOrDefaultIfZeroTC[T]) Test(t *testing.T) {
+	actual := tc.opt.OrDefaultIfZero(tc.def)
+	assert.Equal(t, tc.expect, actual, "unexpected value")
+}
+
+func TestOptional_OrDefaultIfZero(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalOrDefaultIfZeroTC[int]{
+			opt:    Empty[int](),
+			def:    -1,
+			expect: -1,
+		},
+		"on non-empty int Optional with zero value": optionalOrDefaultIfZeroTC[int]{
+			opt:    Of(0),
+			def:    -1,
+			expect: -1,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrDefaultIfZeroTC[int]{
+			opt:    Of(123),
+			def:    -1,
+			expect: 123,
+		},
+		"on empty string Optional": optionalOrDefaultIfZeroTC[string]{
+			opt:    Empty[string](),
+			def:    "unknown",
+			expect: "unknown",
+		},
+		"on non-empty string Optional with zero value": optionalOrDefaultIfZeroTC[string]{
+			opt:    Of(""),
+			def:    "unknown",
+			expect: "unknown",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrDefaultIfZeroTC[string]{
+			opt:    Of("abc"),
+			def:    "unknown",
+			expect: "abc",
+		},
+		// Other test cases...
+		"on non-empty int slice Optional with nil value": optionalOrDefaultIfZeroTC[[]int]{
+			opt:    Of([]int(nil)),
+			def:    []int{},
+			expect: []int{},
+		},
+		"on non-empty int slice Optional with non-nil value": optionalOrDefaultIfZeroTC[[]int]{
+			opt:    Of([]int{123}),
+			def:    []int{},
+			expect: []int{123},
+		},
+	})
+}
+
+func BenchmarkOptional_OrElse(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.OrElse(-1)
+	}
+}
+
+type optionalOrElseTC[T any] struct {
+	opt    Optional[T]
+	other  T
+	expect T
+	test.Control
+}
+
+func (tc optionalOrElseTC[T]) Test(t *testing.T) {
+	value := tc.opt.OrElse(tc.other)
+	assert.Equal(t, tc.expect, value, "unexpected value")
+}
+
+func TestOptional_OrElse(t *testing.T) {
+	defaultInt := -1
+	defaultString := "unknown"
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalOrElseTC[int]{
+			opt:    Empty[int](),
+			other:  defaultInt,
+			expect: defaultInt,
+		},
+		"on non-empty int Optional with zero value": optionalOrElseTC[int]{
+			opt:    Of(0),
+			other:  defaultInt,
+			expect: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrElseTC[int]{
+			opt:    Of(123),
+			other:  defaultInt,
+			expect: 123,
+		},
+		"on empty string Optional": optionalOrElseTC[string]{
+			opt:    Empty[string](),
+			other:  defaultString,
+			expect: defaultString,
+		},
+		"on non-empty string Optional with zero value": optionalOrElseTC[string]{
+			opt:    Of(""),
+			other:  defaultString,
+			expect: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrElseTC[string]{
+			opt:    Of("abc"),
+			other:  defaultString,
+			expect: "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_OrElseChain(b *testing.B) {
+	getters := []func() Optional[int]{
+		func() Optional[int] { return Empty[int]() },
+		func() Optional[int] { return Of(123) },
+	}
+	opt := Empty[int]()
+	for i := 0; i < b.N; i++ {
+		_ = opt.OrElseChain(getters...)
+	}
+}
+
+type optionalOrElseChainTC[T any] struct {
+	opt             Optional[T]
+	others          []Optional[T]
+	expectCallCount int
+	expectPresent   bool
+	expectValue     T
+	test.Control
+}
+
+func (tc optionalOrElseChainTC[T]) Test(t *testing.T) {
+	var callCount int
+	getters := make([]func() Optional[T], len(tc.others))
+	for i, other := range tc.others {
+		other := other
+		getters[i] = func() Optional[T] {
+			callCount++
+			return other
+		}
+	}
+	opt := tc.opt.OrElseChain(getters...)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+	assert.Equalf(t, tc.expectCallCount, callCount, "expected functions to be called %v times", tc.expectCallCount)
+}
+
+func TestOptional_OrElseChain(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional given no functions": optionalOrElseChainTC[int]{
+			opt:             Empty[int](),
+			expectCallCount: 0,
+			expectPresent:   false,
+		},
+		"on empty int Optional given functions returning empty Optionals": optionalOrElseChainTC[int]{
+			opt:             Empty[int](),
+			others:          []Optional[int]{Empty[int](), Empty[int]()},
+			expectCallCount: 2,
+			expectPresent:   false,
+		},
+		"on empty int Optional given functions returning non-empty Optional": optionalOrElseChainTC[int]{
+			opt:             Empty[int](),
+			others:          []Optional[int]{Empty[int](), Of(0), Of(123)},
+			expectCallCount: 2,
+			expectPresent:   true,
+			expectValue:     0,
+		},
+		"on non-empty int Optional with zero value": optionalOrElseChainTC[int]{
+			opt:             Of(0),
+			others:          []Optional[int]{Of(123)},
+			expectCallCount: 0,
+			expectPresent:   true,
+			expectValue:     0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrElseChainTC[int]{
+			opt:             Of(123),
+			others:          []Optional[int]{Of(-123)},
+			expectCallCount: 0,
+			expectPresent:   true,
+			expectValue:     123,
+		},
+		"on empty string Optional given functions returning empty Optionals": optionalOrElseChainTC[string]{
+			opt:             Empty[string](),
+			others:          []Optional[string]{Empty[string]()},
+			expectCallCount: 1,
+			expectPresent:   false,
+		},
+		"on empty string Optional given functions returning non-empty Optional": optionalOrElseChainTC[string]{
+			opt:             Empty[string](),
+			others:          []Optional[string]{Of("abc"), Of("def")},
+			expectCallCount: 1,
+			expectPresent:   true,
+			expectValue:     "abc",
+		},
+		"on non-empty string Optional with zero value": optionalOrElseChainTC[string]{
+			opt:             Of(""),
+			others:          []Optional[string]{Of("abc")},
+			expectCallCount: 0,
+			expectPresent:   true,
+			expectValue:     "",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_OrElseGet(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.OrElseGet(func() int {
+			return -1
+		})
+	}
+}
+
+type optionalOrElseGetTC[T any] struct {
+	opt    Optional[T]
+	other  func() T
+	expect T
+	test.Control
+}
+
+func (tc optionalOrElseGetTC[T]) Test(t *testing.T) {
+	value := tc.opt.OrElseGet(tc.other)
+	assert.Equal(t, tc.expect, value, "unexpected value")
+}
+
+func TestOptional_OrElseGet(t *testing.T) {
+	defaultInt := -1
+	defaultIntFunc := func() int {
+		return defaultInt
+	}
+	defaultString := "unknown"
+	defaultStringFunc := func() string {
+		return defaultString
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalOrElseGetTC[int]{
+			opt:    Empty[int](),
+			other:  defaultIntFunc,
+			expect: defaultInt,
+		},
+		"on non-empty int Optional with zero value": optionalOrElseGetTC[int]{
+			opt:    Of(0),
+			other:  defaultIntFunc,
+			expect: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrElseGetTC[int]{
+			opt:    Of(123),
+			other:  defaultIntFunc,
+			expect: 123,
+		},
+		"on empty string Optional": optionalOrElseGetTC[string]{
+			opt:    Empty[string](),
+			other:  defaultStringFunc,
+			expect: defaultString,
+		},
+		"on non-empty string Optional with zero value": optionalOrElseGetTC[string]{
+			opt:    Of(""),
+			other:  defaultStringFunc,
+			expect: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrElseGetTC[string]{
+			opt:    Of("abc"),
+			other:  defaultStringFunc,
+			expect: "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_OrElseHandle(b *testing.B) {
+	defaultFunc := func() (int, error) {
+		return -1, nil
+	}
+	errFunc := func(_ error) int {
+		return -2
+	}
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.OrElseHandle(defaultFunc, errFunc)
+	}
+}
+
+type optionalOrElseHandleTC[T any] struct {
+	opt                  Optional[T]
+	other                func() (T, error)
+	onErr                func(err error) T
+	expectOtherCallCount uint
+	expectOnErrCallCount uint
+	expectValue          T
+	test.Control
+}
+
+func (tc optionalOrElseHandleTC[T]) Test(t *testing.T) {
+	var otherCallCount, onErrCallCount uint
+	value := tc.opt.OrElseHandle(func() (T, error) {
+		otherCallCount++
+		return tc.other()
+	}, func(err error) T {
+		onErrCallCount++
+		return tc.onErr(err)
+	})
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equalf(t, tc.expectOtherCallCount, otherCallCount, "expected other function to be called %v times", tc.expectOtherCallCount)
+	assert.Equalf(t, tc.expectOnErrCallCount, onErrCallCount, "expected onErr function to be called %v times", tc.expectOnErrCallCount)
+}
+
+func TestOptional_OrElseHandle(t *testing.T) {
+	defaultInt := -1
+	defaultIntFunc := func(err error) func() (int, error) {
+		return func() (int, error) {
+			if err != nil {
+				return 0, err
+			}
+			return defaultInt, nil
+		}
+	}
+	fallbackInt := -2
+	fallbackIntFunc := func(_ error) int {
+		return fallbackInt
+	}
+	defaultString := "unknown"
+	defaultStringFunc := func(err error) func() (string, error) {
+		return func() (string, error) {
+			if err != nil {
+				return "", err
+			}
+			return defaultString, nil
+		}
+	}
+	fallbackStringFunc := func(err error) string {
+		return err.Error()
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalOrElseHandleTC[int]{
+			opt:                  Empty[int](),
+			other:                defaultIntFunc(nil),
+			onErr:                fallbackIntFunc,
+			expectOtherCallCount: 1,
+			expectValue:          defaultInt,
+		},
+		"on empty int Optional given erroneous function": optionalOrElseHandleTC[int]{
+			opt:                  Empty[int](),
+			other:                defaultIntFunc(errors.New("default int already used")),
+			onErr:                fallbackIntFunc,
+			expectOtherCallCount: 1,
+			expectOnErrCallCount: 1,
+			expectValue:          fallbackInt,
+		},
+		"on non-empty int Optional with zero value": optionalOrElseHandleTC[int]{
+			opt:         Of(0),
+			other:       defaultIntFunc(nil),
+			onErr:       fallbackIntFunc,
+			expectValue: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrElseHandleTC[int]{
+			opt:         Of(123),
+			other:       defaultIntFunc(nil),
+			onErr:       fallbackIntFunc,
+			expectValue: 123,
+		},
+		"on empty string Optional": optionalOrElseHandleTC[string]{
+			opt:                  Empty[string](),
+			other:                defaultStringFunc(nil),
+			onErr:                fallbackStringFunc,
+			expectOtherCallCount: 1,
+			expectValue:          defaultString,
+		},
+		"on empty string Optional given erroneous function": optionalOrElseHandleTC[string]{
+			opt:                  Empty[string](),
+			other:                defaultStringFunc(errors.New("default string already used")),
+			onErr:                fallbackStringFunc,
+			expectOtherCallCount: 1,
+			expectOnErrCallCount: 1,
+			expectValue:          "default string already used",
+		},
+		"on non-empty string Optional with zero value": optionalOrElseHandleTC[string]{
+			opt:         Of(""),
+			other:       defaultStringFunc(nil),
+			onErr:       fallbackStringFunc,
+			expectValue: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrElseHandleTC[string]{
+			opt:         Of("abc"),
+			other:       defaultStringFunc(nil),
+			onErr:       fallbackStringFunc,
+			expectValue: "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_OrElseJSON(b *testing.B) {
+	defaultJSON := []byte("-1")
+	opt := Empty[int]()
+	for i := 0; i < b.N; i++ {
+		if _, err := opt.OrElseJSON(defaultJSON); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalOrElseJSONTC[T any] struct {
+	opt         Optional[T]
+	defaultJSON string
+	expectError bool
+	expectValue T
+	test.Control
+}
+
+func (tc optionalOrElseJSONTC[T]) Test(t *testing.T) {
+	value, err := tc.opt.OrElseJSON([]byte(tc.defaultJSON))
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+}
+
+func TestOptional_OrElseJSON(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalOrElseJSONTC[int]{
+			opt:         Empty[int](),
+			defaultJSON: "-1",
+			expectValue: -1,
+		},
+		"on non-empty int Optional with zero value": optionalOrElseJSONTC[int]{
+			opt:         Of(0),
+			defaultJSON: "-1",
+			expectValue: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrElseJSONTC[int]{
+			opt:         Of(123),
+			defaultJSON: "-1",
+			expectValue: 123,
+		},
+		"on empty int Optional given invalid JSON": optionalOrElseJSONTC[int]{
+			opt:         Empty[int](),
+			defaultJSON: `"abc"`,
+			expectError: true,
+		},
+		"on empty string Optional": optionalOrElseJSONTC[string]{
+			opt:         Empty[string](),
+			defaultJSON: `"unknown"`,
+			expectValue: "unknown",
+		},
+		"on non-empty string Optional with zero value": optionalOrElseJSONTC[string]{
+			opt:         Of(""),
+			defaultJSON: `"unknown"`,
+			expectValue: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrElseJSONTC[string]{
+			opt:         Of("abc"),
+			defaultJSON: `"unknown"`,
+			expectValue: "abc",
+		},
+		// Other test cases...
+		"on empty []string Optional": optionalOrElseJSONTC[[]string]{
+			opt:         Empty[[]string](),
+			defaultJSON: `["a","b"]`,
+			expectValue: []string{"a", "b"},
+		},
+		"on empty struct Optional given partially invalid JSON": optionalOrElseJSONTC[struct{ A, B int }]{
+			opt:         Empty[struct{ A, B int }](),
+			defaultJSON: `{"A":1,"B":"abc"}`,
+			expectError: true,
+		},
+		"on empty int Optional given malformed JSON": optionalOrElseJSONTC[int]{
+			opt:         Empty[int](),
+			defaultJSON: "{",
+			expectError: true,
+		},
+		"on non-empty int Optional given malformed JSON": optionalOrElseJSONTC[int]{
+			opt:         Of(123),
+			defaultJSON: "{",
+			expectValue: 123,
+		},
+	})
+}
+
+func BenchmarkOptional_OrElseKey(b *testing.B) {
+	defaults := map[string]int{"port": 8080}
+	opt := Empty[int]()
+	for i := 0; i < b.N; i++ {
+		_ = opt.OrElseKey("port", defaults)
+	}
+}
+
+type optionalOrElseKeyTC[T any] struct {
+	opt         Optional[T]
+	key         string
+	defaults    map[string]T
+	expectValue T
+	test.Control
+}
+
+func (tc optionalOrElseKeyTC[T]) Test(t *testing.T) {
+	value := tc.opt.OrElseKey(tc.key, tc.defaults)
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+}
+
+func TestOptional_OrElseKey(t *testing.T) {
+	intDefaults := map[string]int{"port": 8080, "retries": 0}
+	stringDefaults := map[string]string{"host": "localhost"}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional given known key": optionalOrElseKeyTC[int]{
+			opt:         Empty[int](),
+			key:         "port",
+			defaults:    intDefaults,
+			expectValue: 8080,
+		},
+		"on empty int Optional given unknown key": optionalOrElseKeyTC[int]{
+			opt:         Empty[int](),
+			key:         "timeout",
+			defaults:    intDefaults,
+			expectValue: 0,
+		},
+		"on non-empty int Optional with zero value": optionalOrElseKeyTC[int]{
+			opt:         Of(0),
+			key:         "port",
+			defaults:    intDefaults,
+			expectValue: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrElseKeyTC[int]{
+			opt:         Of(123),
+			key:         "port",
+			defaults:    intDefaults,
+			expectValue: 123,
+		},
+		"on empty string Optional given known key": optionalOrElseKeyTC[string]{
+			opt:         Empty[string](),
+			key:         "host",
+			defaults:    stringDefaults,
+			expectValue: "localhost",
+		},
+		"on empty string Optional given unknown key": optionalOrElseKeyTC[string]{
+			opt:         Empty[string](),
+			key:         "user",
+			defaults:    stringDefaults,
+			expectValue: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrElseKeyTC[string]{
+			opt:         Of("example.com"),
+			key:         "host",
+			defaults:    stringDefaults,
+			expectValue: "example.com",
+		},
+		// Other test cases...
+		"on empty int Optional given known key with zero value": optionalOrElseKeyTC[int]{
+			opt:         Empty[int](),
+			key:         "retries",
+			defaults:    intDefaults,
+			expectValue: 0,
+		},
+		"on empty int Optional given nil defaults": optionalOrElseKeyTC[int]{
+			opt:         Empty[int](),
+			key:         "port",
+			expectValue: 0,
+		},
+	})
+}
+
+func BenchmarkOptional_OrElseTryGet(b *testing.B) {
+	defaultFunc := func() (int, error) {
+		return -1, nil
+	}
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		if _, err := opt.OrElseTryGet(defaultFunc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalOrElseTryGetTC[T any] struct {
+	opt         Optional[T]
+	other       func() (T, error)
+	expectError bool
+	expectValue T
+	test.Control
+}
+
+func (tc optionalOrElseTryGetTC[T]) Test(t *testing.T) {
+	value, err := tc.opt.OrElseTryGet(tc.other)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+}
+
+func TestOptional_OrElseTryGet(t *testing.T) {
+	defaultInt := -1
+	defaultIntFunc := func() (int, error) {
+		return defaultInt, nil
+	}
+	defaultString := "unknown"
+	defaultStringFunc := func(err error) func() (string, error) {
+		return func() (string, error) {
+			if err != nil {
+				return "", err
+			}
+			return defaultString, nil
+		}
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalOrElseTryGetTC[int]{
+			opt:         Empty[int](),
+			other:       defaultIntFunc,
+			expectValue: defaultInt,
+		},
+		"on non-empty int Optional with zero value": optionalOrElseTryGetTC[int]{
+			opt:         Of(0),
+			other:       defaultIntFunc,
+			expectValue: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrElseTryGetTC[int]{
+			opt:         Of(123),
+			other:       defaultIntFunc,
+			expectValue: 123,
+		},
+		"on empty string Optional": optionalOrElseTryGetTC[string]{
+			opt:         Empty[string](),
+			other:       defaultStringFunc(nil),
+			expectValue: defaultString,
+		},
+		"on non-empty string Optional with zero value": optionalOrElseTryGetTC[string]{
+			opt:         Of(""),
+			other:       defaultStringFunc(nil),
+			expectValue: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrElseTryGetTC[string]{
+			opt:         Of("abc"),
+			other:       defaultStringFunc(nil),
+			expectValue: "abc",
+		},
+		"on empty string Optional given function triggering erroneous default call": optionalOrElseTryGetTC[string]{
+			opt:         Empty[string](),
+			other:       defaultStringFunc(errors.New("default string already used")),
+			expectError: true,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_OrderKey(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_, _ = opt.OrderKey()
+	}
+}
+
+type optionalOrderKeyTC[T any] struct {
+	opt         Optional[T]
+	expectEmpty bool
+	expectValue T
+	test.Control
+}
+
+func (tc optionalOrderKeyTC[T]) Test(t *testing.T) {
+	empty, value := tc.opt.OrderKey()
+	assert.Equal(t, tc.expectEmpty, empty, "unexpected emptiness")
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+}
+
+func TestOptional_OrderKey(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalOrderKeyTC[int]{
+			opt:         Empty[int](),
+			expectEmpty: true,
+			expectValue: 0,
+		},
+		"on non-empty int Optional with zero value": optionalOrderKeyTC[int]{
+			opt:         Of(0),
+			expectEmpty: false,
+			expectValue: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalOrderKeyTC[int]{
+			opt:         Of(123),
+			expectEmpty: false,
+			expectValue: 123,
+		},
+		"on empty string Optional": optionalOrderKeyTC[string]{
+			opt:         Empty[string](),
+			expectEmpty: true,
+			expectValue: "",
+		},
+		"on non-empty string Optional with zero value": optionalOrderKeyTC[string]{
+			opt:         Of(""),
+			expectEmpty: false,
+			expectValue: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalOrderKeyTC[string]{
+			opt:         Of("abc"),
+			expectEmpty: false,
+			expectValue: "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_Pair(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_, _ = opt.Pair()
+	}
+}
+
+type optionalPairTC[T any] struct {
+	opt           Optional[T]
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc optionalPairTC[T]) Test(t *testing.T) {
+	value, present := tc.opt.Pair()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestOptional_Pair(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalPairTC[int]{
+			opt:           Empty[int](),
+			expectPresent: false,
+			expectValue:   0,
+		},
+		"on non-empty int Optional with zero value": optionalPairTC[int]{
+			opt:           Of(0),
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on non-empty int Optional with non-zero value": optionalPairTC[int]{
+			opt:           Of(123),
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty string Optional": optionalPairTC[string]{
+			opt:           Empty[string](),
+			expectPresent: false,
+			expectValue:   "",
+		},
+		"on non-empty string Optional with zero value": optionalPairTC[string]{
+			opt:           Of(""),
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"on non-empty string Optional with non-zero value": optionalPairTC[string]{
+			opt:           Of("abc"),
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_PresenceInt(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.PresenceInt()
+	}
+}
+
+type optionalPresenceIntTC[T any] struct {
+	opt    Optional[T]
+	expect int
+	test.Control
+}
+
+func (tc optionalPresenceIntTC[T]) Test(t *testing.T) {
+	actual := tc.opt.PresenceInt()
+	assert.Equal(t, tc.expect, actual, "unexpected presence int")
+}
+
+func TestOptional_PresenceInt(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalPresenceIntTC[int]{
+			opt:    Empty[int](),
+			expect: 0,
+		},
+		"on non-empty int Optional with zero value": optionalPresenceIntTC[int]{
+			opt:    Of(0),
+			expect: 1,
+		},
+		"on non-empty int Optional with non-zero value": optionalPresenceIntTC[int]{
+			opt:    Of(123),
+			expect: 1,
+		},
+		"on empty string Optional": optionalPresenceIntTC[string]{
+			opt:    Empty[string](),
+			expect: 0,
+		},
+		"on non-empty string Optional with zero value": optionalPresenceIntTC[string]{
+			opt:    Of(""),
+			expect: 1,
+		},
+		"on non-empty string Optional with non-zero value": optionalPresenceIntTC[string]{
+			opt:    Of("abc"),
+			expect: 1,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_ProtoPtr(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.ProtoPtr()
+	}
+}
+
+type optionalProtoPtrTC[T any] struct {
+	opt    Optional[T]
+	expect *T
+	test.Control
+}
+
+func (tc optionalProtoPtrTC[T]) Test(t *testing.T) {
+	ptr := tc.opt.ProtoPtr()
+	assert.Equal(t, tc.expect, ptr, "unexpected pointer")
+	if ptr != nil {
+		assert.NotSame(t, &tc.opt.value, ptr, "expected pointer to copy of value")
+	}
+}
+
+func TestOptional_ProtoPtr(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalProtoPtrTC[int]{
+			opt:    Empty[int](),
+			expect: nil,
+		},
+		"on non-empty int Optional with zero value": optionalProtoPtrTC[int]{
+			opt:    Of(0),
+			expect: ptrs.ZeroInt(),
+		},
+		"on non-empty int Optional with non-zero value": optionalProtoPtrTC[int]{
+			opt:    Of(123),
+			expect: ptrs.Int(123),
+		},
+		"on empty string Optional": optionalProtoPtrTC[string]{
+			opt:    Empty[string](),
+			expect: nil,
+		},
+		"on non-empty string Optional with zero value": optionalProtoPtrTC[string]{
+			opt:    Of(""),
+			expect: ptrs.ZeroString(),
+		},
+		"on non-empty string Optional with non-zero value": optionalProtoPtrTC[string]{
+			opt:    Of("abc"),
+			expect: ptrs.String("abc"),
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_Quoted(b *testing.B) {
+	opt := Of("abc")
+	for i := 0; i < b.N; i++ {
+		_ = opt.Quoted()
+	}
+}
+
+type optionalQuotedTC[T any] struct {
+	opt    Optional[T]
+	expect string
+	test.Control
+}
+
+func (tc optionalQuotedTC[T]) Test(t *testing.T) {
+	actual := tc.opt.Quoted()
+	assert.Equal(t, tc.expect, actual, "unexpected string representation")
+}
+
+func TestOptional_Quoted(t *testing.T) {
+	type String string
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalQuotedTC[int]{
+			opt:    Empty[int](),
+			expect: "<empty>",
+		},
+		"on non-empty int Optional with zero value": optionalQuotedTC[int]{
+			opt:    Of(0),
+			expect: "0",
+		},
+		"on non-empty int Optional with non-zero value": optionalQuotedTC[int]{
+			opt:    Of(123),
+			expect: "123",
+		},
+		"on empty string Optional": optionalQuotedTC[string]{
+			opt:    Empty[string](),
+			expect: "<empty>",
+		},
+		"on non-empty string Optional with zero value": optionalQuotedTC[string]{
+			opt:    Of(""),
+			expect: `""`,
+		},
+		"on non-empty string Optional with non-zero value": optionalQuotedTC[string]{
+			opt:    Of("abc"),
+			expect: `"abc"`,
+		},
+		// Other test cases...
+		"on non-empty string Optional with whitespace value": optionalQuotedTC[string]{
+			opt:    Of(" abc\n"),
+			expect: `" abc\n"`,
+		},
+		"on non-empty string-kind Optional with non-zero value": optionalQuotedTC[String]{
+			opt:    Of(String("abc")),
+			expect: `"abc"`,
+		},
+	})
+}
+
+func BenchmarkOptional_Require(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.Require()
+	}
+}
+
+type optionalRequireTC[T any] struct {
+	opt         Optional[T]
+	expectPanic bool
+	expectValue T
+	test.Control
+}
+
+func (tc optionalRequireTC[T]) Test(t *testing.T) {
+	if tc.expectPanic {
+		assert.Panics(t, func() {
+			tc.opt.Require()
+		}, "expected panic")
+	} else {
+		var value T
+		assert.NotPanics(t, func() {
+			value = tc.opt.Require()
+		}, "unexpected panic")
+		assert.Equal(t, tc.expectValue, value, "unexpected value")
+	}
+}
+
+func TestOptional_Require(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalRequireTC[int]{
+			opt:         Empty[int](),
+			expectPanic: true,
+		},
+		"on non-empty int Optional with zero value": optionalRequireTC[int]{
+			opt:         Of(0),
+			expectValue: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalRequireTC[int]{
+			opt:         Of(123),
+			expectValue: 123,
+		},
+		"on empty string Optional": optionalRequireTC[string]{
+			opt:         Empty[string](),
+			expectPanic: true,
+		},
+		"on non-empty string Optional with zero value": optionalRequireTC[string]{
+			opt:         Of(""),
+			expectValue: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalRequireTC[string]{
+			opt:         Of("abc"),
+			expectValue: "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_RequireNoError(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.RequireNoError(nil)
+	}
+}
+
+type optionalRequireNoErrorTC[T any] struct {
+	opt         Optional[T]
+	err         error
+	expectPanic any
+	expectValue T
+	test.Control
+}
+
+func (tc optionalRequireNoErrorTC[T]) Test(t *testing.T) {
+	if tc.expectPanic != nil {
+		assert.PanicsWithValue(t, tc.expectPanic, func() {
+			tc.opt.RequireNoError(tc.err)
+		}, "expected panic")
+	} else {
+		var value T
+		assert.NotPanics(t, func() {
+			value = tc.opt.RequireNoError(tc.err)
+		}, "unexpected panic")
+		assert.Equal(t, tc.expectValue, value, "unexpected value")
+	}
+}
+
+func TestOptional_RequireNoError(t *testing.T) {
+	err := errors.New("failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalRequireNoErrorTC[int]{
+			opt:         Empty[int](),
+			expectPanic: ErrNotPresent,
+		},
+		"on empty int Optional given error": optionalRequireNoErrorTC[int]{
+			opt:         Empty[int](),
+			err:         err,
+			expectPanic: err,
+		},
+		"on non-empty int Optional with zero value": optionalRequireNoErrorTC[int]{
+			opt:         Of(0),
+			expectValue: 0,
+		},
+		"on non-empty int Optional with non-zero value": optionalRequireNoErrorTC[int]{
+			opt:         Of(123),
+			expectValue: 123,
+		},
+		"on empty string Optional": optionalRequireNoErrorTC[string]{
+			opt:         Empty[string](),
+			expectPanic: ErrNotPresent,
+		},
+		"on non-empty string Optional with zero value": optionalRequireNoErrorTC[string]{
+			opt:         Of(""),
+			expectValue: "",
+		},
+		"on non-empty string Optional with non-zero value": optionalRequireNoErrorTC[string]{
+			opt:         Of("abc"),
+			expectValue: "abc",
+		},
+		// Other test cases...
+		"on non-empty int Optional given error": optionalRequireNoErrorTC[int]{
+			opt:         Of(123),
+			err:         err,
+			expectPanic: err,
+		},
+	})
+}
+
+func BenchmarkOptional_Sanitize(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.Sanitize()
+	}
+}
+
+type optionalSanitizeTC[T any] struct {
+	opt           Optional[T]
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc optionalSanitizeTC[T]) Test(t *testing.T) {
+	opt := tc.opt.Sanitize()
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestOptional_Sanitize(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalSanitizeTC[int]{
+			opt:           Empty[int](),
+			expectPresent: false,
+		},
+		"on non-empty int Optional with zero value": optionalSanitizeTC[int]{
+			opt:           Of(0),
+			expectPresent: false,
+		},
+		"on non-empty int Optional with non-zero value": optionalSanitizeTC[int]{
+			opt:           Of(123),
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int pointer Optional": optionalSanitizeTC[*int]{
+			opt:           Empty[*int](),
+			expectPresent: false,
+		},
+		"on non-empty int pointer Optional with nil value": optionalSanitizeTC[*int]{
+			opt:           Of[*int](nil),
+			expectPresent: false,
+		},
+		"on non-empty int pointer Optional with zero value": optionalSanitizeTC[*int]{
+			opt:           Of(ptrs.ZeroInt()),
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt(),
+		},
+		"on non-empty int pointer Optional with non-zero value": optionalSanitizeTC[*int]{
+			opt:           Of(ptrs.Int(123)),
+			expectPresent: true,
+			expectValue:   ptrs.Int(123),
+		},
+		"on empty string Optional": optionalSanitizeTC[string]{
+			opt:           Empty[string](),
+			expectPresent: false,
+		},
+		"on non-empty string Optional with zero value": optionalSanitizeTC[string]{
+			opt:           Of(""),
+			expectPresent: false,
+		},
+		"on non-empty string Optional with non-zero value": optionalSanitizeTC[string]{
+			opt:           Of("abc"),
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+		"on non-empty int slice Optional with nil value": optionalSanitizeTC[[]int]{
+			opt:           Of([]int(nil)),
+			expectPresent: false,
+		},
+		"on non-empty int slice Optional with non-nil value": optionalSanitizeTC[[]int]{
+			opt:           Of([]int{}),
+			expectPresent: true,
+			expectValue:   []int{},
+		},
+	})
+}
+
+func BenchmarkOptional_Scan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var opt Optional[int]
+		if err := opt.Scan(int64(123)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// valuerScanSrc is a test type that implements both driver.Valuer and fmt.Stringer, where the String method does not
+// represent the value.
+type valuerScanSrc struct {
+	err   error
+	value driver.Value
+}
+
+func (v valuerScanSrc) String() string {
+	return "valuer"
+}
+
+func (v valuerScanSrc) Value() (driver.Value, error) {
+	return v.value, v.err
+}
+
+// unsignedScanSrc is a test type that mimics the unsigned wrapper types exposed by some database drivers, exposing both a
+// Uint64 accessor and a String method that does not represent the value numerically.
+type unsignedScanSrc struct {
+	value uint64
+}
+
+func (u unsignedScanSrc) String() string {
+	return fmt.Sprintf("unsigned(%d)", u.value)
+}
+
+func (u unsignedScanSrc) Uint64() uint64 {
+	return u.value
+}
+
+type optionalScanTC[S, T any] struct {
+	opt           Optional[T]
+	src           S
+	expectError   bool
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc optionalScanTC[S, T]) Test(t *testing.T) {
+	err := tc.opt.Scan(tc.src)
+	value, present := tc.opt.Get()
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestOptional_Scan(t *testing.T) {
+	type (
+		Bool    bool
+		Bytes   []byte
+		Float32 float32
+		Float64 float64
+		Int     int
+		Int8    int8
+		Int16   int16
+		Int32   int32
+		Int64   int64
+		String  string
+		Time    time.Time
+		Uint    uint
+		Uint8   uint8
+		Uint16  uint16
+		Uint32  uint32
+		Uint64  uint64
+	)
+
+	var (
+		maxFloat64String = strconv.FormatFloat(math.MaxFloat64, 'g', -1, 64)
+		maxInt64String   = strconv.FormatInt(math.MaxInt64, 10)
+		maxUint64String  = strconv.FormatUint(math.MaxUint64, 10)
+		minFloat64String = strconv.FormatFloat(-math.MaxFloat64, 'g', -1, 64)
+		minInt64String   = strconv.FormatInt(math.MinInt64, 10)
+		timeNow          = time.Now().UTC()
+		timeNowString    = timeNow.Format(time.RFC3339Nano)
+		timeZeroString   = time.Time{}.Format(time.RFC3339Nano)
+	)
+
+	test.RunCases(t, test.Cases{
+		// Test cases for bool source
+		// Supported destination types (incl. pointers and convertible types):
+		// bool, string, []byte, sql.RawBytes, any
+		"on empty bool Optional given zero bool source": optionalScanTC[bool, bool]{
+			src:           false,
+			expectPresent: true,
+			expectValue:   false,
+		},
+		"on empty bool Optional given non-zero bool source": optionalScanTC[bool, bool]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty *bool Optional given zero bool source": optionalScanTC[bool, *bool]{
+			src:           false,
+			expectPresent: true,
+			expectValue:   ptrs.False(),
+		},
+		"on empty *bool Optional given non-zero bool source": optionalScanTC[bool, *bool]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   ptrs.True(),
+		},
+		"on empty Bool Optional given non-zero bool source": optionalScanTC[bool, Bool]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty *Bool Optional given non-zero bool source": optionalScanTC[bool, *Bool]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Bool](true),
+		},
+		"on empty string Optional given zero bool source": optionalScanTC[bool, string]{
+			src:           false,
+			expectPresent: true,
+			expectValue:   "false",
+		},
+		"on empty string Optional given non-zero bool source": optionalScanTC[bool, string]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   "true",
+		},
+		"on empty *string Optional given zero bool source": optionalScanTC[bool, *string]{
+			src:           false,
+			expectPresent: true,
+			expectValue:   ptrs.String("false"),
 		},
 		"on empty *string Optional given non-zero bool source": optionalScanTC[bool, *string]{
 			src:           true,
 			expectPresent: true,
-			expectValue:   ptrs.String("true"),
+			expectValue:   ptrs.String("true"),
+		},
+		"on empty String Optional given non-zero bool source": optionalScanTC[bool, String]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   "true",
+		},
+		"on empty *String Optional given non-zero bool source": optionalScanTC[bool, *String]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   ptrs.Value[String]("true"),
+		},
+		"on empty []byte Optional given zero bool source": optionalScanTC[bool, []byte]{
+			src:           false,
+			expectPresent: true,
+			expectValue:   []byte("false"),
+		},
+		"on empty []byte Optional given non-zero bool source": optionalScanTC[bool, []byte]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   []byte("true"),
+		},
+		"on empty Bytes Optional given non-zero bool source": optionalScanTC[bool, Bytes]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   Bytes("true"),
+		},
+		"on empty sql.RawBytes Optional given non-zero bool source": optionalScanTC[bool, sql.RawBytes]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   sql.RawBytes("true"),
+		},
+		"on empty any Optional given zero bool source": optionalScanTC[bool, any]{
+			src:           false,
+			expectPresent: true,
+			expectValue:   false,
+		},
+		"on empty any Optional given non-zero bool source": optionalScanTC[bool, any]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty Optional of unsupported slice given non-zero bool source": optionalScanTC[bool, []uintptr]{
+			src:         true,
+			expectError: true,
+		},
+		"on empty Optional of unsupported type given non-zero bool source": optionalScanTC[bool, uintptr]{
+			src:         true,
+			expectError: true,
+		},
+		"on empty sql.NullBool Optional given non-zero bool source": optionalScanTC[bool, sql.NullBool]{
+			src:           true,
+			expectPresent: true,
+			expectValue:   sql.NullBool{Bool: true, Valid: true},
+		},
+		// Test cases for float64 source
+		// Supported destination types (incl. pointers and convertible types):
+		// float32, float64, bool, int, int8, int16, int32, int64, string, uint, uint8, uint16, uint32, uint64, []byte,
+		// sql.RawBytes, any
+		"on empty bool Optional given zero float64 source": optionalScanTC[float64, bool]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   false,
+		},
+		"on empty bool Optional given negative non-zero float64 source": optionalScanTC[float64, bool]{
+			src:         -1,
+			expectError: true,
+		},
+		"on empty bool Optional given positive one float64 source": optionalScanTC[float64, bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty bool Optional given positive non-integer float64 source": optionalScanTC[float64, bool]{
+			src:         2.5,
+			expectError: true,
+		},
+		"on empty bool Optional given positive fractional float64 source less than one": optionalScanTC[float64, bool]{
+			src:         0.5,
+			expectError: true,
+		},
+		"on empty *bool Optional given zero float64 source": optionalScanTC[float64, *bool]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.False(),
+		},
+		"on empty *bool Optional given positive one float64 source": optionalScanTC[float64, *bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   ptrs.True(),
+		},
+		"on empty Bool Optional given positive one float64 source": optionalScanTC[float64, Bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty *Bool Optional given positive one float64 source": optionalScanTC[float64, *Bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Bool](true),
+		},
+		"on empty float32 Optional given zero float64 source": optionalScanTC[float64, float32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty float32 Optional given negative non-zero float64 source": optionalScanTC[float64, float32]{
+			src:           -123.456,
+			expectPresent: true,
+			expectValue:   -123.456,
+		},
+		"on empty float32 Optional given negative non-zero float64 source that exceeds min float32": optionalScanTC[float64, float32]{
+			src:         -math.MaxFloat64,
+			expectError: true,
+		},
+		"on empty float32 Optional given positive non-zero float64 source": optionalScanTC[float64, float32]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty float32 Optional given positive non-zero float64 source that exceeds max float32": optionalScanTC[float64, float32]{
+			src:         math.MaxFloat64,
+			expectError: true,
+		},
+		"on empty *float32 Optional given zero float64 source": optionalScanTC[float64, *float32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroFloat32(),
+		},
+		"on empty *float32 Optional given non-zero float64 source": optionalScanTC[float64, *float32]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   ptrs.Float32(123.456),
+		},
+		"on empty Float32 Optional given non-zero float64 source": optionalScanTC[float64, Float32]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty Float32 Optional given non-zero float64 source that exceeds max float32": optionalScanTC[float64, Float32]{
+			src:         math.MaxFloat64,
+			expectError: true,
+		},
+		"on empty *Float32 Optional given non-zero float64 source": optionalScanTC[float64, *Float32]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Float32](123.456),
+		},
+		"on empty float64 Optional given zero float64 source": optionalScanTC[float64, float64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty float64 Optional given negative non-zero float64 source": optionalScanTC[float64, float64]{
+			src:           -123.456,
+			expectPresent: true,
+			expectValue:   -123.456,
+		},
+		"on empty float64 Optional given positive non-zero float64 source": optionalScanTC[float64, float64]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty *float64 Optional given zero float64 source": optionalScanTC[float64, *float64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroFloat64(),
+		},
+		"on empty *float64 Optional given non-zero float64 source": optionalScanTC[float64, *float64]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   ptrs.Float64(123.456),
+		},
+		"on empty Float64 Optional given non-zero float64 source": optionalScanTC[float64, Float64]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty *Float64 Optional given non-zero float64 source": optionalScanTC[float64, *Float64]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Float64](123.456),
+		},
+		"on empty int Optional given zero float64 source": optionalScanTC[float64, int]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int Optional given negative non-zero float64 source": optionalScanTC[float64, int]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int]{
+			src:         -123.456,
+			expectError: true,
+		},
+		"on empty int Optional given negative non-zero float64 source that exceeds min int": optionalScanTC[float64, int]{
+			src:         math.Ceil(-math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty int Optional given positive non-zero float64 source": optionalScanTC[float64, int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty int Optional given positive non-zero float64 source that exceeds max int": optionalScanTC[float64, int]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *int Optional given zero float64 source": optionalScanTC[float64, *int]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt(),
+		},
+		"on empty *int Optional given non-zero float64 source": optionalScanTC[float64, *int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int(123),
+		},
+		"on empty Int Optional given non-zero float64 source": optionalScanTC[float64, Int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Int Optional given non-zero float64 source that exceeds max int": optionalScanTC[float64, Int]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Int Optional given non-zero float64 source": optionalScanTC[float64, *Int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int](123),
+		},
+		"on empty int8 Optional given zero float64 source": optionalScanTC[float64, int8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int8 Optional given negative non-zero float64 source": optionalScanTC[float64, int8]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int8 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int8]{
+			src:         -123.456,
+			expectError: true,
+		},
+		"on empty int8 Optional given negative non-zero float64 source that exceeds min int8": optionalScanTC[float64, int8]{
+			src:         math.Ceil(-math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty int8 Optional given positive non-zero float64 source": optionalScanTC[float64, int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int8 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int8]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty int8 Optional given positive non-zero float64 source that exceeds max int8": optionalScanTC[float64, int8]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *int8 Optional given zero float64 source": optionalScanTC[float64, *int8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt8(),
+		},
+		"on empty *int8 Optional given non-zero float64 source": optionalScanTC[float64, *int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int8(123),
+		},
+		"on empty Int8 Optional given non-zero float64 source": optionalScanTC[float64, Int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int8 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int8]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Int8 Optional given non-zero float64 source that exceeds max int8": optionalScanTC[float64, Int8]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Int8 Optional given non-zero float64 source": optionalScanTC[float64, *Int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int8](123),
+		},
+		"on empty int16 Optional given zero float64 source": optionalScanTC[float64, int16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int16 Optional given negative non-zero float64 source": optionalScanTC[float64, int16]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int16 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int16]{
+			src:         -123.456,
+			expectError: true,
+		},
+		"on empty int16 Optional given negative non-zero float64 source that exceeds min int16": optionalScanTC[float64, int16]{
+			src:         math.Ceil(-math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty int16 Optional given positive non-zero float64 source": optionalScanTC[float64, int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int16 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int16]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty int16 Optional given positive non-zero float64 source that exceeds max int16": optionalScanTC[float64, int16]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *int16 Optional given zero float64 source": optionalScanTC[float64, *int16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt16(),
+		},
+		"on empty *int16 Optional given non-zero float64 source": optionalScanTC[float64, *int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int16(123),
+		},
+		"on empty Int16 Optional given non-zero float64 source": optionalScanTC[float64, Int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int16 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int16]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Int16 Optional given non-zero float64 source that exceeds max int16": optionalScanTC[float64, Int16]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Int16 Optional given non-zero float64 source": optionalScanTC[float64, *Int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int16](123),
+		},
+		"on empty int32 Optional given zero float64 source": optionalScanTC[float64, int32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int32 Optional given negative non-zero float64 source": optionalScanTC[float64, int32]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int32 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int32]{
+			src:         -123.456,
+			expectError: true,
+		},
+		"on empty int32 Optional given negative non-zero float64 source that exceeds min int32": optionalScanTC[float64, int32]{
+			src:         math.Ceil(-math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty int32 Optional given positive non-zero float64 source": optionalScanTC[float64, int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int32 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int32]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty int32 Optional given positive non-zero float64 source that exceeds max int32": optionalScanTC[float64, int32]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *int32 Optional given zero float64 source": optionalScanTC[float64, *int32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt32(),
+		},
+		"on empty *int32 Optional given non-zero float64 source": optionalScanTC[float64, *int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int32(123),
+		},
+		"on empty Int32 Optional given non-zero float64 source": optionalScanTC[float64, Int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int32 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int32]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Int32 Optional given non-zero float64 source that exceeds max int32": optionalScanTC[float64, Int32]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Int32 Optional given non-zero float64 source": optionalScanTC[float64, *Int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int32](123),
+		},
+		"on empty int64 Optional given zero float64 source": optionalScanTC[float64, int64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int64 Optional given negative non-zero float64 source": optionalScanTC[float64, int64]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int64 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int64]{
+			src:         -123.456,
+			expectError: true,
+		},
+		"on empty int64 Optional given negative non-zero float64 source that exceeds min int64": optionalScanTC[float64, int64]{
+			src:         math.Ceil(-math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty int64 Optional given positive non-zero float64 source": optionalScanTC[float64, int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int64 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int64]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty int64 Optional given positive non-zero float64 source that exceeds max int64": optionalScanTC[float64, int64]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *int64 Optional given zero float64 source": optionalScanTC[float64, *int64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt64(),
+		},
+		"on empty *int64 Optional given non-zero float64 source": optionalScanTC[float64, *int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int64(123),
+		},
+		"on empty Int64 Optional given non-zero float64 source": optionalScanTC[float64, Int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int64 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int64]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Int64 Optional given non-zero float64 source that exceeds max int64": optionalScanTC[float64, Int64]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Int64 Optional given non-zero float64 source": optionalScanTC[float64, *Int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int64](123),
+		},
+		"on empty string Optional given zero float64 source": optionalScanTC[float64, string]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   "0",
+		},
+		"on empty string Optional given negative non-zero float64 source": optionalScanTC[float64, string]{
+			src:           -123.456,
+			expectPresent: true,
+			expectValue:   "-123.456",
+		},
+		"on empty string Optional given positive non-zero float64 source": optionalScanTC[float64, string]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   "123.456",
+		},
+		"on empty *string Optional given zero float64 source": optionalScanTC[float64, *string]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.String("0"),
+		},
+		"on empty *string Optional given non-zero float64 source": optionalScanTC[float64, *string]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   ptrs.String("123.456"),
+		},
+		"on empty String Optional given non-zero float64 source": optionalScanTC[float64, String]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   "123.456",
+		},
+		"on empty *String Optional given non-zero float64 source": optionalScanTC[float64, *String]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   ptrs.Value[String]("123.456"),
+		},
+		"on empty uint Optional given zero float64 source": optionalScanTC[float64, uint]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint Optional given negative non-zero float64 source": optionalScanTC[float64, uint]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint Optional given positive non-zero float64 source": optionalScanTC[float64, uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty uint Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty uint Optional given positive non-zero float64 source that exceeds max uint": optionalScanTC[float64, uint]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *uint Optional given zero float64 source": optionalScanTC[float64, *uint]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint(),
+		},
+		"on empty *uint Optional given non-zero float64 source": optionalScanTC[float64, *uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint(123),
+		},
+		"on empty Uint Optional given non-zero float64 source": optionalScanTC[float64, Uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Uint Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Uint Optional given non-zero float64 source that exceeds max uint": optionalScanTC[float64, Uint]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Uint Optional given non-zero float64 source": optionalScanTC[float64, *Uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint](123),
+		},
+		"on empty uint8 Optional given zero float64 source": optionalScanTC[float64, uint8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint8 Optional given negative non-zero float64 source": optionalScanTC[float64, uint8]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint8 Optional given positive non-zero float64 source": optionalScanTC[float64, uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty uint8 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint8]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty uint8 Optional given positive non-zero float64 source that exceeds max uint8": optionalScanTC[float64, uint8]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *uint8 Optional given zero float64 source": optionalScanTC[float64, *uint8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint8(),
+		},
+		"on empty *uint8 Optional given non-zero float64 source": optionalScanTC[float64, *uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint8(123),
+		},
+		"on empty Uint8 Optional given non-zero float64 source": optionalScanTC[float64, Uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Uint8 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint8]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Uint8 Optional given non-zero float64 source that exceeds max uint8": optionalScanTC[float64, Uint8]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Uint8 Optional given non-zero float64 source": optionalScanTC[float64, *Uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint8](123),
+		},
+		"on empty uint16 Optional given zero float64 source": optionalScanTC[float64, uint16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint16 Optional given negative non-zero float64 source": optionalScanTC[float64, uint16]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint16 Optional given positive non-zero float64 source": optionalScanTC[float64, uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty uint16 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint16]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty uint16 Optional given positive non-zero float64 source that exceeds max int16": optionalScanTC[float64, uint16]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *uint16 Optional given zero float64 source": optionalScanTC[float64, *uint16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint16(),
+		},
+		"on empty *uint16 Optional given non-zero float64 source": optionalScanTC[float64, *uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint16(123),
+		},
+		"on empty Uint16 Optional given non-zero float64 source": optionalScanTC[float64, Uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Uint16 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint16]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Uint16 Optional given non-zero float64 source that exceeds max uint16": optionalScanTC[float64, Uint16]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Uint16 Optional given non-zero float64 source": optionalScanTC[float64, *Uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint16](123),
+		},
+		"on empty uint32 Optional given zero float64 source": optionalScanTC[float64, uint32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint32 Optional given negative non-zero float64 source": optionalScanTC[float64, uint32]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint32 Optional given positive non-zero float64 source": optionalScanTC[float64, uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty uint32 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint32]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty uint32 Optional given positive non-zero float64 source that exceeds max int32": optionalScanTC[float64, uint32]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *uint32 Optional given zero float64 source": optionalScanTC[float64, *uint32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint32(),
+		},
+		"on empty *uint32 Optional given non-zero float64 source": optionalScanTC[float64, *uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint32(123),
+		},
+		"on empty Uint32 Optional given non-zero float64 source": optionalScanTC[float64, Uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Uint32 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint32]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Uint32 Optional given non-zero float64 source that exceeds max uint32": optionalScanTC[float64, Uint32]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Uint32 Optional given non-zero float64 source": optionalScanTC[float64, *Uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint32](123),
+		},
+		"on empty uint64 Optional given zero float64 source": optionalScanTC[float64, uint64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint64 Optional given negative non-zero float64 source": optionalScanTC[float64, uint64]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint64 Optional given positive non-zero float64 source": optionalScanTC[float64, uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty uint64 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint64]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty uint64 Optional given positive non-zero float64 source that exceeds max int64": optionalScanTC[float64, uint64]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *uint64 Optional given zero float64 source": optionalScanTC[float64, *uint64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint64(),
+		},
+		"on empty *uint64 Optional given non-zero float64 source": optionalScanTC[float64, *uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint64(123),
+		},
+		"on empty Uint64 Optional given non-zero float64 source": optionalScanTC[float64, Uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Uint64 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint64]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Uint64 Optional given non-zero float64 source that exceeds max uint64": optionalScanTC[float64, Uint64]{
+			src:         math.Floor(math.MaxFloat64),
+			expectError: true,
+		},
+		"on empty *Uint64 Optional given non-zero float64 source": optionalScanTC[float64, *Uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint64](123),
+		},
+		"on empty []byte Optional given zero float64 source": optionalScanTC[float64, []byte]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   []byte("0"),
+		},
+		"on empty []byte Optional given negative non-zero float64 source": optionalScanTC[float64, []byte]{
+			src:           -123.456,
+			expectPresent: true,
+			expectValue:   []byte("-123.456"),
+		},
+		"on empty []byte Optional given positive non-zero float64 source": optionalScanTC[float64, []byte]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   []byte("123.456"),
+		},
+		"on empty Bytes Optional given non-zero float64 source": optionalScanTC[float64, Bytes]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   Bytes("123.456"),
+		},
+		"on empty sql.RawBytes Optional given non-zero float64 source": optionalScanTC[float64, sql.RawBytes]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   sql.RawBytes("123.456"),
+		},
+		"on empty any Optional given zero float64 source": optionalScanTC[float64, any]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   float64(0),
+		},
+		"on empty any Optional given non-zero float64 source": optionalScanTC[float64, any]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty Optional of unsupported slice given non-zero float64 source": optionalScanTC[float64, []uintptr]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty Optional of unsupported type given non-zero float64 source": optionalScanTC[float64, uintptr]{
+			src:         123.456,
+			expectError: true,
+		},
+		"on empty sql.NullFloat64 Optional given non-zero float64 source": optionalScanTC[float64, sql.NullFloat64]{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   sql.NullFloat64{Float64: 123.456, Valid: true},
+		},
+		// Test cases for int64 source
+		// Supported destination types (incl. pointers and convertible types):
+		// int, int8, int16, int32, int64, bool, float32, float64, string, uint, uint8, uint16, uint32, uint64, []byte,
+		// sql.RawBytes, any
+		"on empty int Optional given zero int64 source": optionalScanTC[int64, int]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int Optional given negative non-zero int64 source": optionalScanTC[int64, int]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int Optional given positive non-zero int64 source": optionalScanTC[int64, int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *int Optional given zero int64 source": optionalScanTC[int64, *int]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt(),
+		},
+		"on empty *int Optional given non-zero int64 source": optionalScanTC[int64, *int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int(123),
+		},
+		"on empty Int Optional given non-zero int64 source": optionalScanTC[int64, Int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Int Optional given non-zero int64 source": optionalScanTC[int64, *Int]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int](123),
+		},
+		"on empty int8 Optional given zero int64 source": optionalScanTC[int64, int8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int8 Optional given negative non-zero int64 source": optionalScanTC[int64, int8]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int8 Optional given negative non-zero int64 source that exceeds min int8": optionalScanTC[int64, int8]{
+			src:         math.MinInt64,
+			expectError: true,
+		},
+		"on empty int8 Optional given positive non-zero int64 source": optionalScanTC[int64, int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int8 Optional given positive non-zero int64 source that exceeds max int8": optionalScanTC[int64, int8]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *int8 Optional given zero int64 source": optionalScanTC[int64, *int8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt8(),
+		},
+		"on empty *int8 Optional given non-zero int64 source": optionalScanTC[int64, *int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int8(123),
+		},
+		"on empty Int8 Optional given non-zero int64 source": optionalScanTC[int64, Int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int8 Optional given non-zero int64 source that exceeds max int8": optionalScanTC[int64, Int8]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *Int8 Optional given non-zero int64 source": optionalScanTC[int64, *Int8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int8](123),
+		},
+		"on empty int16 Optional given zero int64 source": optionalScanTC[int64, int16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int16 Optional given negative non-zero int64 source": optionalScanTC[int64, int16]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int16 Optional given negative non-zero int64 source that exceeds min int16": optionalScanTC[int64, int16]{
+			src:         math.MinInt64,
+			expectError: true,
+		},
+		"on empty int16 Optional given positive non-zero int64 source": optionalScanTC[int64, int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int16 Optional given positive non-zero int64 source that exceeds max int16": optionalScanTC[int64, int16]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *int16 Optional given zero int64 source": optionalScanTC[int64, *int16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt16(),
+		},
+		"on empty *int16 Optional given non-zero int64 source": optionalScanTC[int64, *int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int16(123),
+		},
+		"on empty Int16 Optional given non-zero int64 source": optionalScanTC[int64, Int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int16 Optional given non-zero int64 source that exceeds max int16": optionalScanTC[int64, Int16]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *Int16 Optional given non-zero int64 source": optionalScanTC[int64, *Int16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int16](123),
+		},
+		"on empty int32 Optional given zero int64 source": optionalScanTC[int64, int32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int32 Optional given negative non-zero int64 source": optionalScanTC[int64, int32]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int32 Optional given negative non-zero int64 source that exceeds min int32": optionalScanTC[int64, int32]{
+			src:         math.MinInt64,
+			expectError: true,
+		},
+		"on empty int32 Optional given positive non-zero int64 source": optionalScanTC[int64, int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int32 Optional given positive non-zero int64 source that exceeds max int32": optionalScanTC[int64, int32]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *int32 Optional given zero int64 source": optionalScanTC[int64, *int32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt32(),
+		},
+		"on empty *int32 Optional given non-zero int64 source": optionalScanTC[int64, *int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int32(123),
+		},
+		"on empty Int32 Optional given non-zero int64 source": optionalScanTC[int64, Int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Int32 Optional given non-zero int64 source that exceeds max int32": optionalScanTC[int64, Int32]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *Int32 Optional given non-zero int64 source": optionalScanTC[int64, *Int32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int32](123),
+		},
+		"on empty int64 Optional given zero int64 source": optionalScanTC[int64, int64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int64 Optional given negative non-zero int64 source": optionalScanTC[int64, int64]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int64 Optional given positive non-zero int64 source": optionalScanTC[int64, int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *int64 Optional given zero int64 source": optionalScanTC[int64, *int64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt64(),
+		},
+		"on empty *int64 Optional given non-zero int64 source": optionalScanTC[int64, *int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Int64(123),
+		},
+		"on empty Int64 Optional given non-zero int64 source": optionalScanTC[int64, Int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Int64 Optional given non-zero int64 source": optionalScanTC[int64, *Int64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int64](123),
+		},
+		"on empty bool Optional given zero int64 source": optionalScanTC[int64, bool]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   false,
+		},
+		"on empty bool Optional given negative non-zero int64 source": optionalScanTC[int64, bool]{
+			src:         -1,
+			expectError: true,
+		},
+		"on empty bool Optional given positive one int64 source": optionalScanTC[int64, bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty bool Optional given positive non-zero int64 source greater than one": optionalScanTC[int64, bool]{
+			src:         2,
+			expectError: true,
+		},
+		"on empty *bool Optional given zero int64 source": optionalScanTC[int64, *bool]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.False(),
+		},
+		"on empty *bool Optional given positive one int64 source": optionalScanTC[int64, *bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   ptrs.True(),
+		},
+		"on empty Bool Optional given positive one int64 source": optionalScanTC[int64, Bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty *Bool Optional given positive one int64 source": optionalScanTC[int64, *Bool]{
+			src:           1,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Bool](true),
+		},
+		"on empty float32 Optional given zero int64 source": optionalScanTC[int64, float32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty float32 Optional given negative non-zero int64 source": optionalScanTC[int64, float32]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty float32 Optional given positive non-zero int64 source": optionalScanTC[int64, float32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *float32 Optional given zero int64 source": optionalScanTC[int64, *float32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroFloat32(),
+		},
+		"on empty *float32 Optional given non-zero int64 source": optionalScanTC[int64, *float32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Float32(123),
+		},
+		"on empty Float32 Optional given non-zero int64 source": optionalScanTC[int64, Float32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Float32 Optional given non-zero int64 source": optionalScanTC[int64, *Float32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Float32](123),
+		},
+		"on empty float64 Optional given zero int64 source": optionalScanTC[int64, float64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty float64 Optional given negative non-zero int64 source": optionalScanTC[int64, float64]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty float64 Optional given positive non-zero int64 source": optionalScanTC[int64, float64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *float64 Optional given zero int64 source": optionalScanTC[int64, *float64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroFloat64(),
+		},
+		"on empty *float64 Optional given non-zero int64 source": optionalScanTC[int64, *float64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Float64(123),
+		},
+		"on empty Float64 Optional given non-zero int64 source": optionalScanTC[int64, Float64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Float64 Optional given non-zero int64 source": optionalScanTC[int64, *Float64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Float64](123),
+		},
+		"on empty string Optional given zero int64 source": optionalScanTC[int64, string]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   "0",
+		},
+		"on empty string Optional given negative non-zero int64 source": optionalScanTC[int64, string]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   "-123",
+		},
+		"on empty string Optional given positive non-zero int64 source": optionalScanTC[int64, string]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   "123",
+		},
+		"on empty *string Optional given zero int64 source": optionalScanTC[int64, *string]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.String("0"),
+		},
+		"on empty *string Optional given non-zero int64 source": optionalScanTC[int64, *string]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.String("123"),
+		},
+		"on empty String Optional given non-zero int64 source": optionalScanTC[int64, String]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   "123",
+		},
+		"on empty *String Optional given non-zero int64 source": optionalScanTC[int64, *String]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[String]("123"),
+		},
+		"on empty uint Optional given zero int64 source": optionalScanTC[int64, uint]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint Optional given negative non-zero int64 source": optionalScanTC[int64, uint]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint Optional given positive non-zero int64 source": optionalScanTC[int64, uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *uint Optional given zero int64 source": optionalScanTC[int64, *uint]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint(),
+		},
+		"on empty *uint Optional given non-zero int64 source": optionalScanTC[int64, *uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint(123),
+		},
+		"on empty Uint Optional given non-zero int64 source": optionalScanTC[int64, Uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Uint Optional given non-zero int64 source": optionalScanTC[int64, *Uint]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint](123),
+		},
+		"on empty uint8 Optional given zero int64 source": optionalScanTC[int64, uint8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint8 Optional given negative non-zero int64 source": optionalScanTC[int64, uint8]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint8 Optional given positive non-zero int64 source": optionalScanTC[int64, uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty uint8 Optional given positive non-zero int64 source that exceeds max uint8": optionalScanTC[int64, uint8]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *uint8 Optional given zero int64 source": optionalScanTC[int64, *uint8]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint8(),
+		},
+		"on empty *uint8 Optional given non-zero int64 source": optionalScanTC[int64, *uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint8(123),
+		},
+		"on empty Uint8 Optional given non-zero int64 source": optionalScanTC[int64, Uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Uint8 Optional given non-zero int64 source that exceeds max uint8": optionalScanTC[int64, Uint8]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *Uint8 Optional given non-zero int64 source": optionalScanTC[int64, *Uint8]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint8](123),
+		},
+		"on empty uint16 Optional given zero int64 source": optionalScanTC[int64, uint16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint16 Optional given negative non-zero int64 source": optionalScanTC[int64, uint16]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint16 Optional given positive non-zero int64 source": optionalScanTC[int64, uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty uint16 Optional given positive non-zero int64 source that exceeds max uint16": optionalScanTC[int64, uint16]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *uint16 Optional given zero int64 source": optionalScanTC[int64, *uint16]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint16(),
+		},
+		"on empty *uint16 Optional given non-zero int64 source": optionalScanTC[int64, *uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint16(123),
+		},
+		"on empty Uint16 Optional given non-zero int64 source": optionalScanTC[int64, Uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty Uint16 Optional given non-zero int64 source that exceeds max uint16": optionalScanTC[int64, Uint16]{
+			src:         math.MaxInt64,
+			expectError: true,
+		},
+		"on empty *Uint16 Optional given non-zero int64 source": optionalScanTC[int64, *Uint16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint16](123),
+		},
+		"on empty uint32 Optional given zero int64 source": optionalScanTC[int64, uint32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint32 Optional given negative non-zero int64 source": optionalScanTC[int64, uint32]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint32 Optional given positive non-zero int64 source": optionalScanTC[int64, uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *uint32 Optional given zero int64 source": optionalScanTC[int64, *uint32]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint32(),
+		},
+		"on empty *uint32 Optional given non-zero int64 source": optionalScanTC[int64, *uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint32(123),
+		},
+		"on empty Uint32 Optional given non-zero int64 source": optionalScanTC[int64, Uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Uint32 Optional given non-zero int64 source": optionalScanTC[int64, *Uint32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint32](123),
+		},
+		"on empty uint64 Optional given zero int64 source": optionalScanTC[int64, uint64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty uint64 Optional given negative non-zero int64 source": optionalScanTC[int64, uint64]{
+			src:         -123,
+			expectError: true,
+		},
+		"on empty uint64 Optional given positive non-zero int64 source": optionalScanTC[int64, uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *uint64 Optional given zero int64 source": optionalScanTC[int64, *uint64]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   ptrs.ZeroUint64(),
+		},
+		"on empty *uint64 Optional given non-zero int64 source": optionalScanTC[int64, *uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Uint64(123),
+		},
+		"on empty Uint64 Optional given non-zero int64 source": optionalScanTC[int64, Uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Uint64 Optional given non-zero int64 source": optionalScanTC[int64, *Uint64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint64](123),
+		},
+		"on empty []byte Optional given zero int64 source": optionalScanTC[int64, []byte]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   []byte("0"),
+		},
+		"on empty []byte Optional given negative non-zero int64 source": optionalScanTC[int64, []byte]{
+			src:           -123,
+			expectPresent: true,
+			expectValue:   []byte("-123"),
+		},
+		"on empty []byte Optional given positive non-zero int64 source": optionalScanTC[int64, []byte]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   []byte("123"),
+		},
+		"on empty Bytes Optional given non-zero int64 source": optionalScanTC[int64, Bytes]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   Bytes("123"),
+		},
+		"on empty sql.RawBytes Optional given non-zero int64 source": optionalScanTC[int64, sql.RawBytes]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   sql.RawBytes("123"),
+		},
+		"on empty any Optional given zero int64 source": optionalScanTC[int64, any]{
+			src:           0,
+			expectPresent: true,
+			expectValue:   int64(0),
+		},
+		"on empty any Optional given non-zero int64 source": optionalScanTC[int64, any]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   int64(123),
+		},
+		"on empty Optional of unsupported slice given non-zero int64 source": optionalScanTC[int64, []uintptr]{
+			src:         123,
+			expectError: true,
+		},
+		"on empty Optional of unsupported type given non-zero int64 source": optionalScanTC[int64, uintptr]{
+			src:         123,
+			expectError: true,
+		},
+		"on empty sql.NullByte Optional given non-zero int source": optionalScanTC[int64, sql.NullByte]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   sql.NullByte{Byte: 123, Valid: true},
+		},
+		"on empty sql.NullInt16 Optional given non-zero int64 source": optionalScanTC[int64, sql.NullInt16]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   sql.NullInt16{Int16: 123, Valid: true},
+		},
+		"on empty sql.NullInt32 Optional given non-zero int64 source": optionalScanTC[int64, sql.NullInt32]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   sql.NullInt32{Int32: 123, Valid: true},
+		},
+		"on empty sql.NullInt64 Optional given non-zero int64 source": optionalScanTC[int64, sql.NullInt64]{
+			src:           123,
+			expectPresent: true,
+			expectValue:   sql.NullInt64{Int64: 123, Valid: true},
+		},
+		// Test cases for string source
+		// Supported destination types (incl. pointers and convertible types):
+		// string, bool, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, []byte,
+		// sql.RawBytes, any
+		"on empty string Optional given zero string source": optionalScanTC[string, string]{
+			src:           "",
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"on empty string Optional given non-zero string source": optionalScanTC[string, string]{
+			src:           "abc",
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		"on empty *string Optional given zero string source": optionalScanTC[string, *string]{
+			src:           "",
+			expectPresent: true,
+			expectValue:   ptrs.ZeroString(),
+		},
+		"on empty *string Optional given non-zero string source": optionalScanTC[string, *string]{
+			src:           "abc",
+			expectPresent: true,
+			expectValue:   ptrs.String("abc"),
+		},
+		"on empty String Optional given non-zero string source": optionalScanTC[string, String]{
+			src:           "abc",
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		"on empty *String Optional given non-zero string source": optionalScanTC[string, *String]{
+			src:           "abc",
+			expectPresent: true,
+			expectValue:   ptrs.Value[String]("abc"),
+		},
+		"on empty bool Optional given zero string source": optionalScanTC[string, bool]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty bool Optional given false string source": optionalScanTC[string, bool]{
+			src:           "false",
+			expectPresent: true,
+			expectValue:   false,
+		},
+		"on empty bool Optional given true string source": optionalScanTC[string, bool]{
+			src:           "true",
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty bool Optional given non-boolean string source": optionalScanTC[string, bool]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *bool Optional given zero string source": optionalScanTC[string, *bool]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *bool Optional given boolean string source": optionalScanTC[string, *bool]{
+			src:           "true",
+			expectPresent: true,
+			expectValue:   ptrs.True(),
+		},
+		"on empty *bool Optional given non-boolean string source": optionalScanTC[string, *bool]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty Bool Optional given boolean string source": optionalScanTC[string, Bool]{
+			src:           "true",
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"on empty *Bool Optional given boolean string source": optionalScanTC[string, *Bool]{
+			src:           "false",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Bool](false),
+		},
+		"on empty float32 Optional given zero string source": optionalScanTC[string, float32]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty float32 Optional given zero float string source": optionalScanTC[string, float32]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty float32 Optional given negative non-zero float string source": optionalScanTC[string, float32]{
+			src:           "-123.456",
+			expectPresent: true,
+			expectValue:   -123.456,
+		},
+		"on empty float32 Optional given negative non-zero float string source that exceeds min float32": optionalScanTC[string, float32]{
+			src:         minFloat64String,
+			expectError: true,
+		},
+		"on empty float32 Optional given positive non-zero float string source": optionalScanTC[string, float32]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty float32 Optional given positive non-zero float string source that exceeds max float32": optionalScanTC[string, float32]{
+			src:         maxFloat64String,
+			expectError: true,
+		},
+		"on empty float32 Optional given non-float string source": optionalScanTC[string, float32]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *float32 Optional given zero string source": optionalScanTC[string, *float32]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *float32 Optional given zero float string source": optionalScanTC[string, *float32]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   ptrs.ZeroFloat32(),
+		},
+		"on empty *float32 Optional given negative float string source": optionalScanTC[string, *float32]{
+			src:           "-123.456",
+			expectPresent: true,
+			expectValue:   ptrs.Float32(-123.456),
+		},
+		"on empty *float32 Optional given positive float string source": optionalScanTC[string, *float32]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   ptrs.Float32(123.456),
+		},
+		"on empty *float32 Optional given non-float string source": optionalScanTC[string, *float32]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty Float32 Optional given float string source": optionalScanTC[string, Float32]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty *Float32 Optional given float string source": optionalScanTC[string, *Float32]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Float32](123.456),
+		},
+		"on empty float64 Optional given zero string source": optionalScanTC[string, float64]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty float64 Optional given zero float string source": optionalScanTC[string, float64]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty float64 Optional given negative non-zero float string source": optionalScanTC[string, float64]{
+			src:           "-123.456",
+			expectPresent: true,
+			expectValue:   -123.456,
+		},
+		"on empty float64 Optional given negative non-zero float string source that exceeds min float64": optionalScanTC[string, float64]{
+			src:         minFloat64String + "0",
+			expectError: true,
+		},
+		"on empty float64 Optional given positive non-zero float string source": optionalScanTC[string, float64]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty float64 Optional given positive non-zero float string source that exceeds max float64": optionalScanTC[string, float64]{
+			src:         maxFloat64String + "0",
+			expectError: true,
+		},
+		"on empty float64 Optional given non-float string source": optionalScanTC[string, float64]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *float64 Optional given zero string source": optionalScanTC[string, *float64]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *float64 Optional given zero float string source": optionalScanTC[string, *float64]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   ptrs.ZeroFloat64(),
+		},
+		"on empty *float64 Optional given negative float string source": optionalScanTC[string, *float64]{
+			src:           "-123.456",
+			expectPresent: true,
+			expectValue:   ptrs.Float64(-123.456),
+		},
+		"on empty *float64 Optional given positive float string source": optionalScanTC[string, *float64]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   ptrs.Float64(123.456),
+		},
+		"on empty *float64 Optional given non-float string source": optionalScanTC[string, *float64]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty Float64 Optional given float string source": optionalScanTC[string, Float64]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   123.456,
+		},
+		"on empty *Float64 Optional given float string source": optionalScanTC[string, *Float64]{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Float64](123.456),
+		},
+		"on empty int Optional given zero string source": optionalScanTC[string, int]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty int Optional given zero int string source": optionalScanTC[string, int]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int Optional given negative non-zero int string source": optionalScanTC[string, int]{
+			src:           "-123",
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int]{
+			src:         "-123.456",
+			expectError: true,
+		},
+		"on empty int Optional given negative non-zero int string source that exceeds min int": optionalScanTC[string, int]{
+			src:         minInt64String + "0",
+			expectError: true,
+		},
+		"on empty int Optional given positive non-zero int string source": optionalScanTC[string, int]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int]{
+			src:         "123.456",
+			expectError: true,
+		},
+		"on empty int Optional given positive non-zero int string source that exceeds max int": optionalScanTC[string, int]{
+			src:         maxInt64String + "0",
+			expectError: true,
+		},
+		"on empty int Optional given non-int string source": optionalScanTC[string, int]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *int Optional given zero string source": optionalScanTC[string, *int]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *int Optional given zero int string source": optionalScanTC[string, *int]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt(),
+		},
+		"on empty *int Optional given negative int string source": optionalScanTC[string, *int]{
+			src:           "-123",
+			expectPresent: true,
+			expectValue:   ptrs.Int(-123),
+		},
+		"on empty *int Optional given positive int string source": optionalScanTC[string, *int]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Int(123),
+		},
+		"on empty *int Optional given non-int string source": optionalScanTC[string, *int]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty Int Optional given int string source": optionalScanTC[string, Int]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Int Optional given int string source": optionalScanTC[string, *Int]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int](123),
+		},
+		"on empty int8 Optional given zero string source": optionalScanTC[string, int8]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty int8 Optional given zero int string source": optionalScanTC[string, int8]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int8 Optional given negative non-zero int string source": optionalScanTC[string, int8]{
+			src:           "-123",
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int8 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int8]{
+			src:         "-123.456",
+			expectError: true,
+		},
+		"on empty int8 Optional given negative non-zero int string source that exceeds min int8": optionalScanTC[string, int8]{
+			src:         minInt64String,
+			expectError: true,
+		},
+		"on empty int8 Optional given positive non-zero int string source": optionalScanTC[string, int8]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int8 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int8]{
+			src:         "123.456",
+			expectError: true,
+		},
+		"on empty int8 Optional given positive non-zero int string source that exceeds max int8": optionalScanTC[string, int8]{
+			src:         maxInt64String,
+			expectError: true,
+		},
+		"on empty int8 Optional given non-int string source": optionalScanTC[string, int8]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *int8 Optional given zero string source": optionalScanTC[string, *int8]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *int8 Optional given zero int string source": optionalScanTC[string, *int8]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt8(),
+		},
+		"on empty *int8 Optional given negative int string source": optionalScanTC[string, *int8]{
+			src:           "-123",
+			expectPresent: true,
+			expectValue:   ptrs.Int8(-123),
+		},
+		"on empty *int8 Optional given positive int string source": optionalScanTC[string, *int8]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Int8(123),
+		},
+		"on empty *int8 Optional given non-int string source": optionalScanTC[string, *int8]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty Int8 Optional given int string source": optionalScanTC[string, Int8]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Int8 Optional given int string source": optionalScanTC[string, *Int8]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int8](123),
+		},
+		"on empty int16 Optional given zero string source": optionalScanTC[string, int16]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty int16 Optional given zero int string source": optionalScanTC[string, int16]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int16 Optional given negative non-zero int string source": optionalScanTC[string, int16]{
+			src:           "-123",
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int16 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int16]{
+			src:         "-123.456",
+			expectError: true,
+		},
+		"on empty int16 Optional given negative non-zero int string source that exceeds min int16": optionalScanTC[string, int16]{
+			src:         minInt64String,
+			expectError: true,
+		},
+		"on empty int16 Optional given positive non-zero int string source": optionalScanTC[string, int16]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int16 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int16]{
+			src:         "123.456",
+			expectError: true,
+		},
+		"on empty int16 Optional given positive non-zero int string source that exceeds max int16": optionalScanTC[string, int16]{
+			src:         maxInt64String,
+			expectError: true,
+		},
+		"on empty int16 Optional given non-int string source": optionalScanTC[string, int16]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *int16 Optional given zero string source": optionalScanTC[string, *int16]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *int16 Optional given zero int string source": optionalScanTC[string, *int16]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   ptrs.ZeroInt16(),
+		},
+		"on empty *int16 Optional given negative int string source": optionalScanTC[string, *int16]{
+			src:           "-123",
+			expectPresent: true,
+			expectValue:   ptrs.Int16(-123),
+		},
+		"on empty *int16 Optional given positive int string source": optionalScanTC[string, *int16]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Int16(123),
+		},
+		"on empty *int16 Optional given non-int string source": optionalScanTC[string, *int16]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty Int16 Optional given int string source": optionalScanTC[string, Int16]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Int16 Optional given int string source": optionalScanTC[string, *Int16]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int16](123),
+		},
+		"on empty int32 Optional given zero string source": optionalScanTC[string, int32]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty int32 Optional given zero int string source": optionalScanTC[string, int32]{
+			src:           "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on empty int32 Optional given negative non-zero int string source": optionalScanTC[string, int32]{
+			src:           "-123",
+			expectPresent: true,
+			expectValue:   -123,
+		},
+		"on empty int32 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int32]{
+			src:         "-123.456",
+			expectError: true,
+		},
+		"on empty int32 Optional given negative non-zero int string source that exceeds min int32": optionalScanTC[string, int32]{
+			src:         minInt64String,
+			expectError: true,
+		},
+		"on empty int32 Optional given positive non-zero int string source": optionalScanTC[string, int32]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty int32 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int32]{
+			src:         "123.456",
+			expectError: true,
+		},
+		"on empty int32 Optional given positive non-zero int string source that exceeds max int32": optionalScanTC[string, int32]{
+			src:         maxInt64String,
+			expectError: true,
+		},
+		"on empty int32 Optional given non-int string source": optionalScanTC[string, int32]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *int32 Optional given zero string source": optionalScanTC[string, *int32]{
+			src:         "",
+			expectError: true,
 		},
-		"on empty String Optional given non-zero bool source": optionalScanTC[bool, String]{
-			src:           true,
+		"on empty *int32 Optional given zero int string source": optionalScanTC[string, *int32]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   "true",
+			expectValue:   ptrs.ZeroInt32(),
 		},
-		"on empty *String Optional given non-zero bool source": optionalScanTC[bool, *String]{
-			src:           true,
+		"on empty *int32 Optional given negative int string source": optionalScanTC[string, *int32]{
+			src:           "-123",
 			expectPresent: true,
-			expectValue:   ptrs.Value[String]("true"),
+			expectValue:   ptrs.Int32(-123),
 		},
-		"on empty []byte Optional given zero bool source": optionalScanTC[bool, []byte]{
-			src:           false,
+		"on empty *int32 Optional given positive int string source": optionalScanTC[string, *int32]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   []byte("false"),
+			expectValue:   ptrs.Int32(123),
 		},
-		"on empty []byte Optional given non-zero bool source": optionalScanTC[bool, []byte]{
-			src:           true,
-			expectPresent: true,
-			expectValue:   []byte("true"),
+		"on empty *int32 Optional given non-int string source": optionalScanTC[string, *int32]{
+			src:         "abc",
+			expectError: true,
 		},
-		"on empty Bytes Optional given non-zero bool source": optionalScanTC[bool, Bytes]{
-			src:           true,
+		"on empty Int32 Optional given int string source": optionalScanTC[string, Int32]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   Bytes("true"),
+			expectValue:   123,
 		},
-		"on empty sql.RawBytes Optional given non-zero bool source": optionalScanTC[bool, sql.RawBytes]{
-			src:           true,
+		"on empty *Int32 Optional given int string source": optionalScanTC[string, *Int32]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   sql.RawBytes("true"),
+			expectValue:   ptrs.Value[Int32](123),
 		},
-		"on empty any Optional given zero bool source": optionalScanTC[bool, any]{
-			src:           false,
+		"on empty int64 Optional given zero string source": optionalScanTC[string, int64]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty int64 Optional given zero int string source": optionalScanTC[string, int64]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   false,
+			expectValue:   0,
 		},
-		"on empty any Optional given non-zero bool source": optionalScanTC[bool, any]{
-			src:           true,
+		"on empty int64 Optional given negative non-zero int string source": optionalScanTC[string, int64]{
+			src:           "-123",
 			expectPresent: true,
-			expectValue:   true,
+			expectValue:   -123,
 		},
-		"on empty Optional of unsupported slice given non-zero bool source": optionalScanTC[bool, []uintptr]{
-			src:         true,
+		"on empty int64 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int64]{
+			src:         "-123.456",
 			expectError: true,
 		},
-		"on empty Optional of unsupported type given non-zero bool source": optionalScanTC[bool, uintptr]{
-			src:         true,
+		"on empty int64 Optional given negative non-zero int string source that exceeds min int64": optionalScanTC[string, int64]{
+			src:         minInt64String + "0",
 			expectError: true,
 		},
-		"on empty sql.NullBool Optional given non-zero bool source": optionalScanTC[bool, sql.NullBool]{
-			src:           true,
-			expectPresent: true,
-			expectValue:   sql.NullBool{Bool: true, Valid: true},
-		},
-		// Test cases for float64 source
-		// Supported destination types (incl. pointers and convertible types):
-		// float32, float64, int, int8, int16, int32, int64, string, uint, uint8, uint16, uint32, uint64, []byte,
-		// sql.RawBytes, any
-		"on empty float32 Optional given zero float64 source": optionalScanTC[float64, float32]{
-			src:           0,
+		"on empty int64 Optional given positive non-zero int string source": optionalScanTC[string, int64]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   123,
 		},
-		"on empty float32 Optional given negative non-zero float64 source": optionalScanTC[float64, float32]{
-			src:           -123.456,
-			expectPresent: true,
-			expectValue:   -123.456,
+		"on empty int64 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int64]{
+			src:         "123.456",
+			expectError: true,
 		},
-		"on empty float32 Optional given negative non-zero float64 source that exceeds min float32": optionalScanTC[float64, float32]{
-			src:         -math.MaxFloat64,
+		"on empty int64 Optional given positive non-zero int string source that exceeds max int64": optionalScanTC[string, int64]{
+			src:         maxInt64String + "0",
 			expectError: true,
 		},
-		"on empty float32 Optional given positive non-zero float64 source": optionalScanTC[float64, float32]{
-			src:           123.456,
-			expectPresent: true,
-			expectValue:   123.456,
+		"on empty int64 Optional given non-int string source": optionalScanTC[string, int64]{
+			src:         "abc",
+			expectError: true,
 		},
-		"on empty float32 Optional given positive non-zero float64 source that exceeds max float32": optionalScanTC[float64, float32]{
-			src:         math.MaxFloat64,
+		"on empty *int64 Optional given zero string source": optionalScanTC[string, *int64]{
+			src:         "",
 			expectError: true,
 		},
-		"on empty *float32 Optional given zero float64 source": optionalScanTC[float64, *float32]{
-			src:           0,
+		"on empty *int64 Optional given zero int string source": optionalScanTC[string, *int64]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat32(),
+			expectValue:   ptrs.ZeroInt64(),
 		},
-		"on empty *float32 Optional given non-zero float64 source": optionalScanTC[float64, *float32]{
-			src:           123.456,
+		"on empty *int64 Optional given negative int string source": optionalScanTC[string, *int64]{
+			src:           "-123",
 			expectPresent: true,
-			expectValue:   ptrs.Float32(123.456),
+			expectValue:   ptrs.Int64(-123),
 		},
-		"on empty Float32 Optional given non-zero float64 source": optionalScanTC[float64, Float32]{
-			src:           123.456,
+		"on empty *int64 Optional given positive int string source": optionalScanTC[string, *int64]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   ptrs.Int64(123),
 		},
-		"on empty Float32 Optional given non-zero float64 source that exceeds max float32": optionalScanTC[float64, Float32]{
-			src:         math.MaxFloat64,
+		"on empty *int64 Optional given non-int string source": optionalScanTC[string, *int64]{
+			src:         "abc",
 			expectError: true,
 		},
-		"on empty *Float32 Optional given non-zero float64 source": optionalScanTC[float64, *Float32]{
-			src:           123.456,
+		"on empty Int64 Optional given int string source": optionalScanTC[string, Int64]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   ptrs.Value[Float32](123.456),
+			expectValue:   123,
 		},
-		"on empty float64 Optional given zero float64 source": optionalScanTC[float64, float64]{
-			src:           0,
+		"on empty *Int64 Optional given int string source": optionalScanTC[string, *Int64]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   ptrs.Value[Int64](123),
 		},
-		"on empty float64 Optional given negative non-zero float64 source": optionalScanTC[float64, float64]{
-			src:           -123.456,
+		"on empty uint Optional given zero string source": optionalScanTC[string, uint]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty uint Optional given zero int string source": optionalScanTC[string, uint]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   -123.456,
+			expectValue:   0,
 		},
-		"on empty float64 Optional given positive non-zero float64 source": optionalScanTC[float64, float64]{
-			src:           123.456,
+		"on empty uint Optional given negative non-zero int string source": optionalScanTC[string, uint]{
+			src:         "-123",
+			expectError: true,
+		},
+		"on empty uint Optional given positive non-zero int string source": optionalScanTC[string, uint]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   123,
 		},
-		"on empty *float64 Optional given zero float64 source": optionalScanTC[float64, *float64]{
-			src:           0,
+		"on empty uint Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint]{
+			src:         "123.456",
+			expectError: true,
+		},
+		"on empty uint Optional given positive non-zero int string source that exceeds max uint": optionalScanTC[string, uint]{
+			src:         maxUint64String + "0",
+			expectError: true,
+		},
+		"on empty uint Optional given non-int string source": optionalScanTC[string, uint]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *uint Optional given zero string source": optionalScanTC[string, *uint]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *uint Optional given zero int string source": optionalScanTC[string, *uint]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat64(),
+			expectValue:   ptrs.ZeroUint(),
 		},
-		"on empty *float64 Optional given non-zero float64 source": optionalScanTC[float64, *float64]{
-			src:           123.456,
+		"on empty *uint Optional given non-zero int string source": optionalScanTC[string, *uint]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   ptrs.Float64(123.456),
+			expectValue:   ptrs.Uint(123),
 		},
-		"on empty Float64 Optional given non-zero float64 source": optionalScanTC[float64, Float64]{
-			src:           123.456,
+		"on empty *uint Optional given non-int string source": optionalScanTC[string, *uint]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty Uint Optional given int string source": optionalScanTC[string, Uint]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   123,
 		},
-		"on empty *Float64 Optional given non-zero float64 source": optionalScanTC[float64, *Float64]{
-			src:           123.456,
+		"on empty *Uint Optional given int string source": optionalScanTC[string, *Uint]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   ptrs.Value[Float64](123.456),
+			expectValue:   ptrs.Value[Uint](123),
 		},
-		"on empty int Optional given zero float64 source": optionalScanTC[float64, int]{
-			src:           0,
+		"on empty uint8 Optional given zero string source": optionalScanTC[string, uint8]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty uint8 Optional given zero int string source": optionalScanTC[string, uint8]{
+			src:           "0",
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty int Optional given negative non-zero float64 source": optionalScanTC[float64, int]{
-			src:           -123,
+		"on empty uint8 Optional given negative non-zero int string source": optionalScanTC[string, uint8]{
+			src:         "-123",
+			expectError: true,
+		},
+		"on empty uint8 Optional given positive non-zero int string source": optionalScanTC[string, uint8]{
+			src:           "123",
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   123,
 		},
-		"on empty int Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int]{
-			src:         -123.456,
+		"on empty uint8 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint8]{
+			src:         "123.456",
 			expectError: true,
 		},
-		"on empty int Optional given negative non-zero float64 source that exceeds min int": optionalScanTC[float64, int]{
-			src:         math.Ceil(-math.MaxFloat64),
+		"on empty uint8 Optional given positive non-zero int string source that exceeds max uint8": optionalScanTC[string, uint8]{
+			src:         maxUint64String,
 			expectError: true,
 		},
-		"on empty int Optional given positive non-zero float64 source": optionalScanTC[float64, int]{
-			src:           123,
+		"on empty uint8 Optional given non-int string source": optionalScanTC[string, uint8]{
+			src:         "abc",
+			expectError: true,
+		},
+		"on empty *uint8 Optional given zero string source": optionalScanTC[string, *uint8]{
+			src:         "",
+			expectError: true,
+		},
+		"on empty *uint8 Optional given zero int string source": optionalScanTC[string, *uint8]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.ZeroUint8(),
 		},
-		"on empty int Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int]{
-			src:         123.456,
+		"on empty *uint8 Optional given non-zero int string source": optionalScanTC[string, *uint8]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Uint8(123),
+		},
+		"on empty *uint8 Optional given non-int string source": optionalScanTC[string, *uint8]{
+			src:         "abc",
 			expectError: true,
 		},
-		"on empty int Optional given positive non-zero float64 source that exceeds max int": optionalScanTC[float64, int]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty Uint8 Optional given int string source": optionalScanTC[string, Uint8]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on empty *Uint8 Optional given int string source": optionalScanTC[string, *Uint8]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint8](123),
+		},
+		"on empty uint16 Optional given zero string source": optionalScanTC[string, uint16]{
+			src:         "",
 			expectError: true,
 		},
-		"on empty *int Optional given zero float64 source": optionalScanTC[float64, *int]{
-			src:           0,
+		"on empty uint16 Optional given zero int string source": optionalScanTC[string, uint16]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt(),
+			expectValue:   0,
 		},
-		"on empty *int Optional given non-zero float64 source": optionalScanTC[float64, *int]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Int(123),
+		"on empty uint16 Optional given negative non-zero int string source": optionalScanTC[string, uint16]{
+			src:         "-123",
+			expectError: true,
 		},
-		"on empty Int Optional given non-zero float64 source": optionalScanTC[float64, Int]{
-			src:           123,
+		"on empty uint16 Optional given positive non-zero int string source": optionalScanTC[string, uint16]{
+			src:           "123",
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty Int Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int]{
-			src:         123.456,
+		"on empty uint16 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint16]{
+			src:         "123.456",
 			expectError: true,
 		},
-		"on empty Int Optional given non-zero float64 source that exceeds max int": optionalScanTC[float64, Int]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty uint16 Optional given positive non-zero int string source that exceeds max uint16": optionalScanTC[string, uint16]{
+			src:         maxUint64String,
 			expectError: true,
 		},
-		"on empty *Int Optional given non-zero float64 source": optionalScanTC[float64, *Int]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int](123),
+		"on empty uint16 Optional given non-int string source": optionalScanTC[string, uint16]{
+			src:         "abc",
+			expectError: true,
 		},
-		"on empty int8 Optional given zero float64 source": optionalScanTC[float64, int8]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   0,
+		"on empty *uint16 Optional given zero string source": optionalScanTC[string, *uint16]{
+			src:         "",
+			expectError: true,
 		},
-		"on empty int8 Optional given negative non-zero float64 source": optionalScanTC[float64, int8]{
-			src:           -123,
+		"on empty *uint16 Optional given zero int string source": optionalScanTC[string, *uint16]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   ptrs.ZeroUint16(),
 		},
-		"on empty int8 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int8]{
-			src:         -123.456,
-			expectError: true,
+		"on empty *uint16 Optional given non-zero int string source": optionalScanTC[string, *uint16]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Uint16(123),
 		},
-		"on empty int8 Optional given negative non-zero float64 source that exceeds min int8": optionalScanTC[float64, int8]{
-			src:         math.Ceil(-math.MaxFloat64),
+		"on empty *uint16 Optional given non-int string source": optionalScanTC[string, *uint16]{
+			src:         "abc",
 			expectError: true,
 		},
-		"on empty int8 Optional given positive non-zero float64 source": optionalScanTC[float64, int8]{
-			src:           123,
+		"on empty Uint16 Optional given int string source": optionalScanTC[string, Uint16]{
+			src:           "123",
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty int8 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int8]{
-			src:         123.456,
-			expectError: true,
+		"on empty *Uint16 Optional given int string source": optionalScanTC[string, *Uint16]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint16](123),
 		},
-		"on empty int8 Optional given positive non-zero float64 source that exceeds max int8": optionalScanTC[float64, int8]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty uint32 Optional given zero string source": optionalScanTC[string, uint32]{
+			src:         "",
 			expectError: true,
 		},
-		"on empty *int8 Optional given zero float64 source": optionalScanTC[float64, *int8]{
-			src:           0,
+		"on empty uint32 Optional given zero int string source": optionalScanTC[string, uint32]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt8(),
+			expectValue:   0,
 		},
-		"on empty *int8 Optional given non-zero float64 source": optionalScanTC[float64, *int8]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Int8(123),
+		"on empty uint32 Optional given negative non-zero int string source": optionalScanTC[string, uint32]{
+			src:         "-123",
+			expectError: true,
 		},
-		"on empty Int8 Optional given non-zero float64 source": optionalScanTC[float64, Int8]{
-			src:           123,
+		"on empty uint32 Optional given positive non-zero int string source": optionalScanTC[string, uint32]{
+			src:           "123",
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty Int8 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int8]{
-			src:         123.456,
+		"on empty uint32 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint32]{
+			src:         "123.456",
 			expectError: true,
 		},
-		"on empty Int8 Optional given non-zero float64 source that exceeds max int8": optionalScanTC[float64, Int8]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty uint32 Optional given positive non-zero int string source that exceeds max uint32": optionalScanTC[string, uint32]{
+			src:         maxUint64String,
 			expectError: true,
 		},
-		"on empty *Int8 Optional given non-zero float64 source": optionalScanTC[float64, *Int8]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int8](123),
+		"on empty uint32 Optional given non-int string source": optionalScanTC[string, uint32]{
+			src:         "abc",
+			expectError: true,
 		},
-		"on empty int16 Optional given zero float64 source": optionalScanTC[float64, int16]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   0,
+		"on empty *uint32 Optional given zero string source": optionalScanTC[string, *uint32]{
+			src:         "",
+			expectError: true,
 		},
-		"on empty int16 Optional given negative non-zero float64 source": optionalScanTC[float64, int16]{
-			src:           -123,
+		"on empty *uint32 Optional given zero int string source": optionalScanTC[string, *uint32]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   ptrs.ZeroUint32(),
 		},
-		"on empty int16 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int16]{
-			src:         -123.456,
-			expectError: true,
+		"on empty *uint32 Optional given non-zero int string source": optionalScanTC[string, *uint32]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Uint32(123),
 		},
-		"on empty int16 Optional given negative non-zero float64 source that exceeds min int16": optionalScanTC[float64, int16]{
-			src:         math.Ceil(-math.MaxFloat64),
+		"on empty *uint32 Optional given non-int string source": optionalScanTC[string, *uint32]{
+			src:         "abc",
 			expectError: true,
 		},
-		"on empty int16 Optional given positive non-zero float64 source": optionalScanTC[float64, int16]{
-			src:           123,
+		"on empty Uint32 Optional given int string source": optionalScanTC[string, Uint32]{
+			src:           "123",
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty int16 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int16]{
-			src:         123.456,
-			expectError: true,
+		"on empty *Uint32 Optional given int string source": optionalScanTC[string, *Uint32]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint32](123),
 		},
-		"on empty int16 Optional given positive non-zero float64 source that exceeds max int16": optionalScanTC[float64, int16]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty uint64 Optional given zero string source": optionalScanTC[string, uint64]{
+			src:         "",
 			expectError: true,
 		},
-		"on empty *int16 Optional given zero float64 source": optionalScanTC[float64, *int16]{
-			src:           0,
+		"on empty uint64 Optional given zero int string source": optionalScanTC[string, uint64]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt16(),
+			expectValue:   0,
 		},
-		"on empty *int16 Optional given non-zero float64 source": optionalScanTC[float64, *int16]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Int16(123),
+		"on empty uint64 Optional given negative non-zero int string source": optionalScanTC[string, uint64]{
+			src:         "-123",
+			expectError: true,
 		},
-		"on empty Int16 Optional given non-zero float64 source": optionalScanTC[float64, Int16]{
-			src:           123,
+		"on empty uint64 Optional given positive non-zero int string source": optionalScanTC[string, uint64]{
+			src:           "123",
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty Int16 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int16]{
-			src:         123.456,
+		"on empty uint64 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint64]{
+			src:         "123.456",
 			expectError: true,
 		},
-		"on empty Int16 Optional given non-zero float64 source that exceeds max int16": optionalScanTC[float64, Int16]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty uint64 Optional given positive non-zero int string source that exceeds max uint": optionalScanTC[string, uint64]{
+			src:         maxUint64String + "0",
 			expectError: true,
 		},
-		"on empty *Int16 Optional given non-zero float64 source": optionalScanTC[float64, *Int16]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int16](123),
+		"on empty uint64 Optional given non-int string source": optionalScanTC[string, uint64]{
+			src:         "abc",
+			expectError: true,
 		},
-		"on empty int32 Optional given zero float64 source": optionalScanTC[float64, int32]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   0,
+		"on empty *uint64 Optional given zero string source": optionalScanTC[string, *uint64]{
+			src:         "",
+			expectError: true,
 		},
-		"on empty int32 Optional given negative non-zero float64 source": optionalScanTC[float64, int32]{
-			src:           -123,
+		"on empty *uint64 Optional given zero int string source": optionalScanTC[string, *uint64]{
+			src:           "0",
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   ptrs.ZeroUint64(),
 		},
-		"on empty int32 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int32]{
-			src:         -123.456,
-			expectError: true,
+		"on empty *uint64 Optional given non-zero int string source": optionalScanTC[string, *uint64]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Uint64(123),
 		},
-		"on empty int32 Optional given negative non-zero float64 source that exceeds min int32": optionalScanTC[float64, int32]{
-			src:         math.Ceil(-math.MaxFloat64),
+		"on empty *uint64 Optional given non-int string source": optionalScanTC[string, *uint64]{
+			src:         "abc",
 			expectError: true,
 		},
-		"on empty int32 Optional given positive non-zero float64 source": optionalScanTC[float64, int32]{
-			src:           123,
+		"on empty Uint64 Optional given int string source": optionalScanTC[string, Uint64]{
+			src:           "123",
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty int32 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int32]{
-			src:         123.456,
-			expectError: true,
+		"on empty *Uint64 Optional given int string source": optionalScanTC[string, *Uint64]{
+			src:           "123",
+			expectPresent: true,
+			expectValue:   ptrs.Value[Uint64](123),
 		},
-		"on empty int32 Optional given positive non-zero float64 source that exceeds max int32": optionalScanTC[float64, int32]{
-			src:         math.Floor(math.MaxFloat64),
-			expectError: true,
+		"on empty []byte Optional given zero string source": optionalScanTC[string, []byte]{
+			src:           "",
+			expectPresent: true,
+			expectValue:   []byte(""),
 		},
-		"on empty *int32 Optional given zero float64 source": optionalScanTC[float64, *int32]{
-			src:           0,
+		"on empty []byte Optional given non-zero string source": optionalScanTC[string, []byte]{
+			src:           "abc",
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt32(),
+			expectValue:   []byte("abc"),
 		},
-		"on empty *int32 Optional given non-zero float64 source": optionalScanTC[float64, *int32]{
-			src:           123,
+		"on empty Bytes Optional given non-zero string source": optionalScanTC[string, Bytes]{
+			src:           "abc",
 			expectPresent: true,
-			expectValue:   ptrs.Int32(123),
+			expectValue:   Bytes("abc"),
 		},
-		"on empty Int32 Optional given non-zero float64 source": optionalScanTC[float64, Int32]{
-			src:           123,
+		"on empty sql.RawBytes Optional given non-zero string source": optionalScanTC[string, sql.RawBytes]{
+			src:           "abc",
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   sql.RawBytes("abc"),
 		},
-		"on empty Int32 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int32]{
-			src:         123.456,
+		"on empty any Optional given zero string source": optionalScanTC[string, any]{
+			src:           "",
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"on empty any Optional given non-zero string source": optionalScanTC[string, any]{
+			src:           "abc",
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		"on empty Optional of unsupported slice given non-zero string source": optionalScanTC[string, []uintptr]{
+			src:         "abc",
 			expectError: true,
 		},
-		"on empty Int32 Optional given non-zero float64 source that exceeds max int32": optionalScanTC[float64, Int32]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty Optional of unsupported type given non-zero string source": optionalScanTC[string, uintptr]{
+			src:         "abc",
 			expectError: true,
 		},
-		"on empty *Int32 Optional given non-zero float64 source": optionalScanTC[float64, *Int32]{
-			src:           123,
+		"on empty sql.NullString Optional given non-zero string source": optionalScanTC[string, sql.NullString]{
+			src:           "abc",
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int32](123),
+			expectValue:   sql.NullString{String: "abc", Valid: true},
 		},
-		"on empty int64 Optional given zero float64 source": optionalScanTC[float64, int64]{
-			src:           0,
+		// Test cases for []byte source
+		// Supported destination types (incl. pointers and convertible types):
+		// []byte, bool, float32, float64, int, int8, int16, int32, int64, string, uint, uint8, uint16, uint32, uint64,
+		// sql.RawBytes, any
+		"on empty []byte Optional given empty []byte source": optionalScanTC[[]byte, []byte]{
+			src:           []byte{},
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   []byte{},
 		},
-		"on empty int64 Optional given negative non-zero float64 source": optionalScanTC[float64, int64]{
-			src:           -123,
+		"on empty []byte Optional given non-empty []byte source": optionalScanTC[[]byte, []byte]{
+			src:           []byte("abc"),
 			expectPresent: true,
-			expectValue:   -123,
-		},
-		"on empty int64 Optional given negative non-zero float64 source that contains floating points": optionalScanTC[float64, int64]{
-			src:         -123.456,
-			expectError: true,
-		},
-		"on empty int64 Optional given negative non-zero float64 source that exceeds min int64": optionalScanTC[float64, int64]{
-			src:         math.Ceil(-math.MaxFloat64),
-			expectError: true,
+			expectValue:   []byte("abc"),
 		},
-		"on empty int64 Optional given positive non-zero float64 source": optionalScanTC[float64, int64]{
-			src:           123,
+		"on empty Bytes Optional given empty []byte source": optionalScanTC[[]byte, Bytes]{
+			src:           []byte{},
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   Bytes{},
 		},
-		"on empty int64 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, int64]{
-			src:         123.456,
-			expectError: true,
+		"on empty Bytes Optional given non-empty []byte source": optionalScanTC[[]byte, Bytes]{
+			src:           []byte("abc"),
+			expectPresent: true,
+			expectValue:   Bytes("abc"),
 		},
-		"on empty int64 Optional given positive non-zero float64 source that exceeds max int64": optionalScanTC[float64, int64]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty bool Optional given empty []byte source": optionalScanTC[[]byte, bool]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *int64 Optional given zero float64 source": optionalScanTC[float64, *int64]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt64(),
-		},
-		"on empty *int64 Optional given non-zero float64 source": optionalScanTC[float64, *int64]{
-			src:           123,
+		"on empty bool Optional given false []byte source": optionalScanTC[[]byte, bool]{
+			src:           []byte("false"),
 			expectPresent: true,
-			expectValue:   ptrs.Int64(123),
+			expectValue:   false,
 		},
-		"on empty Int64 Optional given non-zero float64 source": optionalScanTC[float64, Int64]{
-			src:           123,
+		"on empty bool Optional given true []byte source": optionalScanTC[[]byte, bool]{
+			src:           []byte("true"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   true,
 		},
-		"on empty Int64 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Int64]{
-			src:         123.456,
+		"on empty bool Optional given non-boolean []byte source": optionalScanTC[[]byte, bool]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty Int64 Optional given non-zero float64 source that exceeds max int64": optionalScanTC[float64, Int64]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty *bool Optional given empty []byte source": optionalScanTC[[]byte, *bool]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *Int64 Optional given non-zero float64 source": optionalScanTC[float64, *Int64]{
-			src:           123,
+		"on empty *bool Optional given boolean []byte source": optionalScanTC[[]byte, *bool]{
+			src:           []byte("true"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int64](123),
+			expectValue:   ptrs.True(),
 		},
-		"on empty string Optional given zero float64 source": optionalScanTC[float64, string]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   "0",
+		"on empty *bool Optional given non-boolean []byte source": optionalScanTC[[]byte, *bool]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty string Optional given negative non-zero float64 source": optionalScanTC[float64, string]{
-			src:           -123.456,
+		"on empty Bool Optional given boolean []byte source": optionalScanTC[[]byte, Bool]{
+			src:           []byte("true"),
 			expectPresent: true,
-			expectValue:   "-123.456",
+			expectValue:   true,
 		},
-		"on empty string Optional given positive non-zero float64 source": optionalScanTC[float64, string]{
-			src:           123.456,
+		"on empty *Bool Optional given boolean []byte source": optionalScanTC[[]byte, *Bool]{
+			src:           []byte("false"),
 			expectPresent: true,
-			expectValue:   "123.456",
+			expectValue:   ptrs.Value[Bool](false),
 		},
-		"on empty *string Optional given zero float64 source": optionalScanTC[float64, *string]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   ptrs.String("0"),
+		"on empty float32 Optional given empty []byte source": optionalScanTC[[]byte, float32]{
+			src:         []byte{},
+			expectError: true,
 		},
-		"on empty *string Optional given non-zero float64 source": optionalScanTC[float64, *string]{
-			src:           123.456,
+		"on empty float32 Optional given zero float []byte source": optionalScanTC[[]byte, float32]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.String("123.456"),
+			expectValue:   0,
 		},
-		"on empty String Optional given non-zero float64 source": optionalScanTC[float64, String]{
-			src:           123.456,
+		"on empty float32 Optional given negative non-zero float []byte source": optionalScanTC[[]byte, float32]{
+			src:           []byte("-123.456"),
 			expectPresent: true,
-			expectValue:   "123.456",
+			expectValue:   -123.456,
 		},
-		"on empty *String Optional given non-zero float64 source": optionalScanTC[float64, *String]{
-			src:           123.456,
-			expectPresent: true,
-			expectValue:   ptrs.Value[String]("123.456"),
+		"on empty float32 Optional given negative non-zero float []byte source that exceeds min float32": optionalScanTC[[]byte, float32]{
+			src:         []byte(minFloat64String),
+			expectError: true,
 		},
-		"on empty uint Optional given zero float64 source": optionalScanTC[float64, uint]{
-			src:           0,
+		"on empty float32 Optional given positive non-zero float []byte source": optionalScanTC[[]byte, float32]{
+			src:           []byte("123.456"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   123.456,
 		},
-		"on empty uint Optional given negative non-zero float64 source": optionalScanTC[float64, uint]{
-			src:         -123,
+		"on empty float32 Optional given positive non-zero float []byte source that exceeds max float32": optionalScanTC[[]byte, float32]{
+			src:         []byte(maxFloat64String),
 			expectError: true,
 		},
-		"on empty uint Optional given positive non-zero float64 source": optionalScanTC[float64, uint]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
-		},
-		"on empty uint Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint]{
-			src:         123.456,
+		"on empty float32 Optional given non-float []byte source": optionalScanTC[[]byte, float32]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty uint Optional given positive non-zero float64 source that exceeds max uint": optionalScanTC[float64, uint]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty *float32 Optional given empty []byte source": optionalScanTC[[]byte, *float32]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *uint Optional given zero float64 source": optionalScanTC[float64, *uint]{
-			src:           0,
+		"on empty *float32 Optional given zero float []byte source": optionalScanTC[[]byte, *float32]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint(),
+			expectValue:   ptrs.ZeroFloat32(),
 		},
-		"on empty *uint Optional given non-zero float64 source": optionalScanTC[float64, *uint]{
-			src:           123,
+		"on empty *float32 Optional given negative float []byte source": optionalScanTC[[]byte, *float32]{
+			src:           []byte("-123.456"),
 			expectPresent: true,
-			expectValue:   ptrs.Uint(123),
+			expectValue:   ptrs.Float32(-123.456),
 		},
-		"on empty Uint Optional given non-zero float64 source": optionalScanTC[float64, Uint]{
-			src:           123,
+		"on empty *float32 Optional given positive float []byte source": optionalScanTC[[]byte, *float32]{
+			src:           []byte("123.456"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.Float32(123.456),
 		},
-		"on empty Uint Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint]{
-			src:         123.456,
+		"on empty *float32 Optional given non-float []byte source": optionalScanTC[[]byte, *float32]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty Uint Optional given non-zero float64 source that exceeds max uint": optionalScanTC[float64, Uint]{
-			src:         math.Floor(math.MaxFloat64),
-			expectError: true,
+		"on empty Float32 Optional given float []byte source": optionalScanTC[[]byte, Float32]{
+			src:           []byte("123.456"),
+			expectPresent: true,
+			expectValue:   123.456,
 		},
-		"on empty *Uint Optional given non-zero float64 source": optionalScanTC[float64, *Uint]{
-			src:           123,
+		"on empty *Float32 Optional given float []byte source": optionalScanTC[[]byte, *Float32]{
+			src:           []byte("123.456"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint](123),
+			expectValue:   ptrs.Value[Float32](123.456),
 		},
-		"on empty uint8 Optional given zero float64 source": optionalScanTC[float64, uint8]{
-			src:           0,
+		"on empty float64 Optional given empty []byte source": optionalScanTC[[]byte, float64]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty float64 Optional given zero float []byte source": optionalScanTC[[]byte, float64]{
+			src:           []byte("0"),
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty uint8 Optional given negative non-zero float64 source": optionalScanTC[float64, uint8]{
-			src:         -123,
+		"on empty float64 Optional given negative non-zero float []byte source": optionalScanTC[[]byte, float64]{
+			src:           []byte("-123.456"),
+			expectPresent: true,
+			expectValue:   -123.456,
+		},
+		"on empty float64 Optional given negative non-zero float []byte source that exceeds min float64": optionalScanTC[[]byte, float64]{
+			src:         []byte(minFloat64String + "0"),
 			expectError: true,
 		},
-		"on empty uint8 Optional given positive non-zero float64 source": optionalScanTC[float64, uint8]{
-			src:           123,
+		"on empty float64 Optional given positive non-zero float []byte source": optionalScanTC[[]byte, float64]{
+			src:           []byte("123.456"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   123.456,
 		},
-		"on empty uint8 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint8]{
-			src:         123.456,
+		"on empty float64 Optional given positive non-zero float []byte source that exceeds max float64": optionalScanTC[[]byte, float64]{
+			src:         []byte(maxFloat64String + "0"),
 			expectError: true,
 		},
-		"on empty uint8 Optional given positive non-zero float64 source that exceeds max uint8": optionalScanTC[float64, uint8]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty float64 Optional given non-float []byte source": optionalScanTC[[]byte, float64]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty *uint8 Optional given zero float64 source": optionalScanTC[float64, *uint8]{
-			src:           0,
+		"on empty *float64 Optional given empty []byte source": optionalScanTC[[]byte, *float64]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty *float64 Optional given zero float []byte source": optionalScanTC[[]byte, *float64]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint8(),
+			expectValue:   ptrs.ZeroFloat64(),
 		},
-		"on empty *uint8 Optional given non-zero float64 source": optionalScanTC[float64, *uint8]{
-			src:           123,
+		"on empty *float64 Optional given negative float []byte source": optionalScanTC[[]byte, *float64]{
+			src:           []byte("-123.456"),
 			expectPresent: true,
-			expectValue:   ptrs.Uint8(123),
+			expectValue:   ptrs.Float64(-123.456),
 		},
-		"on empty Uint8 Optional given non-zero float64 source": optionalScanTC[float64, Uint8]{
-			src:           123,
+		"on empty *float64 Optional given positive float []byte source": optionalScanTC[[]byte, *float64]{
+			src:           []byte("123.456"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.Float64(123.456),
 		},
-		"on empty Uint8 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint8]{
-			src:         123.456,
+		"on empty *float64 Optional given non-float []byte source": optionalScanTC[[]byte, *float64]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty Uint8 Optional given non-zero float64 source that exceeds max uint8": optionalScanTC[float64, Uint8]{
-			src:         math.Floor(math.MaxFloat64),
-			expectError: true,
+		"on empty Float64 Optional given float []byte source": optionalScanTC[[]byte, Float64]{
+			src:           []byte("123.456"),
+			expectPresent: true,
+			expectValue:   123.456,
 		},
-		"on empty *Uint8 Optional given non-zero float64 source": optionalScanTC[float64, *Uint8]{
-			src:           123,
+		"on empty *Float64 Optional given float []byte source": optionalScanTC[[]byte, *Float64]{
+			src:           []byte("123.456"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint8](123),
+			expectValue:   ptrs.Value[Float64](123.456),
 		},
-		"on empty uint16 Optional given zero float64 source": optionalScanTC[float64, uint16]{
-			src:           0,
+		"on empty int Optional given empty []byte source": optionalScanTC[[]byte, int]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty int Optional given zero int []byte source": optionalScanTC[[]byte, int]{
+			src:           []byte("0"),
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty uint16 Optional given negative non-zero float64 source": optionalScanTC[float64, uint16]{
-			src:         -123,
-			expectError: true,
-		},
-		"on empty uint16 Optional given positive non-zero float64 source": optionalScanTC[float64, uint16]{
-			src:           123,
+		"on empty int Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   -123,
 		},
-		"on empty uint16 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint16]{
-			src:         123.456,
+		"on empty int Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int]{
+			src:         []byte("-123.456"),
 			expectError: true,
 		},
-		"on empty uint16 Optional given positive non-zero float64 source that exceeds max int16": optionalScanTC[float64, uint16]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty int Optional given negative non-zero int []byte source that exceeds min int": optionalScanTC[[]byte, int]{
+			src:         []byte(minInt64String + "0"),
 			expectError: true,
 		},
-		"on empty *uint16 Optional given zero float64 source": optionalScanTC[float64, *uint16]{
-			src:           0,
+		"on empty int Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint16(),
+			expectValue:   123,
 		},
-		"on empty *uint16 Optional given non-zero float64 source": optionalScanTC[float64, *uint16]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Uint16(123),
+		"on empty int Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int]{
+			src:         []byte("123.456"),
+			expectError: true,
 		},
-		"on empty Uint16 Optional given non-zero float64 source": optionalScanTC[float64, Uint16]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
+		"on empty int Optional given positive non-zero int []byte source that exceeds max int": optionalScanTC[[]byte, int]{
+			src:         []byte(maxInt64String + "0"),
+			expectError: true,
 		},
-		"on empty Uint16 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint16]{
-			src:         123.456,
+		"on empty int Optional given non-int []byte source": optionalScanTC[[]byte, int]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty Uint16 Optional given non-zero float64 source that exceeds max uint16": optionalScanTC[float64, Uint16]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty *int Optional given empty []byte source": optionalScanTC[[]byte, *int]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *Uint16 Optional given non-zero float64 source": optionalScanTC[float64, *Uint16]{
-			src:           123,
+		"on empty *int Optional given zero int []byte source": optionalScanTC[[]byte, *int]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint16](123),
+			expectValue:   ptrs.ZeroInt(),
 		},
-		"on empty uint32 Optional given zero float64 source": optionalScanTC[float64, uint32]{
-			src:           0,
+		"on empty *int Optional given negative int []byte source": optionalScanTC[[]byte, *int]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   ptrs.Int(-123),
 		},
-		"on empty uint32 Optional given negative non-zero float64 source": optionalScanTC[float64, uint32]{
-			src:         -123,
+		"on empty *int Optional given positive int []byte source": optionalScanTC[[]byte, *int]{
+			src:           []byte("123"),
+			expectPresent: true,
+			expectValue:   ptrs.Int(123),
+		},
+		"on empty *int Optional given non-int []byte source": optionalScanTC[[]byte, *int]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty uint32 Optional given positive non-zero float64 source": optionalScanTC[float64, uint32]{
-			src:           123,
+		"on empty Int Optional given int []byte source": optionalScanTC[[]byte, Int]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty uint32 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint32]{
-			src:         123.456,
-			expectError: true,
+		"on empty *Int Optional given int []byte source": optionalScanTC[[]byte, *Int]{
+			src:           []byte("123"),
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int](123),
 		},
-		"on empty uint32 Optional given positive non-zero float64 source that exceeds max int32": optionalScanTC[float64, uint32]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty int8 Optional given empty []byte source": optionalScanTC[[]byte, int8]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *uint32 Optional given zero float64 source": optionalScanTC[float64, *uint32]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint32(),
-		},
-		"on empty *uint32 Optional given non-zero float64 source": optionalScanTC[float64, *uint32]{
-			src:           123,
+		"on empty int8 Optional given zero int []byte source": optionalScanTC[[]byte, int8]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.Uint32(123),
+			expectValue:   0,
 		},
-		"on empty Uint32 Optional given non-zero float64 source": optionalScanTC[float64, Uint32]{
-			src:           123,
+		"on empty int8 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int8]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   -123,
 		},
-		"on empty Uint32 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint32]{
-			src:         123.456,
+		"on empty int8 Optional given negative non-zero int string []byte that contains floating points": optionalScanTC[[]byte, int8]{
+			src:         []byte("-123.456"),
 			expectError: true,
 		},
-		"on empty Uint32 Optional given non-zero float64 source that exceeds max uint32": optionalScanTC[float64, Uint32]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty int8 Optional given negative non-zero int string []byte that exceeds min int8": optionalScanTC[[]byte, int8]{
+			src:         []byte(minInt64String),
 			expectError: true,
 		},
-		"on empty *Uint32 Optional given non-zero float64 source": optionalScanTC[float64, *Uint32]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint32](123),
-		},
-		"on empty uint64 Optional given zero float64 source": optionalScanTC[float64, uint64]{
-			src:           0,
+		"on empty int8 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int8]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   123,
 		},
-		"on empty uint64 Optional given negative non-zero float64 source": optionalScanTC[float64, uint64]{
-			src:         -123,
+		"on empty int8 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int8]{
+			src:         []byte("123.456"),
 			expectError: true,
 		},
-		"on empty uint64 Optional given positive non-zero float64 source": optionalScanTC[float64, uint64]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
+		"on empty int8 Optional given positive non-zero int []byte source that exceeds max int8": optionalScanTC[[]byte, int8]{
+			src:         []byte(maxInt64String),
+			expectError: true,
 		},
-		"on empty uint64 Optional given positive non-zero float64 source that contains floating points": optionalScanTC[float64, uint64]{
-			src:         123.456,
+		"on empty int8 Optional given non-int []byte source": optionalScanTC[[]byte, int8]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty uint64 Optional given positive non-zero float64 source that exceeds max int64": optionalScanTC[float64, uint64]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty *int8 Optional given empty []byte source": optionalScanTC[[]byte, *int8]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *uint64 Optional given zero float64 source": optionalScanTC[float64, *uint64]{
-			src:           0,
+		"on empty *int8 Optional given zero int []byte source": optionalScanTC[[]byte, *int8]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint64(),
+			expectValue:   ptrs.ZeroInt8(),
 		},
-		"on empty *uint64 Optional given non-zero float64 source": optionalScanTC[float64, *uint64]{
-			src:           123,
+		"on empty *int8 Optional given negative int []byte source": optionalScanTC[[]byte, *int8]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   ptrs.Uint64(123),
+			expectValue:   ptrs.Int8(-123),
 		},
-		"on empty Uint64 Optional given non-zero float64 source": optionalScanTC[float64, Uint64]{
-			src:           123,
+		"on empty *int8 Optional given positive int []byte source": optionalScanTC[[]byte, *int8]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   123,
-		},
-		"on empty Uint64 Optional given non-zero float64 source that contains floating points": optionalScanTC[float64, Uint64]{
-			src:         123.456,
-			expectError: true,
+			expectValue:   ptrs.Int8(123),
 		},
-		"on empty Uint64 Optional given non-zero float64 source that exceeds max uint64": optionalScanTC[float64, Uint64]{
-			src:         math.Floor(math.MaxFloat64),
+		"on empty *int8 Optional given non-int []byte source": optionalScanTC[[]byte, *int8]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty *Uint64 Optional given non-zero float64 source": optionalScanTC[float64, *Uint64]{
-			src:           123,
+		"on empty Int8 Optional given int []byte source": optionalScanTC[[]byte, Int8]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint64](123),
+			expectValue:   123,
 		},
-		"on empty []byte Optional given zero float64 source": optionalScanTC[float64, []byte]{
-			src:           0,
+		"on empty *Int8 Optional given int []byte source": optionalScanTC[[]byte, *Int8]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   []byte("0"),
+			expectValue:   ptrs.Value[Int8](123),
 		},
-		"on empty []byte Optional given negative non-zero float64 source": optionalScanTC[float64, []byte]{
-			src:           -123.456,
-			expectPresent: true,
-			expectValue:   []byte("-123.456"),
+		"on empty int16 Optional given empty []byte source": optionalScanTC[[]byte, int16]{
+			src:         []byte{},
+			expectError: true,
 		},
-		"on empty []byte Optional given positive non-zero float64 source": optionalScanTC[float64, []byte]{
-			src:           123.456,
+		"on empty int16 Optional given zero int []byte source": optionalScanTC[[]byte, int16]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   []byte("123.456"),
+			expectValue:   0,
 		},
-		"on empty Bytes Optional given non-zero float64 source": optionalScanTC[float64, Bytes]{
-			src:           123.456,
+		"on empty int16 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int16]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   Bytes("123.456"),
+			expectValue:   -123,
 		},
-		"on empty sql.RawBytes Optional given non-zero float64 source": optionalScanTC[float64, sql.RawBytes]{
-			src:           123.456,
-			expectPresent: true,
-			expectValue:   sql.RawBytes("123.456"),
+		"on empty int16 Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int16]{
+			src:         []byte("-123.456"),
+			expectError: true,
 		},
-		"on empty any Optional given zero float64 source": optionalScanTC[float64, any]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   float64(0),
+		"on empty int16 Optional given negative non-zero int []byte source that exceeds min int16": optionalScanTC[[]byte, int16]{
+			src:         []byte(minInt64String),
+			expectError: true,
 		},
-		"on empty any Optional given non-zero float64 source": optionalScanTC[float64, any]{
-			src:           123.456,
+		"on empty int16 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int16]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   123,
 		},
-		"on empty Optional of unsupported slice given non-zero float64 source": optionalScanTC[float64, []uintptr]{
-			src:         123.456,
+		"on empty int16 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int16]{
+			src:         []byte("123.456"),
 			expectError: true,
 		},
-		"on empty Optional of unsupported type given non-zero float64 source": optionalScanTC[float64, uintptr]{
-			src:         123.456,
+		"on empty int16 Optional given positive non-zero int []byte source that exceeds max int16": optionalScanTC[[]byte, int16]{
+			src:         []byte(maxInt64String),
 			expectError: true,
 		},
-		"on empty sql.NullFloat64 Optional given non-zero float64 source": optionalScanTC[float64, sql.NullFloat64]{
-			src:           123.456,
-			expectPresent: true,
-			expectValue:   sql.NullFloat64{Float64: 123.456, Valid: true},
-		},
-		// Test cases for int64 source
-		// Supported destination types (incl. pointers and convertible types):
-		// int, int8, int16, int32, int64, bool, float32, float64, string, uint, uint8, uint16, uint32, uint64, []byte,
-		// sql.RawBytes, any
-		"on empty int Optional given zero int64 source": optionalScanTC[int64, int]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   0,
+		"on empty int16 Optional given non-int []byte source": optionalScanTC[[]byte, int16]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty int Optional given negative non-zero int64 source": optionalScanTC[int64, int]{
-			src:           -123,
-			expectPresent: true,
-			expectValue:   -123,
+		"on empty *int16 Optional given empty []byte source": optionalScanTC[[]byte, *int16]{
+			src:         []byte{},
+			expectError: true,
 		},
-		"on empty int Optional given positive non-zero int64 source": optionalScanTC[int64, int]{
-			src:           123,
+		"on empty *int16 Optional given zero int []byte source": optionalScanTC[[]byte, *int16]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.ZeroInt16(),
 		},
-		"on empty *int Optional given zero int64 source": optionalScanTC[int64, *int]{
-			src:           0,
+		"on empty *int16 Optional given negative int []byte source": optionalScanTC[[]byte, *int16]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt(),
+			expectValue:   ptrs.Int16(-123),
 		},
-		"on empty *int Optional given non-zero int64 source": optionalScanTC[int64, *int]{
-			src:           123,
+		"on empty *int16 Optional given positive int []byte source": optionalScanTC[[]byte, *int16]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Int(123),
+			expectValue:   ptrs.Int16(123),
 		},
-		"on empty Int Optional given non-zero int64 source": optionalScanTC[int64, Int]{
-			src:           123,
+		"on empty *int16 Optional given non-int []byte source": optionalScanTC[[]byte, *int16]{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"on empty Int16 Optional given int []byte source": optionalScanTC[[]byte, Int16]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty *Int Optional given non-zero int64 source": optionalScanTC[int64, *Int]{
-			src:           123,
+		"on empty *Int16 Optional given int []byte source": optionalScanTC[[]byte, *Int16]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int](123),
+			expectValue:   ptrs.Value[Int16](123),
 		},
-		"on empty int8 Optional given zero int64 source": optionalScanTC[int64, int8]{
-			src:           0,
+		"on empty int32 Optional given empty []byte source": optionalScanTC[[]byte, int32]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty int32 Optional given zero int []byte source": optionalScanTC[[]byte, int32]{
+			src:           []byte("0"),
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty int8 Optional given negative non-zero int64 source": optionalScanTC[int64, int8]{
-			src:           -123,
+		"on empty int32 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int32]{
+			src:           []byte("-123"),
 			expectPresent: true,
 			expectValue:   -123,
 		},
-		"on empty int8 Optional given negative non-zero int64 source that exceeds min int8": optionalScanTC[int64, int8]{
-			src:         math.MinInt64,
+		"on empty int32 Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int32]{
+			src:         []byte("-123.456"),
 			expectError: true,
 		},
-		"on empty int8 Optional given positive non-zero int64 source": optionalScanTC[int64, int8]{
-			src:           123,
+		"on empty int32 Optional given negative non-zero int []byte source that exceeds min int32": optionalScanTC[[]byte, int32]{
+			src:         []byte(minInt64String),
+			expectError: true,
+		},
+		"on empty int32 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int32]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty int8 Optional given positive non-zero int64 source that exceeds max int8": optionalScanTC[int64, int8]{
-			src:         math.MaxInt64,
+		"on empty int32 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int32]{
+			src:         []byte("123.456"),
 			expectError: true,
 		},
-		"on empty *int8 Optional given zero int64 source": optionalScanTC[int64, *int8]{
-			src:           0,
+		"on empty int32 Optional given positive non-zero int []byte source that exceeds max int32": optionalScanTC[[]byte, int32]{
+			src:         []byte(maxInt64String),
+			expectError: true,
+		},
+		"on empty int32 Optional given non-int []byte source": optionalScanTC[[]byte, int32]{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"on empty *int32 Optional given empty []byte source": optionalScanTC[[]byte, *int32]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty *int32 Optional given []byte int string source": optionalScanTC[[]byte, *int32]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt8(),
+			expectValue:   ptrs.ZeroInt32(),
 		},
-		"on empty *int8 Optional given non-zero int64 source": optionalScanTC[int64, *int8]{
-			src:           123,
+		"on empty *int32 Optional given negative int []byte source": optionalScanTC[[]byte, *int32]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   ptrs.Int8(123),
+			expectValue:   ptrs.Int32(-123),
 		},
-		"on empty Int8 Optional given non-zero int64 source": optionalScanTC[int64, Int8]{
-			src:           123,
+		"on empty *int32 Optional given positive int []byte source": optionalScanTC[[]byte, *int32]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.Int32(123),
 		},
-		"on empty Int8 Optional given non-zero int64 source that exceeds max int8": optionalScanTC[int64, Int8]{
-			src:         math.MaxInt64,
+		"on empty *int32 Optional given non-int []byte source": optionalScanTC[[]byte, *int32]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty *Int8 Optional given non-zero int64 source": optionalScanTC[int64, *Int8]{
-			src:           123,
+		"on empty Int32 Optional given int []byte source": optionalScanTC[[]byte, Int32]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int8](123),
+			expectValue:   123,
 		},
-		"on empty int16 Optional given zero int64 source": optionalScanTC[int64, int16]{
-			src:           0,
+		"on empty *Int32 Optional given int []byte source": optionalScanTC[[]byte, *Int32]{
+			src:           []byte("123"),
+			expectPresent: true,
+			expectValue:   ptrs.Value[Int32](123),
+		},
+		"on empty int64 Optional given empty []byte source": optionalScanTC[[]byte, int64]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty int64 Optional given zero int []byte source": optionalScanTC[[]byte, int64]{
+			src:           []byte("0"),
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty int16 Optional given negative non-zero int64 source": optionalScanTC[int64, int16]{
-			src:           -123,
+		"on empty int64 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int64]{
+			src:           []byte("-123"),
 			expectPresent: true,
 			expectValue:   -123,
 		},
-		"on empty int16 Optional given negative non-zero int64 source that exceeds min int16": optionalScanTC[int64, int16]{
-			src:         math.MinInt64,
+		"on empty int64 Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int64]{
+			src:         []byte("-123.456"),
 			expectError: true,
 		},
-		"on empty int16 Optional given positive non-zero int64 source": optionalScanTC[int64, int16]{
-			src:           123,
+		"on empty int64 Optional given negative non-zero int []byte source that exceeds min int64": optionalScanTC[[]byte, int64]{
+			src:         []byte(minInt64String + "0"),
+			expectError: true,
+		},
+		"on empty int64 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int64]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty int16 Optional given positive non-zero int64 source that exceeds max int16": optionalScanTC[int64, int16]{
-			src:         math.MaxInt64,
+		"on empty int64 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int64]{
+			src:         []byte("123.456"),
 			expectError: true,
 		},
-		"on empty *int16 Optional given zero int64 source": optionalScanTC[int64, *int16]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt16(),
-		},
-		"on empty *int16 Optional given non-zero int64 source": optionalScanTC[int64, *int16]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Int16(123),
+		"on empty int64 Optional given positive non-zero int []byte source that exceeds max int64": optionalScanTC[[]byte, int64]{
+			src:         []byte(maxInt64String + "0"),
+			expectError: true,
 		},
-		"on empty Int16 Optional given non-zero int64 source": optionalScanTC[int64, Int16]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
+		"on empty int64 Optional given non-int []byte source": optionalScanTC[[]byte, int64]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty Int16 Optional given non-zero int64 source that exceeds max int16": optionalScanTC[int64, Int16]{
-			src:         math.MaxInt64,
+		"on empty *int64 Optional given empty []byte source": optionalScanTC[[]byte, *int64]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *Int16 Optional given non-zero int64 source": optionalScanTC[int64, *Int16]{
-			src:           123,
+		"on empty *int64 Optional given zero int []byte source": optionalScanTC[[]byte, *int64]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int16](123),
+			expectValue:   ptrs.ZeroInt64(),
 		},
-		"on empty int32 Optional given zero int64 source": optionalScanTC[int64, int32]{
-			src:           0,
+		"on empty *int64 Optional given negative int []byte source": optionalScanTC[[]byte, *int64]{
+			src:           []byte("-123"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   ptrs.Int64(-123),
 		},
-		"on empty int32 Optional given negative non-zero int64 source": optionalScanTC[int64, int32]{
-			src:           -123,
+		"on empty *int64 Optional given positive int []byte source": optionalScanTC[[]byte, *int64]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   ptrs.Int64(123),
 		},
-		"on empty int32 Optional given negative non-zero int64 source that exceeds min int32": optionalScanTC[int64, int32]{
-			src:         math.MinInt64,
+		"on empty *int64 Optional given non-int []byte source": optionalScanTC[[]byte, *int64]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty int32 Optional given positive non-zero int64 source": optionalScanTC[int64, int32]{
-			src:           123,
+		"on empty Int64 Optional given int []byte source": optionalScanTC[[]byte, Int64]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty int32 Optional given positive non-zero int64 source that exceeds max int32": optionalScanTC[int64, int32]{
-			src:         math.MaxInt64,
-			expectError: true,
-		},
-		"on empty *int32 Optional given zero int64 source": optionalScanTC[int64, *int32]{
-			src:           0,
+		"on empty *Int64 Optional given int []byte source": optionalScanTC[[]byte, *Int64]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt32(),
+			expectValue:   ptrs.Value[Int64](123),
 		},
-		"on empty *int32 Optional given non-zero int64 source": optionalScanTC[int64, *int32]{
-			src:           123,
+		"on empty string Optional given empty []byte source": optionalScanTC[[]byte, string]{
+			src:           []byte{},
 			expectPresent: true,
-			expectValue:   ptrs.Int32(123),
+			expectValue:   "",
 		},
-		"on empty Int32 Optional given non-zero int64 source": optionalScanTC[int64, Int32]{
-			src:           123,
+		"on empty string Optional given non-empty []byte source": optionalScanTC[[]byte, string]{
+			src:           []byte("abc"),
 			expectPresent: true,
-			expectValue:   123,
-		},
-		"on empty Int32 Optional given non-zero int64 source that exceeds max int32": optionalScanTC[int64, Int32]{
-			src:         math.MaxInt64,
-			expectError: true,
+			expectValue:   "abc",
 		},
-		"on empty *Int32 Optional given non-zero int64 source": optionalScanTC[int64, *Int32]{
-			src:           123,
+		"on empty *string Optional given empty []byte source": optionalScanTC[[]byte, *string]{
+			src:           []byte{},
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int32](123),
+			expectValue:   ptrs.ZeroString(),
 		},
-		"on empty int64 Optional given zero int64 source": optionalScanTC[int64, int64]{
-			src:           0,
+		"on empty *string Optional given non-empty []byte source": optionalScanTC[[]byte, *string]{
+			src:           []byte("abc"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   ptrs.String("abc"),
 		},
-		"on empty int64 Optional given negative non-zero int64 source": optionalScanTC[int64, int64]{
-			src:           -123,
+		"on empty String Optional given non-empty []byte source": optionalScanTC[[]byte, String]{
+			src:           []byte("abc"),
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   "abc",
 		},
-		"on empty int64 Optional given positive non-zero int64 source": optionalScanTC[int64, int64]{
-			src:           123,
+		"on empty *String Optional given non-empty []byte source": optionalScanTC[[]byte, *String]{
+			src:           []byte("abc"),
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.Value[String]("abc"),
 		},
-		"on empty *int64 Optional given zero int64 source": optionalScanTC[int64, *int64]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt64(),
+		"on empty uint Optional given empty []byte source": optionalScanTC[[]byte, uint]{
+			src:         []byte{},
+			expectError: true,
 		},
-		"on empty *int64 Optional given non-zero int64 source": optionalScanTC[int64, *int64]{
-			src:           123,
+		"on empty uint Optional given zero int []byte source": optionalScanTC[[]byte, uint]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.Int64(123),
+			expectValue:   0,
 		},
-		"on empty Int64 Optional given non-zero int64 source": optionalScanTC[int64, Int64]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
+		"on empty uint Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint]{
+			src:         []byte("-123"),
+			expectError: true,
 		},
-		"on empty *Int64 Optional given non-zero int64 source": optionalScanTC[int64, *Int64]{
-			src:           123,
+		"on empty uint Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int64](123),
+			expectValue:   123,
 		},
-		"on empty bool Optional given zero int64 source": optionalScanTC[int64, bool]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   false,
+		"on empty uint Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint]{
+			src:         []byte("123.456"),
+			expectError: true,
 		},
-		"on empty bool Optional given negative non-zero int64 source": optionalScanTC[int64, bool]{
-			src:         -1,
+		"on empty uint Optional given positive non-zero int []byte source that exceeds max uint": optionalScanTC[[]byte, uint]{
+			src:         []byte(maxUint64String + "0"),
 			expectError: true,
 		},
-		"on empty bool Optional given positive one int64 source": optionalScanTC[int64, bool]{
-			src:           1,
-			expectPresent: true,
-			expectValue:   true,
+		"on empty uint Optional given non-int []byte source": optionalScanTC[[]byte, uint]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty bool Optional given positive non-zero int64 source greater than one": optionalScanTC[int64, bool]{
-			src:         2,
+		"on empty *uint Optional given empty []byte source": optionalScanTC[[]byte, *uint]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *bool Optional given zero int64 source": optionalScanTC[int64, *bool]{
-			src:           0,
+		"on empty *uint Optional given zero int []byte source": optionalScanTC[[]byte, *uint]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.False(),
+			expectValue:   ptrs.ZeroUint(),
 		},
-		"on empty *bool Optional given positive one int64 source": optionalScanTC[int64, *bool]{
-			src:           1,
+		"on empty *uint Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.True(),
+			expectValue:   ptrs.Uint(123),
 		},
-		"on empty Bool Optional given positive one int64 source": optionalScanTC[int64, Bool]{
-			src:           1,
+		"on empty *uint Optional given non-int []byte source": optionalScanTC[[]byte, *uint]{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"on empty Uint Optional given int []byte source": optionalScanTC[[]byte, Uint]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   true,
+			expectValue:   123,
 		},
-		"on empty *Bool Optional given positive one int64 source": optionalScanTC[int64, *Bool]{
-			src:           1,
+		"on empty *Uint Optional given int []byte source": optionalScanTC[[]byte, *Uint]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Bool](true),
+			expectValue:   ptrs.Value[Uint](123),
 		},
-		"on empty float32 Optional given zero int64 source": optionalScanTC[int64, float32]{
-			src:           0,
+		"on empty uint8 Optional given empty []byte source": optionalScanTC[[]byte, uint8]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty uint8 Optional given zero int []byte source": optionalScanTC[[]byte, uint8]{
+			src:           []byte("0"),
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty float32 Optional given negative non-zero int64 source": optionalScanTC[int64, float32]{
-			src:           -123,
-			expectPresent: true,
-			expectValue:   -123,
+		"on empty uint8 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint8]{
+			src:         []byte("-123"),
+			expectError: true,
 		},
-		"on empty float32 Optional given positive non-zero int64 source": optionalScanTC[int64, float32]{
-			src:           123,
+		"on empty uint8 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint8]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty *float32 Optional given zero int64 source": optionalScanTC[int64, *float32]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat32(),
+		"on empty uint8 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint8]{
+			src:         []byte("123.456"),
+			expectError: true,
 		},
-		"on empty *float32 Optional given non-zero int64 source": optionalScanTC[int64, *float32]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Float32(123),
+		"on empty uint8 Optional given positive non-zero int []byte source that exceeds max uint8": optionalScanTC[[]byte, uint8]{
+			src:         []byte(maxUint64String),
+			expectError: true,
 		},
-		"on empty Float32 Optional given non-zero int64 source": optionalScanTC[int64, Float32]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
+		"on empty uint8 Optional given non-int []byte source": optionalScanTC[[]byte, uint8]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty *Float32 Optional given non-zero int64 source": optionalScanTC[int64, *Float32]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Value[Float32](123),
+		"on empty *uint8 Optional given empty []byte source": optionalScanTC[[]byte, *uint8]{
+			src:         []byte{},
+			expectError: true,
 		},
-		"on empty float64 Optional given zero int64 source": optionalScanTC[int64, float64]{
-			src:           0,
+		"on empty *uint8 Optional given zero int []byte source": optionalScanTC[[]byte, *uint8]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   ptrs.ZeroUint8(),
 		},
-		"on empty float64 Optional given negative non-zero int64 source": optionalScanTC[int64, float64]{
-			src:           -123,
+		"on empty *uint8 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint8]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   ptrs.Uint8(123),
 		},
-		"on empty float64 Optional given positive non-zero int64 source": optionalScanTC[int64, float64]{
-			src:           123,
+		"on empty *uint8 Optional given non-int []byte source": optionalScanTC[[]byte, *uint8]{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"on empty Uint8 Optional given int []byte source": optionalScanTC[[]byte, Uint8]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty *float64 Optional given zero int64 source": optionalScanTC[int64, *float64]{
-			src:           0,
+		"on empty *Uint8 Optional given int []byte source": optionalScanTC[[]byte, *Uint8]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat64(),
+			expectValue:   ptrs.Value[Uint8](123),
 		},
-		"on empty *float64 Optional given non-zero int64 source": optionalScanTC[int64, *float64]{
-			src:           123,
+		"on empty uint16 Optional given empty []byte source": optionalScanTC[[]byte, uint16]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty uint16 Optional given zero int []byte source": optionalScanTC[[]byte, uint16]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.Float64(123),
+			expectValue:   0,
 		},
-		"on empty Float64 Optional given non-zero int64 source": optionalScanTC[int64, Float64]{
-			src:           123,
+		"on empty uint16 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint16]{
+			src:         []byte("-123"),
+			expectError: true,
+		},
+		"on empty uint16 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint16]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty *Float64 Optional given non-zero int64 source": optionalScanTC[int64, *Float64]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Value[Float64](123),
+		"on empty uint16 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint16]{
+			src:         []byte("123.456"),
+			expectError: true,
 		},
-		"on empty string Optional given zero int64 source": optionalScanTC[int64, string]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   "0",
+		"on empty uint16 Optional given positive non-zero int []byte source that exceeds max uint16": optionalScanTC[[]byte, uint16]{
+			src:         []byte(maxUint64String),
+			expectError: true,
 		},
-		"on empty string Optional given negative non-zero int64 source": optionalScanTC[int64, string]{
-			src:           -123,
-			expectPresent: true,
-			expectValue:   "-123",
+		"on empty uint16 Optional given non-int []byte source": optionalScanTC[[]byte, uint16]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty string Optional given positive non-zero int64 source": optionalScanTC[int64, string]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   "123",
+		"on empty *uint16 Optional given zero []byte source": optionalScanTC[[]byte, *uint16]{
+			src:         []byte{},
+			expectError: true,
 		},
-		"on empty *string Optional given zero int64 source": optionalScanTC[int64, *string]{
-			src:           0,
+		"on empty *uint16 Optional given zero int []byte source": optionalScanTC[[]byte, *uint16]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.String("0"),
+			expectValue:   ptrs.ZeroUint16(),
 		},
-		"on empty *string Optional given non-zero int64 source": optionalScanTC[int64, *string]{
-			src:           123,
+		"on empty *uint16 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint16]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.String("123"),
+			expectValue:   ptrs.Uint16(123),
 		},
-		"on empty String Optional given non-zero int64 source": optionalScanTC[int64, String]{
-			src:           123,
+		"on empty *uint16 Optional given non-int []byte source": optionalScanTC[[]byte, *uint16]{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"on empty Uint16 Optional given int []byte source": optionalScanTC[[]byte, Uint16]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   "123",
+			expectValue:   123,
 		},
-		"on empty *String Optional given non-zero int64 source": optionalScanTC[int64, *String]{
-			src:           123,
+		"on empty *Uint16 Optional given int []byte source": optionalScanTC[[]byte, *Uint16]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[String]("123"),
+			expectValue:   ptrs.Value[Uint16](123),
 		},
-		"on empty uint Optional given zero int64 source": optionalScanTC[int64, uint]{
-			src:           0,
+		"on empty uint32 Optional given empty []byte source": optionalScanTC[[]byte, uint32]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty uint32 Optional given zero int []byte source": optionalScanTC[[]byte, uint32]{
+			src:           []byte("0"),
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty uint Optional given negative non-zero int64 source": optionalScanTC[int64, uint]{
-			src:         -123,
+		"on empty uint32 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint32]{
+			src:         []byte("-123"),
 			expectError: true,
 		},
-		"on empty uint Optional given positive non-zero int64 source": optionalScanTC[int64, uint]{
-			src:           123,
+		"on empty uint32 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint32]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty *uint Optional given zero int64 source": optionalScanTC[int64, *uint]{
-			src:           0,
+		"on empty uint32 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint32]{
+			src:         []byte("123.456"),
+			expectError: true,
+		},
+		"on empty uint32 Optional given positive non-zero int []byte source that exceeds max uint32": optionalScanTC[[]byte, uint32]{
+			src:         []byte(maxUint64String),
+			expectError: true,
+		},
+		"on empty uint32 Optional given non-int []byte source": optionalScanTC[[]byte, uint32]{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"on empty *uint32 Optional given empty []byte source": optionalScanTC[[]byte, *uint32]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty *uint32 Optional given zero int []byte source": optionalScanTC[[]byte, *uint32]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint(),
+			expectValue:   ptrs.ZeroUint32(),
 		},
-		"on empty *uint Optional given non-zero int64 source": optionalScanTC[int64, *uint]{
-			src:           123,
+		"on empty *uint32 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint32]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Uint(123),
+			expectValue:   ptrs.Uint32(123),
 		},
-		"on empty Uint Optional given non-zero int64 source": optionalScanTC[int64, Uint]{
-			src:           123,
+		"on empty *uint32 Optional given non-int []byte source": optionalScanTC[[]byte, *uint32]{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"on empty Uint32 Optional given int []byte source": optionalScanTC[[]byte, Uint32]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty *Uint Optional given non-zero int64 source": optionalScanTC[int64, *Uint]{
-			src:           123,
+		"on empty *Uint32 Optional given int []byte source": optionalScanTC[[]byte, *Uint32]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint](123),
+			expectValue:   ptrs.Value[Uint32](123),
 		},
-		"on empty uint8 Optional given zero int64 source": optionalScanTC[int64, uint8]{
-			src:           0,
+		"on empty uint64 Optional given empty []byte source": optionalScanTC[[]byte, uint64]{
+			src:         []byte{},
+			expectError: true,
+		},
+		"on empty uint64 Optional given zero int []byte source": optionalScanTC[[]byte, uint64]{
+			src:           []byte("0"),
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty uint8 Optional given negative non-zero int64 source": optionalScanTC[int64, uint8]{
-			src:         -123,
+		"on empty uint64 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint64]{
+			src:         []byte("-123"),
 			expectError: true,
 		},
-		"on empty uint8 Optional given positive non-zero int64 source": optionalScanTC[int64, uint8]{
-			src:           123,
+		"on empty uint64 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint64]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty uint8 Optional given positive non-zero int64 source that exceeds max uint8": optionalScanTC[int64, uint8]{
-			src:         math.MaxInt64,
+		"on empty uint64 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint64]{
+			src:         []byte("123.456"),
 			expectError: true,
 		},
-		"on empty *uint8 Optional given zero int64 source": optionalScanTC[int64, *uint8]{
-			src:           0,
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint8(),
-		},
-		"on empty *uint8 Optional given non-zero int64 source": optionalScanTC[int64, *uint8]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   ptrs.Uint8(123),
+		"on empty uint64 Optional given positive non-zero int []byte source that exceeds max uint": optionalScanTC[[]byte, uint64]{
+			src:         []byte(maxUint64String + "0"),
+			expectError: true,
 		},
-		"on empty Uint8 Optional given non-zero int64 source": optionalScanTC[int64, Uint8]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
+		"on empty uint64 Optional given non-int []byte source": optionalScanTC[[]byte, uint64]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty Uint8 Optional given non-zero int64 source that exceeds max uint8": optionalScanTC[int64, Uint8]{
-			src:         math.MaxInt64,
+		"on empty *uint64 Optional given empty []byte source": optionalScanTC[[]byte, *uint64]{
+			src:         []byte{},
 			expectError: true,
 		},
-		"on empty *Uint8 Optional given non-zero int64 source": optionalScanTC[int64, *Uint8]{
-			src:           123,
+		"on empty *uint64 Optional given zero int []byte source": optionalScanTC[[]byte, *uint64]{
+			src:           []byte("0"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint8](123),
+			expectValue:   ptrs.ZeroUint64(),
 		},
-		"on empty uint16 Optional given zero int64 source": optionalScanTC[int64, uint16]{
-			src:           0,
+		"on empty *uint64 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint64]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   ptrs.Uint64(123),
 		},
-		"on empty uint16 Optional given negative non-zero int64 source": optionalScanTC[int64, uint16]{
-			src:         -123,
+		"on empty *uint64 Optional given non-int []byte source": optionalScanTC[[]byte, *uint64]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty uint16 Optional given positive non-zero int64 source": optionalScanTC[int64, uint16]{
-			src:           123,
+		"on empty Uint64 Optional given int []byte source": optionalScanTC[[]byte, Uint64]{
+			src:           []byte("123"),
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty uint16 Optional given positive non-zero int64 source that exceeds max uint16": optionalScanTC[int64, uint16]{
-			src:         math.MaxInt64,
-			expectError: true,
-		},
-		"on empty *uint16 Optional given zero int64 source": optionalScanTC[int64, *uint16]{
-			src:           0,
+		"on empty *Uint64 Optional given int []byte source": optionalScanTC[[]byte, *Uint64]{
+			src:           []byte("123"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint16(),
+			expectValue:   ptrs.Value[Uint64](123),
 		},
-		"on empty *uint16 Optional given non-zero int64 source": optionalScanTC[int64, *uint16]{
-			src:           123,
+		"on empty sql.RawBytes Optional given empty []byte source": optionalScanTC[[]byte, sql.RawBytes]{
+			src:           []byte{},
 			expectPresent: true,
-			expectValue:   ptrs.Uint16(123),
+			expectValue:   sql.RawBytes{},
 		},
-		"on empty Uint16 Optional given non-zero int64 source": optionalScanTC[int64, Uint16]{
-			src:           123,
+		"on empty sql.RawBytes Optional given non-empty []byte source": optionalScanTC[[]byte, sql.RawBytes]{
+			src:           []byte("abc"),
 			expectPresent: true,
-			expectValue:   123,
-		},
-		"on empty Uint16 Optional given non-zero int64 source that exceeds max uint16": optionalScanTC[int64, Uint16]{
-			src:         math.MaxInt64,
-			expectError: true,
+			expectValue:   sql.RawBytes("abc"),
 		},
-		"on empty *Uint16 Optional given non-zero int64 source": optionalScanTC[int64, *Uint16]{
-			src:           123,
+		"on empty any Optional given empty []byte source": optionalScanTC[[]byte, any]{
+			src:           []byte{},
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint16](123),
+			expectValue:   []byte{},
 		},
-		"on empty uint32 Optional given zero int64 source": optionalScanTC[int64, uint32]{
-			src:           0,
+		"on empty any Optional given non-empty []byte source": optionalScanTC[[]byte, any]{
+			src:           []byte("abc"),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   []byte("abc"),
 		},
-		"on empty uint32 Optional given negative non-zero int64 source": optionalScanTC[int64, uint32]{
-			src:         -123,
+		"on empty Optional of unsupported slice given non-empty []byte source": optionalScanTC[[]byte, []uintptr]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty uint32 Optional given positive non-zero int64 source": optionalScanTC[int64, uint32]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   123,
+		"on empty Optional of unsupported type given non-empty []byte source": optionalScanTC[[]byte, uintptr]{
+			src:         []byte("abc"),
+			expectError: true,
 		},
-		"on empty *uint32 Optional given zero int64 source": optionalScanTC[int64, *uint32]{
-			src:           0,
+		// Test cases for time.Time source
+		// Supported destination types (incl. pointers and convertible types):
+		// time.Time, string, []byte, sql.RawBytes, any
+		"on empty time.Time Optional given zero time.Time source": optionalScanTC[time.Time, time.Time]{
+			src:           time.Time{},
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint32(),
+			expectValue:   time.Time{},
 		},
-		"on empty *uint32 Optional given non-zero int64 source": optionalScanTC[int64, *uint32]{
-			src:           123,
+		"on empty time.Time Optional given non-zero time.Time source": optionalScanTC[time.Time, time.Time]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   ptrs.Uint32(123),
+			expectValue:   timeNow,
 		},
-		"on empty Uint32 Optional given non-zero int64 source": optionalScanTC[int64, Uint32]{
-			src:           123,
+		"on empty *time.Time Optional given zero time.Time source": optionalScanTC[time.Time, *time.Time]{
+			src:           time.Time{},
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   &time.Time{},
 		},
-		"on empty *Uint32 Optional given non-zero int64 source": optionalScanTC[int64, *Uint32]{
-			src:           123,
+		"on empty *time.Time Optional given non-zero time.Time source": optionalScanTC[time.Time, *time.Time]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint32](123),
+			expectValue:   ptrs.Value(timeNow),
 		},
-		"on empty uint64 Optional given zero int64 source": optionalScanTC[int64, uint64]{
-			src:           0,
+		"on empty Time Optional given non-zero time.Time source": optionalScanTC[time.Time, Time]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   Time(timeNow),
 		},
-		"on empty uint64 Optional given negative non-zero int64 source": optionalScanTC[int64, uint64]{
-			src:         -123,
-			expectError: true,
+		"on empty *Time Optional given non-zero time.Time source": optionalScanTC[time.Time, *Time]{
+			src:           timeNow,
+			expectPresent: true,
+			expectValue:   ptrs.Value(Time(timeNow)),
 		},
-		"on empty uint64 Optional given positive non-zero int64 source": optionalScanTC[int64, uint64]{
-			src:           123,
+		"on empty string Optional given zero time.Time source": optionalScanTC[time.Time, string]{
+			src:           time.Time{},
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   timeZeroString,
 		},
-		"on empty *uint64 Optional given zero int64 source": optionalScanTC[int64, *uint64]{
-			src:           0,
+		"on empty string Optional given non-zero time.Time source": optionalScanTC[time.Time, string]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint64(),
+			expectValue:   timeNowString,
 		},
-		"on empty *uint64 Optional given non-zero int64 source": optionalScanTC[int64, *uint64]{
-			src:           123,
+		"on empty *string Optional given zero time.Time source": optionalScanTC[time.Time, *string]{
+			src:           time.Time{},
 			expectPresent: true,
-			expectValue:   ptrs.Uint64(123),
+			expectValue:   ptrs.String(timeZeroString),
 		},
-		"on empty Uint64 Optional given non-zero int64 source": optionalScanTC[int64, Uint64]{
-			src:           123,
+		"on empty *string Optional given non-zero time.Time source": optionalScanTC[time.Time, *string]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.String(timeNowString),
 		},
-		"on empty *Uint64 Optional given non-zero int64 source": optionalScanTC[int64, *Uint64]{
-			src:           123,
+		"on empty String Optional given non-zero time.Time source": optionalScanTC[time.Time, String]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint64](123),
+			expectValue:   String(timeNowString),
 		},
-		"on empty []byte Optional given zero int64 source": optionalScanTC[int64, []byte]{
-			src:           0,
+		"on empty *String Optional given non-zero time.Time source": optionalScanTC[time.Time, *String]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   []byte("0"),
+			expectValue:   ptrs.Value(String(timeNowString)),
 		},
-		"on empty []byte Optional given negative non-zero int64 source": optionalScanTC[int64, []byte]{
-			src:           -123,
+		"on empty []byte Optional given zero time.Time source": optionalScanTC[time.Time, []byte]{
+			src:           time.Time{},
 			expectPresent: true,
-			expectValue:   []byte("-123"),
+			expectValue:   []byte(timeZeroString),
 		},
-		"on empty []byte Optional given positive non-zero int64 source": optionalScanTC[int64, []byte]{
-			src:           123,
+		"on empty []byte Optional given non-zero time.Time source": optionalScanTC[time.Time, []byte]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   []byte("123"),
+			expectValue:   []byte(timeNowString),
 		},
-		"on empty Bytes Optional given non-zero int64 source": optionalScanTC[int64, Bytes]{
-			src:           123,
+		"on empty Bytes Optional given non-zero time.Time source": optionalScanTC[time.Time, Bytes]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   Bytes("123"),
+			expectValue:   Bytes(timeNowString),
 		},
-		"on empty sql.RawBytes Optional given non-zero int64 source": optionalScanTC[int64, sql.RawBytes]{
-			src:           123,
+		"on empty sql.RawBytes Optional given non-zero time.Time source": optionalScanTC[time.Time, sql.RawBytes]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   sql.RawBytes("123"),
+			expectValue:   sql.RawBytes(timeNowString),
 		},
-		"on empty any Optional given zero int64 source": optionalScanTC[int64, any]{
-			src:           0,
+		"on empty any Optional given zero time.Time source": optionalScanTC[time.Time, any]{
+			src:           time.Time{},
 			expectPresent: true,
-			expectValue:   int64(0),
+			expectValue:   time.Time{},
 		},
-		"on empty any Optional given non-zero int64 source": optionalScanTC[int64, any]{
-			src:           123,
+		"on empty any Optional given non-zero time.Time source": optionalScanTC[time.Time, any]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   int64(123),
+			expectValue:   timeNow,
 		},
-		"on empty Optional of unsupported slice given non-zero int64 source": optionalScanTC[int64, []uintptr]{
-			src:         123,
+		"on empty Optional of unsupported slice given non-zero time.Time source": optionalScanTC[time.Time, []uintptr]{
+			src:         timeNow,
 			expectError: true,
 		},
-		"on empty Optional of unsupported type given non-zero int64 source": optionalScanTC[int64, uintptr]{
-			src:         123,
+		"on empty Optional of unsupported type given non-zero time.Time source": optionalScanTC[time.Time, uintptr]{
+			src:         timeNow,
 			expectError: true,
 		},
-		"on empty sql.NullByte Optional given non-zero int source": optionalScanTC[int64, sql.NullByte]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   sql.NullByte{Byte: 123, Valid: true},
-		},
-		"on empty sql.NullInt16 Optional given non-zero int64 source": optionalScanTC[int64, sql.NullInt16]{
-			src:           123,
-			expectPresent: true,
-			expectValue:   sql.NullInt16{Int16: 123, Valid: true},
-		},
-		"on empty sql.NullInt32 Optional given non-zero int64 source": optionalScanTC[int64, sql.NullInt32]{
-			src:           123,
+		"on empty sql.NullTime Optional given non-zero time.Time source": optionalScanTC[time.Time, sql.NullTime]{
+			src:           timeNow,
 			expectPresent: true,
-			expectValue:   sql.NullInt32{Int32: 123, Valid: true},
+			expectValue:   sql.NullTime{Time: timeNow, Valid: true},
 		},
-		"on empty sql.NullInt64 Optional given non-zero int64 source": optionalScanTC[int64, sql.NullInt64]{
-			src:           123,
+		// Test cases for json.Number source
+		"on empty int Optional given zero json.Number source": optionalScanTC[json.Number, int]{
+			src:           json.Number("0"),
 			expectPresent: true,
-			expectValue:   sql.NullInt64{Int64: 123, Valid: true},
+			expectValue:   0,
 		},
-		// Test cases for string source
-		// Supported destination types (incl. pointers and convertible types):
-		// string, bool, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, []byte,
-		// sql.RawBytes, any
-		"on empty string Optional given zero string source": optionalScanTC[string, string]{
-			src:           "",
+		"on empty int Optional given non-zero json.Number source": optionalScanTC[json.Number, int]{
+			src:           json.Number("123"),
 			expectPresent: true,
-			expectValue:   "",
+			expectValue:   123,
 		},
-		"on empty string Optional given non-zero string source": optionalScanTC[string, string]{
-			src:           "abc",
+		"on empty int Optional given json.Number source that contains floating points": optionalScanTC[json.Number, int]{
+			src:         json.Number("1.5"),
+			expectError: true,
+		},
+		"on empty *int Optional given non-zero json.Number source": optionalScanTC[json.Number, *int]{
+			src:           json.Number("123"),
 			expectPresent: true,
-			expectValue:   "abc",
+			expectValue:   ptrs.Int(123),
 		},
-		"on empty *string Optional given zero string source": optionalScanTC[string, *string]{
-			src:           "",
+		"on empty float64 Optional given non-zero json.Number source": optionalScanTC[json.Number, float64]{
+			src:           json.Number("1.5"),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroString(),
+			expectValue:   1.5,
 		},
-		"on empty *string Optional given non-zero string source": optionalScanTC[string, *string]{
-			src:           "abc",
+		"on empty uint64 Optional given json.Number source that exceeds max int64": optionalScanTC[json.Number, uint64]{
+			src:           json.Number(maxUint64String),
 			expectPresent: true,
-			expectValue:   ptrs.String("abc"),
+			expectValue:   math.MaxUint64,
 		},
-		"on empty String Optional given non-zero string source": optionalScanTC[string, String]{
-			src:           "abc",
+		"on empty string Optional given non-zero json.Number source": optionalScanTC[json.Number, string]{
+			src:           json.Number("123"),
 			expectPresent: true,
-			expectValue:   "abc",
+			expectValue:   "123",
 		},
-		"on empty *String Optional given non-zero string source": optionalScanTC[string, *String]{
-			src:           "abc",
+		"on empty string Optional given json.Number source with high precision": optionalScanTC[json.Number, string]{
+			src:           json.Number("0.1000000000000000000000000001"),
 			expectPresent: true,
-			expectValue:   ptrs.Value[String]("abc"),
+			expectValue:   "0.1000000000000000000000000001",
 		},
-		"on empty bool Optional given zero string source": optionalScanTC[string, bool]{
-			src:         "",
-			expectError: true,
+		"on empty []byte Optional given non-zero json.Number source": optionalScanTC[json.Number, []byte]{
+			src:           json.Number("123"),
+			expectPresent: true,
+			expectValue:   []byte("123"),
 		},
-		"on empty bool Optional given false string source": optionalScanTC[string, bool]{
-			src:           "false",
+		// Test cases for time.Duration destination
+		"on empty time.Duration Optional given duration string source": optionalScanTC[string, time.Duration]{
+			src:           "1h30m",
 			expectPresent: true,
-			expectValue:   false,
+			expectValue:   90 * time.Minute,
 		},
-		"on empty bool Optional given true string source": optionalScanTC[string, bool]{
-			src:           "true",
+		"on empty time.Duration Optional given nanoseconds string source": optionalScanTC[string, time.Duration]{
+			src:           "5400000000000",
 			expectPresent: true,
-			expectValue:   true,
+			expectValue:   90 * time.Minute,
 		},
-		"on empty bool Optional given non-boolean string source": optionalScanTC[string, bool]{
+		"on empty time.Duration Optional given erroneous string source": optionalScanTC[string, time.Duration]{
 			src:         "abc",
 			expectError: true,
 		},
-		"on empty *bool Optional given zero string source": optionalScanTC[string, *bool]{
-			src:         "",
-			expectError: true,
+		"on empty *time.Duration Optional given duration string source": optionalScanTC[string, *time.Duration]{
+			src:           "1h30m",
+			expectPresent: true,
+			expectValue:   ptrs.Value(90 * time.Minute),
 		},
-		"on empty *bool Optional given boolean string source": optionalScanTC[string, *bool]{
-			src:           "true",
+		"on empty time.Duration Optional given duration []byte source": optionalScanTC[[]byte, time.Duration]{
+			src:           []byte("1h30m"),
 			expectPresent: true,
-			expectValue:   ptrs.True(),
+			expectValue:   90 * time.Minute,
 		},
-		"on empty *bool Optional given non-boolean string source": optionalScanTC[string, *bool]{
-			src:         "abc",
+		"on empty time.Duration Optional given erroneous []byte source": optionalScanTC[[]byte, time.Duration]{
+			src:         []byte("abc"),
 			expectError: true,
 		},
-		"on empty Bool Optional given boolean string source": optionalScanTC[string, Bool]{
-			src:           "true",
+		"on empty time.Duration Optional given int64 source": optionalScanTC[int64, time.Duration]{
+			src:           int64(5400000000000),
 			expectPresent: true,
-			expectValue:   true,
+			expectValue:   90 * time.Minute,
 		},
-		"on empty *Bool Optional given boolean string source": optionalScanTC[string, *Bool]{
-			src:           "false",
+		"on empty *time.Duration Optional given int64 source": optionalScanTC[int64, *time.Duration]{
+			src:           int64(5400000000000),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Bool](false),
+			expectValue:   ptrs.Value(90 * time.Minute),
 		},
-		"on empty float32 Optional given zero string source": optionalScanTC[string, float32]{
-			src:         "",
-			expectError: true,
+		// Test cases for uint64 source
+		"on empty uint64 Optional given uint64 source": optionalScanTC[uint64, uint64]{
+			src:           uint64(math.MaxUint64),
+			expectPresent: true,
+			expectValue:   math.MaxUint64,
 		},
-		"on empty float32 Optional given zero float string source": optionalScanTC[string, float32]{
-			src:           "0",
+		"on empty *uint64 Optional given uint64 source": optionalScanTC[uint64, *uint64]{
+			src:           uint64(123),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   ptrs.Value(uint64(123)),
 		},
-		"on empty float32 Optional given negative non-zero float string source": optionalScanTC[string, float32]{
-			src:           "-123.456",
+		"on empty Uint64 Optional given uint64 source": optionalScanTC[uint64, Uint64]{
+			src:           uint64(math.MaxUint64),
 			expectPresent: true,
-			expectValue:   -123.456,
+			expectValue:   math.MaxUint64,
 		},
-		"on empty float32 Optional given negative non-zero float string source that exceeds min float32": optionalScanTC[string, float32]{
-			src:         minFloat64String,
+		"on empty uint8 Optional given uint64 source": optionalScanTC[uint64, uint8]{
+			src:           uint64(255),
+			expectPresent: true,
+			expectValue:   255,
+		},
+		"on empty uint8 Optional given overflowing uint64 source": optionalScanTC[uint64, uint8]{
+			src:         uint64(256),
 			expectError: true,
 		},
-		"on empty float32 Optional given positive non-zero float string source": optionalScanTC[string, float32]{
-			src:           "123.456",
+		"on empty int64 Optional given uint64 source": optionalScanTC[uint64, int64]{
+			src:           uint64(math.MaxInt64),
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   math.MaxInt64,
 		},
-		"on empty float32 Optional given positive non-zero float string source that exceeds max float32": optionalScanTC[string, float32]{
-			src:         maxFloat64String,
+		"on empty int64 Optional given overflowing uint64 source": optionalScanTC[uint64, int64]{
+			src:         uint64(math.MaxUint64),
 			expectError: true,
 		},
-		"on empty float32 Optional given non-float string source": optionalScanTC[string, float32]{
-			src:         "abc",
-			expectError: true,
+		"on empty bool Optional given uint64 source": optionalScanTC[uint64, bool]{
+			src:           uint64(1),
+			expectPresent: true,
+			expectValue:   true,
 		},
-		"on empty *float32 Optional given zero string source": optionalScanTC[string, *float32]{
-			src:         "",
+		"on empty bool Optional given erroneous uint64 source": optionalScanTC[uint64, bool]{
+			src:         uint64(2),
 			expectError: true,
 		},
-		"on empty *float32 Optional given zero float string source": optionalScanTC[string, *float32]{
-			src:           "0",
+		"on empty float64 Optional given uint64 source": optionalScanTC[uint64, float64]{
+			src:           uint64(123),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat32(),
+			expectValue:   123,
 		},
-		"on empty *float32 Optional given negative float string source": optionalScanTC[string, *float32]{
-			src:           "-123.456",
+		"on empty string Optional given uint64 source": optionalScanTC[uint64, string]{
+			src:           uint64(math.MaxUint64),
 			expectPresent: true,
-			expectValue:   ptrs.Float32(-123.456),
+			expectValue:   maxUint64String,
 		},
-		"on empty *float32 Optional given positive float string source": optionalScanTC[string, *float32]{
-			src:           "123.456",
+		"on empty []byte Optional given uint64 source": optionalScanTC[uint64, []byte]{
+			src:           uint64(math.MaxUint64),
 			expectPresent: true,
-			expectValue:   ptrs.Float32(123.456),
+			expectValue:   []byte(maxUint64String),
 		},
-		"on empty *float32 Optional given non-float string source": optionalScanTC[string, *float32]{
-			src:         "abc",
+		"on empty any Optional given uint64 source": optionalScanTC[uint64, any]{
+			src:           uint64(123),
+			expectPresent: true,
+			expectValue:   uint64(123),
+		},
+		"on empty time.Time Optional given uint64 source": optionalScanTC[uint64, time.Time]{
+			src:         uint64(123),
 			expectError: true,
 		},
-		"on empty Float32 Optional given float string source": optionalScanTC[string, Float32]{
-			src:           "123.456",
+		// Test cases for Uint64 accessor source
+		"on empty uint64 Optional given Uint64 accessor source": optionalScanTC[unsignedScanSrc, uint64]{
+			src:           unsignedScanSrc{value: math.MaxUint64},
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   math.MaxUint64,
 		},
-		"on empty *Float32 Optional given float string source": optionalScanTC[string, *Float32]{
-			src:           "123.456",
+		"on empty *uint64 Optional given Uint64 accessor source": optionalScanTC[unsignedScanSrc, *uint64]{
+			src:           unsignedScanSrc{value: 123},
 			expectPresent: true,
-			expectValue:   ptrs.Value[Float32](123.456),
+			expectValue:   ptrs.Value(uint64(123)),
 		},
-		"on empty float64 Optional given zero string source": optionalScanTC[string, float64]{
-			src:         "",
+		"on empty string Optional given Uint64 accessor source": optionalScanTC[unsignedScanSrc, string]{
+			src:           unsignedScanSrc{value: math.MaxUint64},
+			expectPresent: true,
+			expectValue:   maxUint64String,
+		},
+		"on empty int64 Optional given overflowing Uint64 accessor source": optionalScanTC[unsignedScanSrc, int64]{
+			src:         unsignedScanSrc{value: math.MaxUint64},
 			expectError: true,
 		},
-		"on empty float64 Optional given zero float string source": optionalScanTC[string, float64]{
-			src:           "0",
+		"on empty uint64 Optional given *big.Int source": optionalScanTC[*big.Int, uint64]{
+			src:           new(big.Int).SetUint64(math.MaxUint64),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   math.MaxUint64,
 		},
-		"on empty float64 Optional given negative non-zero float string source": optionalScanTC[string, float64]{
-			src:           "-123.456",
+		"on empty int64 Optional given *big.Int source": optionalScanTC[*big.Int, int64]{
+			src:           big.NewInt(5),
 			expectPresent: true,
-			expectValue:   -123.456,
+			expectValue:   5,
 		},
-		"on empty float64 Optional given negative non-zero float string source that exceeds min float64": optionalScanTC[string, float64]{
-			src:         minFloat64String + "0",
-			expectError: true,
+		"on empty int64 Optional given negative *big.Int source": optionalScanTC[*big.Int, int64]{
+			src:           big.NewInt(-5),
+			expectPresent: true,
+			expectValue:   -5,
 		},
-		"on empty float64 Optional given positive non-zero float string source": optionalScanTC[string, float64]{
-			src:           "123.456",
+		"on empty string Optional given negative *big.Int source": optionalScanTC[*big.Int, string]{
+			src:           big.NewInt(-5),
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   "-5",
 		},
-		"on empty float64 Optional given positive non-zero float string source that exceeds max float64": optionalScanTC[string, float64]{
-			src:         maxFloat64String + "0",
+		"on empty uint64 Optional given negative *big.Int source": optionalScanTC[*big.Int, uint64]{
+			src:         big.NewInt(-5),
 			expectError: true,
 		},
-		"on empty float64 Optional given non-float string source": optionalScanTC[string, float64]{
-			src:         "abc",
-			expectError: true,
+		"on empty string Optional given oversized *big.Int source": optionalScanTC[*big.Int, string]{
+			src:           new(big.Int).Lsh(big.NewInt(1), 100),
+			expectPresent: true,
+			expectValue:   "1267650600228229401496703205376",
 		},
-		"on empty *float64 Optional given zero string source": optionalScanTC[string, *float64]{
-			src:         "",
+		"on empty uint64 Optional given oversized *big.Int source": optionalScanTC[*big.Int, uint64]{
+			src:         new(big.Int).Lsh(big.NewInt(1), 64),
 			expectError: true,
 		},
-		"on empty *float64 Optional given zero float string source": optionalScanTC[string, *float64]{
-			src:           "0",
+		// Test cases for driver.Valuer source
+		"on empty string Optional given driver.Valuer source": optionalScanTC[valuerScanSrc, string]{
+			src:           valuerScanSrc{value: "abc"},
 			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat64(),
+			expectValue:   "abc",
 		},
-		"on empty *float64 Optional given negative float string source": optionalScanTC[string, *float64]{
-			src:           "-123.456",
+		"on empty int Optional given driver.Valuer source": optionalScanTC[valuerScanSrc, int]{
+			src:           valuerScanSrc{value: int64(123)},
 			expectPresent: true,
-			expectValue:   ptrs.Float64(-123.456),
+			expectValue:   123,
 		},
-		"on empty *float64 Optional given positive float string source": optionalScanTC[string, *float64]{
-			src:           "123.456",
-			expectPresent: true,
-			expectValue:   ptrs.Float64(123.456),
+		"on non-empty string Optional given driver.Valuer source with nil value": optionalScanTC[valuerScanSrc, string]{
+			opt:           Of("abc"),
+			src:           valuerScanSrc{value: nil},
+			expectPresent: false,
 		},
-		"on empty *float64 Optional given non-float string source": optionalScanTC[string, *float64]{
-			src:         "abc",
+		"on empty string Optional given erroneous driver.Valuer source": optionalScanTC[valuerScanSrc, string]{
+			src:         valuerScanSrc{err: errors.New("failed")},
 			expectError: true,
 		},
-		"on empty Float64 Optional given float string source": optionalScanTC[string, Float64]{
-			src:           "123.456",
+		"on empty string Optional given driver.Valuer source returning driver.Valuer": optionalScanTC[valuerScanSrc, string]{
+			src:         valuerScanSrc{value: valuerScanSrc{value: "abc"}},
+			expectError: true,
+		},
+		"on empty string Optional given empty Optional source": optionalScanTC[Optional[int], string]{
+			src:           Empty[int](),
+			expectPresent: false,
+		},
+		"on non-empty string Optional given empty Optional source": optionalScanTC[Optional[string], string]{
+			opt:           Of("abc"),
+			src:           Empty[string](),
+			expectPresent: false,
+		},
+		"on empty string Optional given non-empty Optional source": optionalScanTC[Optional[string], string]{
+			src:           Of("abc"),
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   "abc",
 		},
-		"on empty *Float64 Optional given float string source": optionalScanTC[string, *Float64]{
-			src:           "123.456",
+		"on empty int Optional given non-empty Optional source": optionalScanTC[Optional[int], int]{
+			src:           Of(123),
 			expectPresent: true,
-			expectValue:   ptrs.Value[Float64](123.456),
+			expectValue:   123,
 		},
-		"on empty int Optional given zero string source": optionalScanTC[string, int]{
-			src:         "",
-			expectError: true,
+		"on empty string Optional given invalid sql.NullString source": optionalScanTC[sql.NullString, string]{
+			src:           sql.NullString{},
+			expectPresent: false,
 		},
-		"on empty int Optional given zero int string source": optionalScanTC[string, int]{
-			src:           "0",
+		"on empty string Optional given valid sql.NullString source": optionalScanTC[sql.NullString, string]{
+			src:           sql.NullString{String: "abc", Valid: true},
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   "abc",
 		},
-		"on empty int Optional given negative non-zero int string source": optionalScanTC[string, int]{
-			src:           "-123",
+		"on empty sql.NullString Optional given non-empty Optional source": optionalScanTC[Optional[string], sql.NullString]{
+			src:           Of("abc"),
 			expectPresent: true,
-			expectValue:   -123,
-		},
-		"on empty int Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int]{
-			src:         "-123.456",
-			expectError: true,
+			expectValue:   sql.NullString{String: "abc", Valid: true},
 		},
-		"on empty int Optional given negative non-zero int string source that exceeds min int": optionalScanTC[string, int]{
-			src:         minInt64String + "0",
-			expectError: true,
+		// Test cases for fmt.Stringer source
+		"on empty string Optional given fmt.Stringer source": optionalScanTC[time.Weekday, string]{
+			src:           time.Monday,
+			expectPresent: true,
+			expectValue:   "Monday",
 		},
-		"on empty int Optional given positive non-zero int string source": optionalScanTC[string, int]{
-			src:           "123",
+		"on empty *string Optional given fmt.Stringer source": optionalScanTC[time.Weekday, *string]{
+			src:           time.Monday,
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   ptrs.Value("Monday"),
 		},
-		"on empty int Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int]{
-			src:         "123.456",
-			expectError: true,
+		"on empty String Optional given fmt.Stringer source": optionalScanTC[time.Month, String]{
+			src:           time.January,
+			expectPresent: true,
+			expectValue:   "January",
 		},
-		"on empty int Optional given positive non-zero int string source that exceeds max int": optionalScanTC[string, int]{
-			src:         maxInt64String + "0",
-			expectError: true,
+		"on empty []byte Optional given fmt.Stringer source": optionalScanTC[time.Weekday, []byte]{
+			src:           time.Monday,
+			expectPresent: true,
+			expectValue:   []byte("Monday"),
 		},
-		"on empty int Optional given non-int string source": optionalScanTC[string, int]{
-			src:         "abc",
-			expectError: true,
+		"on empty time.Duration Optional given fmt.Stringer source": optionalScanTC[time.Duration, time.Duration]{
+			src:           90 * time.Minute,
+			expectPresent: true,
+			expectValue:   90 * time.Minute,
 		},
-		"on empty *int Optional given zero string source": optionalScanTC[string, *int]{
-			src:         "",
+		"on empty int Optional given erroneous fmt.Stringer source": optionalScanTC[time.Weekday, int]{
+			src:         time.Monday,
 			expectError: true,
 		},
-		"on empty *int Optional given zero int string source": optionalScanTC[string, *int]{
-			src:           "0",
+		// Test cases for JSON array source
+		"on empty []string Optional given JSON array string source": optionalScanTC[string, []string]{
+			src:           `["a","b"]`,
 			expectPresent: true,
-			expectValue:   ptrs.ZeroInt(),
+			expectValue:   []string{"a", "b"},
 		},
-		"on empty *int Optional given negative int string source": optionalScanTC[string, *int]{
-			src:           "-123",
+		"on empty []string Optional given empty JSON array string source": optionalScanTC[string, []string]{
+			src:           `[]`,
 			expectPresent: true,
-			expectValue:   ptrs.Int(-123),
+			expectValue:   []string{},
 		},
-		"on empty *int Optional given positive int string source": optionalScanTC[string, *int]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Int(123),
+		"on empty []string Optional given malformed JSON array string source": optionalScanTC[string, []string]{
+			src:         `["a","b"`,
+			expectError: true,
 		},
-		"on empty *int Optional given non-int string source": optionalScanTC[string, *int]{
-			src:         "abc",
+		"on empty []string Optional given non-JSON array string source": optionalScanTC[string, []string]{
+			src:         `{a,b}`,
 			expectError: true,
 		},
-		"on empty Int Optional given int string source": optionalScanTC[string, Int]{
-			src:           "123",
+		"on empty *[]string Optional given JSON array string source": optionalScanTC[string, *[]string]{
+			src:           `["a","b"]`,
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   &[]string{"a", "b"},
 		},
-		"on empty *Int Optional given int string source": optionalScanTC[string, *Int]{
-			src:           "123",
+		"on empty []int Optional given JSON array string source": optionalScanTC[string, []int]{
+			src:           ` [1, 2, 3] `,
 			expectPresent: true,
-			expectValue:   ptrs.Value[Int](123),
+			expectValue:   []int{1, 2, 3},
 		},
-		"on empty int8 Optional given zero string source": optionalScanTC[string, int8]{
-			src:         "",
+		"on empty []int Optional given erroneous JSON array string source": optionalScanTC[string, []int]{
+			src:         `["a","b"]`,
 			expectError: true,
 		},
-		"on empty int8 Optional given zero int string source": optionalScanTC[string, int8]{
-			src:           "0",
+		"on empty []string Optional given JSON array []byte source": optionalScanTC[[]byte, []string]{
+			src:           []byte(`["a","b"]`),
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   []string{"a", "b"},
 		},
-		"on empty int8 Optional given negative non-zero int string source": optionalScanTC[string, int8]{
-			src:           "-123",
+		"on empty []string Optional given malformed JSON array []byte source": optionalScanTC[[]byte, []string]{
+			src:         []byte(`["a",`),
+			expectError: true,
+		},
+		"on empty []int Optional given JSON array []byte source": optionalScanTC[[]byte, []int]{
+			src:           []byte(`[1,2,3]`),
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   []int{1, 2, 3},
+		},
+		// Test cases for nil source
+		"on empty bool Optional given nil source": optionalScanTC[any, bool]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty *bool Optional given nil source": optionalScanTC[any, *bool]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty float64 Optional given nil source": optionalScanTC[any, float64]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty *float64 Optional given nil source": optionalScanTC[any, *float64]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty int64 Optional given nil source": optionalScanTC[any, int64]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty *int64 Optional given nil source": optionalScanTC[any, *int64]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty string Optional given nil source": optionalScanTC[any, string]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty *string Optional given nil source": optionalScanTC[any, *string]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty []byte Optional given nil source": optionalScanTC[any, []byte]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty time.Time Optional given nil source": optionalScanTC[any, time.Time]{
+			src:           nil,
+			expectPresent: false,
+		},
+		"on empty *time.Time Optional given nil source": optionalScanTC[any, *time.Time]{
+			src:           nil,
+			expectPresent: false,
 		},
-		"on empty int8 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int8]{
-			src:         "-123.456",
-			expectError: true,
+		"on empty any Optional given nil source": optionalScanTC[any, any]{
+			src:           nil,
+			expectPresent: false,
 		},
-		"on empty int8 Optional given negative non-zero int string source that exceeds min int8": optionalScanTC[string, int8]{
-			src:         minInt64String,
-			expectError: true,
+	})
+}
+
+func BenchmarkOptional_State(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.State()
+	}
+}
+
+type optionalStateTC[T any] struct {
+	opt    Optional[T]
+	expect State
+	test.Control
+}
+
+func (tc optionalStateTC[T]) Test(t *testing.T) {
+	state := tc.opt.State()
+	assert.Equal(t, tc.expect, state, "unexpected state")
+}
+
+func TestOptional_State(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalStateTC[int]{
+			opt:    Empty[int](),
+			expect: Absent,
 		},
-		"on empty int8 Optional given positive non-zero int string source": optionalScanTC[string, int8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty int Optional with zero value": optionalStateTC[int]{
+			opt:    Of(0),
+			expect: Present,
 		},
-		"on empty int8 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int8]{
-			src:         "123.456",
-			expectError: true,
+		"on non-empty int Optional with non-zero value": optionalStateTC[int]{
+			opt:    Of(123),
+			expect: Present,
 		},
-		"on empty int8 Optional given positive non-zero int string source that exceeds max int8": optionalScanTC[string, int8]{
-			src:         maxInt64String,
-			expectError: true,
+		"on empty string Optional": optionalStateTC[string]{
+			opt:    Empty[string](),
+			expect: Absent,
 		},
-		"on empty int8 Optional given non-int string source": optionalScanTC[string, int8]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty string Optional with zero value": optionalStateTC[string]{
+			opt:    Of(""),
+			expect: Present,
 		},
-		"on empty *int8 Optional given zero string source": optionalScanTC[string, *int8]{
-			src:         "",
-			expectError: true,
+		"on non-empty string Optional with non-zero value": optionalStateTC[string]{
+			opt:    Of("abc"),
+			expect: Present,
 		},
-		"on empty *int8 Optional given zero int string source": optionalScanTC[string, *int8]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt8(),
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_String(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.String()
+	}
+}
+
+type optionalStringTC[T any] struct {
+	opt    Optional[T]
+	expect string
+	test.Control
+}
+
+func (tc optionalStringTC[T]) Test(t *testing.T) {
+	value := tc.opt.String()
+	assert.Equal(t, tc.expect, value, "unexpected string representation")
+}
+
+func TestOptional_String(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalStringTC[int]{
+			opt:    Empty[int](),
+			expect: "<empty>",
 		},
-		"on empty *int8 Optional given negative int string source": optionalScanTC[string, *int8]{
-			src:           "-123",
-			expectPresent: true,
-			expectValue:   ptrs.Int8(-123),
+		"on non-empty int Optional with zero value": optionalStringTC[int]{
+			opt:    Of(0),
+			expect: "0",
 		},
-		"on empty *int8 Optional given positive int string source": optionalScanTC[string, *int8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Int8(123),
+		"on non-empty int Optional with non-zero value": optionalStringTC[int]{
+			opt:    Of(123),
+			expect: "123",
 		},
-		"on empty *int8 Optional given non-int string source": optionalScanTC[string, *int8]{
-			src:         "abc",
-			expectError: true,
+		"on empty string Optional": optionalStringTC[string]{
+			opt:    Empty[string](),
+			expect: "<empty>",
 		},
-		"on empty Int8 Optional given int string source": optionalScanTC[string, Int8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty string Optional with zero value": optionalStringTC[string]{
+			opt:    Of(""),
+			expect: "",
 		},
-		"on empty *Int8 Optional given int string source": optionalScanTC[string, *Int8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int8](123),
+		"on non-empty string Optional with non-zero value": optionalStringTC[string]{
+			opt:    Of("abc"),
+			expect: "abc",
 		},
-		"on empty int16 Optional given zero string source": optionalScanTC[string, int16]{
-			src:         "",
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_Stringer(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.Stringer()
+	}
+}
+
+type optionalStringerTC[T any] struct {
+	opt    Optional[T]
+	expect string
+	test.Control
+}
+
+func (tc optionalStringerTC[T]) Test(t *testing.T) {
+	stringer := tc.opt.Stringer()
+	assert.Equal(t, tc.expect, stringer.String(), "unexpected string representation")
+	assert.Equal(t, tc.opt.String(), stringer.String(), "unexpected string representation mismatch")
+}
+
+func TestOptional_Stringer(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalStringerTC[int]{
+			opt:    Empty[int](),
+			expect: "<empty>",
 		},
-		"on empty int16 Optional given zero int string source": optionalScanTC[string, int16]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   0,
+		"on non-empty int Optional with zero value": optionalStringerTC[int]{
+			opt:    Of(0),
+			expect: "0",
 		},
-		"on empty int16 Optional given negative non-zero int string source": optionalScanTC[string, int16]{
-			src:           "-123",
-			expectPresent: true,
-			expectValue:   -123,
+		"on non-empty int Optional with non-zero value": optionalStringerTC[int]{
+			opt:    Of(123),
+			expect: "123",
 		},
-		"on empty int16 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int16]{
-			src:         "-123.456",
-			expectError: true,
+		"on empty string Optional": optionalStringerTC[string]{
+			opt:    Empty[string](),
+			expect: "<empty>",
 		},
-		"on empty int16 Optional given negative non-zero int string source that exceeds min int16": optionalScanTC[string, int16]{
-			src:         minInt64String,
-			expectError: true,
+		"on non-empty string Optional with zero value": optionalStringerTC[string]{
+			opt:    Of(""),
+			expect: "",
 		},
-		"on empty int16 Optional given positive non-zero int string source": optionalScanTC[string, int16]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty string Optional with non-zero value": optionalStringerTC[string]{
+			opt:    Of("abc"),
+			expect: "abc",
 		},
-		"on empty int16 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int16]{
-			src:         "123.456",
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_Tap(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.Tap(func(_ int) {}, func() {})
+	}
+}
+
+type optionalTapTC[T any] struct {
+	opt                    Optional[T]
+	nilFuncs               bool
+	expectEmptyCallCount   uint
+	expectPresentCallCount uint
+	test.Control
+}
+
+func (tc optionalTapTC[T]) Test(t *testing.T) {
+	var emptyCallCount, presentCallCount uint
+	present := func(value T) {
+		presentCallCount++
+		assert.Equal(t, tc.opt.value, value)
+	}
+	empty := func() {
+		emptyCallCount++
+	}
+	if tc.nilFuncs {
+		present = nil
+		empty = nil
+	}
+	opt := tc.opt.Tap(present, empty)
+	assert.Equal(t, tc.opt, opt, "unexpected Optional")
+	assert.Equalf(t, tc.expectPresentCallCount, presentCallCount, "expected present function to be called %v times", tc.expectPresentCallCount)
+	assert.Equalf(t, tc.expectEmptyCallCount, emptyCallCount, "expected empty function to be called %v times", tc.expectEmptyCallCount)
+}
+
+func TestOptional_Tap(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalTapTC[int]{
+			opt:                  Empty[int](),
+			expectEmptyCallCount: 1,
 		},
-		"on empty int16 Optional given positive non-zero int string source that exceeds max int16": optionalScanTC[string, int16]{
-			src:         maxInt64String,
-			expectError: true,
+		"on non-empty int Optional with zero value": optionalTapTC[int]{
+			opt:                    Of(0),
+			expectPresentCallCount: 1,
 		},
-		"on empty int16 Optional given non-int string source": optionalScanTC[string, int16]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty int Optional with non-zero value": optionalTapTC[int]{
+			opt:                    Of(123),
+			expectPresentCallCount: 1,
 		},
-		"on empty *int16 Optional given zero string source": optionalScanTC[string, *int16]{
-			src:         "",
-			expectError: true,
+		"on empty string Optional": optionalTapTC[string]{
+			opt:                  Empty[string](),
+			expectEmptyCallCount: 1,
 		},
-		"on empty *int16 Optional given zero int string source": optionalScanTC[string, *int16]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt16(),
+		"on non-empty string Optional with zero value": optionalTapTC[string]{
+			opt:                    Of(""),
+			expectPresentCallCount: 1,
 		},
-		"on empty *int16 Optional given negative int string source": optionalScanTC[string, *int16]{
-			src:           "-123",
-			expectPresent: true,
-			expectValue:   ptrs.Int16(-123),
+		"on non-empty string Optional with non-zero value": optionalTapTC[string]{
+			opt:                    Of("abc"),
+			expectPresentCallCount: 1,
 		},
-		"on empty *int16 Optional given positive int string source": optionalScanTC[string, *int16]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Int16(123),
+		// Other test cases...
+		"on empty int Optional given nil functions": optionalTapTC[int]{
+			opt:      Empty[int](),
+			nilFuncs: true,
 		},
-		"on empty *int16 Optional given non-int string source": optionalScanTC[string, *int16]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty int Optional given nil functions": optionalTapTC[int]{
+			opt:      Of(123),
+			nilFuncs: true,
 		},
-		"on empty Int16 Optional given int string source": optionalScanTC[string, Int16]{
-			src:           "123",
+	})
+}
+
+func BenchmarkOptional_ToErrgroupFunc(b *testing.B) {
+	produce := func() (int, error) {
+		return 123, nil
+	}
+	var opt Optional[int]
+	for i := 0; i < b.N; i++ {
+		if err := opt.ToErrgroupFunc(produce)(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalToErrgroupFuncTC[T any] struct {
+	opt           Optional[T]
+	value         T
+	err           error
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc optionalToErrgroupFuncTC[T]) Test(t *testing.T) {
+	var callCount int
+	fn := tc.opt.ToErrgroupFunc(func() (T, error) {
+		callCount++
+		return tc.value, tc.err
+	})
+	assert.Equal(t, 0, callCount, "expected function to not be called before returned function")
+	err := fn()
+	assert.Equal(t, 1, callCount, "expected function to be called once")
+	assert.Equal(t, tc.err, err, "unexpected error")
+	value, present := tc.opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestOptional_ToErrgroupFunc(t *testing.T) {
+	err := errors.New("failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional given successful function": optionalToErrgroupFuncTC[int]{
+			opt:           Empty[int](),
+			value:         123,
 			expectPresent: true,
 			expectValue:   123,
-		},
-		"on empty *Int16 Optional given int string source": optionalScanTC[string, *Int16]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int16](123),
-		},
-		"on empty int32 Optional given zero string source": optionalScanTC[string, int32]{
-			src:         "",
-			expectError: true,
-		},
-		"on empty int32 Optional given zero int string source": optionalScanTC[string, int32]{
-			src:           "0",
+		},
+		"on empty int Optional given successful function returning zero value": optionalToErrgroupFuncTC[int]{
+			opt:           Empty[int](),
+			value:         0,
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty int32 Optional given negative non-zero int string source": optionalScanTC[string, int32]{
-			src:           "-123",
+		"on empty int Optional given erroneous function": optionalToErrgroupFuncTC[int]{
+			opt:           Empty[int](),
+			err:           err,
+			expectPresent: false,
+		},
+		"on empty string Optional given successful function": optionalToErrgroupFuncTC[string]{
+			opt:           Empty[string](),
+			value:         "abc",
 			expectPresent: true,
-			expectValue:   -123,
+			expectValue:   "abc",
 		},
-		"on empty int32 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int32]{
-			src:         "-123.456",
-			expectError: true,
+		"on empty string Optional given erroneous function": optionalToErrgroupFuncTC[string]{
+			opt:           Empty[string](),
+			err:           err,
+			expectPresent: false,
 		},
-		"on empty int32 Optional given negative non-zero int string source that exceeds min int32": optionalScanTC[string, int32]{
-			src:         minInt64String,
-			expectError: true,
+		// Other test cases...
+		"on non-empty int Optional given successful function": optionalToErrgroupFuncTC[int]{
+			opt:           Of(123),
+			value:         456,
+			expectPresent: true,
+			expectValue:   456,
 		},
-		"on empty int32 Optional given positive non-zero int string source": optionalScanTC[string, int32]{
-			src:           "123",
+		"on non-empty int Optional given erroneous function": optionalToErrgroupFuncTC[int]{
+			opt:           Of(123),
+			value:         456,
+			err:           err,
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty int32 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int32]{
-			src:         "123.456",
-			expectError: true,
-		},
-		"on empty int32 Optional given positive non-zero int string source that exceeds max int32": optionalScanTC[string, int32]{
-			src:         maxInt64String,
-			expectError: true,
+	})
+}
+
+func TestOptional_ToErrgroupFunc_concurrent(t *testing.T) {
+	var (
+		count Optional[int]
+		name  Optional[string]
+		wg    sync.WaitGroup
+	)
+	errs := make([]error, 2)
+	for i, fn := range []func() error{
+		count.ToErrgroupFunc(func() (int, error) {
+			return 123, nil
+		}),
+		name.ToErrgroupFunc(func() (string, error) {
+			return "", errors.New("failed")
+		}),
+	} {
+		i, fn := i, fn
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = fn()
+		}()
+	}
+	wg.Wait()
+
+	assert.NoError(t, errs[0], "unexpected error")
+	assert.Error(t, errs[1], "expected error")
+	assert.Equal(t, Of(123), count, "unexpected Optional")
+	assert.Equal(t, Empty[string](), name, "unexpected Optional")
+}
+
+func BenchmarkOptional_ToMap(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = opt.ToMap("abc")
+	}
+}
+
+type optionalToMapTC[T any] struct {
+	opt    Optional[T]
+	key    string
+	expect map[string]T
+	test.Control
+}
+
+func (tc optionalToMapTC[T]) Test(t *testing.T) {
+	actual := tc.opt.ToMap(tc.key)
+	assert.Equal(t, tc.expect, actual, "unexpected map")
+}
+
+func TestOptional_ToMap(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": optionalToMapTC[int]{
+			opt:    Empty[int](),
+			key:    "abc",
+			expect: nil,
 		},
-		"on empty int32 Optional given non-int string source": optionalScanTC[string, int32]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty int Optional with zero value": optionalToMapTC[int]{
+			opt:    Of(0),
+			key:    "abc",
+			expect: map[string]int{"abc": 0},
 		},
-		"on empty *int32 Optional given zero string source": optionalScanTC[string, *int32]{
-			src:         "",
-			expectError: true,
+		"on non-empty int Optional with non-zero value": optionalToMapTC[int]{
+			opt:    Of(123),
+			key:    "abc",
+			expect: map[string]int{"abc": 123},
 		},
-		"on empty *int32 Optional given zero int string source": optionalScanTC[string, *int32]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt32(),
+		"on empty string Optional": optionalToMapTC[string]{
+			opt:    Empty[string](),
+			key:    "abc",
+			expect: nil,
 		},
-		"on empty *int32 Optional given negative int string source": optionalScanTC[string, *int32]{
-			src:           "-123",
-			expectPresent: true,
-			expectValue:   ptrs.Int32(-123),
+		"on non-empty string Optional with zero value": optionalToMapTC[string]{
+			opt:    Of(""),
+			key:    "abc",
+			expect: map[string]string{"abc": ""},
 		},
-		"on empty *int32 Optional given positive int string source": optionalScanTC[string, *int32]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Int32(123),
+		"on non-empty string Optional with non-zero value": optionalToMapTC[string]{
+			opt:    Of("def"),
+			key:    "abc",
+			expect: map[string]string{"abc": "def"},
 		},
-		"on empty *int32 Optional given non-int string source": optionalScanTC[string, *int32]{
-			src:         "abc",
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkOptional_UnmarshalJSON(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var opt Optional[int]
+		if err := json.Unmarshal([]byte(`123`), &opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalUnmarshalJSONTC[T any] struct {
+	json   string
+	expect T
+	test.Control
+}
+
+func (tc optionalUnmarshalJSONTC[T]) Test(t *testing.T) {
+	var value T
+	err := json.Unmarshal([]byte(tc.json), &value)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expect, value, "unexpected value")
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	type Example struct {
+		Int       Optional[int]     `json:"int"`
+		String    Optional[string]  `json:"string"`
+		IntPtr    *Optional[int]    `json:"intPtr"`
+		StringPtr *Optional[string] `json:"stringPtr"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on empty int Optional": optionalUnmarshalJSONTC[Optional[int]]{
+			json:   `null`,
+			expect: Of(0),
 		},
-		"on empty Int32 Optional given int string source": optionalScanTC[string, Int32]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty int Optional with zero value": optionalUnmarshalJSONTC[Optional[int]]{
+			json:   `0`,
+			expect: Of(0),
 		},
-		"on empty *Int32 Optional given int string source": optionalScanTC[string, *Int32]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int32](123),
+		"on non-empty int Optional with non-zero value": optionalUnmarshalJSONTC[Optional[int]]{
+			json:   `123`,
+			expect: Of(123),
 		},
-		"on empty int64 Optional given zero string source": optionalScanTC[string, int64]{
-			src:         "",
-			expectError: true,
+		"on empty string Optional": optionalUnmarshalJSONTC[Optional[string]]{
+			json:   `null`,
+			expect: Of(""),
 		},
-		"on empty int64 Optional given zero int string source": optionalScanTC[string, int64]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   0,
+		"on non-empty string Optional with zero value": optionalUnmarshalJSONTC[Optional[string]]{
+			json:   `""`,
+			expect: Of(""),
 		},
-		"on empty int64 Optional given negative non-zero int string source": optionalScanTC[string, int64]{
-			src:           "-123",
-			expectPresent: true,
-			expectValue:   -123,
+		"on non-empty string Optional with non-zero value": optionalUnmarshalJSONTC[Optional[string]]{
+			json:   `"abc"`,
+			expect: Of("abc"),
 		},
-		"on empty int64 Optional given negative non-zero int string source that contains floating points": optionalScanTC[string, int64]{
-			src:         "-123.456",
-			expectError: true,
+		"on struct with empty Optionals": optionalUnmarshalJSONTC[Example]{
+			json:   `{}`,
+			expect: Example{},
 		},
-		"on empty int64 Optional given negative non-zero int string source that exceeds min int64": optionalScanTC[string, int64]{
-			src:         minInt64String + "0",
-			expectError: true,
+		"on struct with non-empty Optionals and zero field values": optionalUnmarshalJSONTC[Example]{
+			json: `{"int":0,"string":"","intPtr":0,"stringPtr":""}`,
+			expect: Example{
+				Int:       Of(0),
+				String:    Of(""),
+				IntPtr:    ptrs.Value(Of(0)),
+				StringPtr: ptrs.Value(Of("")),
+			},
 		},
-		"on empty int64 Optional given positive non-zero int string source": optionalScanTC[string, int64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on struct with non-empty Optionals and non-zero field values": optionalUnmarshalJSONTC[Example]{
+			json: `{"int":123,"string":"abc","intPtr":123,"stringPtr":"abc"}`,
+			expect: Example{
+				Int:       Of(123),
+				String:    Of("abc"),
+				IntPtr:    ptrs.Value(Of(123)),
+				StringPtr: ptrs.Value(Of("abc")),
+			},
 		},
-		"on empty int64 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, int64]{
-			src:         "123.456",
-			expectError: true,
+	})
+}
+
+func BenchmarkOptional_UnmarshalXML(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var opt Optional[int]
+		if err := xml.Unmarshal([]byte(`<int>123</int>`), &opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalUnmarshalXMLTC[T any] struct {
+	xml    string
+	expect T
+	test.Control
+}
+
+func (tc optionalUnmarshalXMLTC[T]) Test(t *testing.T) {
+	var value T
+	err := xml.Unmarshal([]byte(tc.xml), &value)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expect, value, "unexpected value")
+}
+
+func TestOptional_UnmarshalXML(t *testing.T) {
+	type Example struct {
+		Int       Optional[int]     `xml:"int"`
+		String    Optional[string]  `xml:"string"`
+		IntPtr    *Optional[int]    `xml:"intPtr"`
+		StringPtr *Optional[string] `xml:"stringPtr"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on empty int Optional": optionalUnmarshalXMLTC[Optional[int]]{
+			xml:    `<int/>`,
+			expect: Of(0),
 		},
-		"on empty int64 Optional given positive non-zero int string source that exceeds max int64": optionalScanTC[string, int64]{
-			src:         maxInt64String + "0",
-			expectError: true,
+		"on non-empty int Optional with zero value": optionalUnmarshalXMLTC[Optional[int]]{
+			xml:    `<int>0</int>`,
+			expect: Of(0),
 		},
-		"on empty int64 Optional given non-int string source": optionalScanTC[string, int64]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty int Optional with non-zero value": optionalUnmarshalXMLTC[Optional[int]]{
+			xml:    `<int>123</int>`,
+			expect: Of(123),
 		},
-		"on empty *int64 Optional given zero string source": optionalScanTC[string, *int64]{
-			src:         "",
-			expectError: true,
+		"on empty string Optional": optionalUnmarshalXMLTC[Optional[string]]{
+			xml:    `<string/>`,
+			expect: Of(""),
 		},
-		"on empty *int64 Optional given zero int string source": optionalScanTC[string, *int64]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt64(),
+		"on non-empty string Optional with zero value": optionalUnmarshalXMLTC[Optional[string]]{
+			xml:    `<string></string>`,
+			expect: Of(""),
 		},
-		"on empty *int64 Optional given negative int string source": optionalScanTC[string, *int64]{
-			src:           "-123",
-			expectPresent: true,
-			expectValue:   ptrs.Int64(-123),
+		"on non-empty string Optional with non-zero value": optionalUnmarshalXMLTC[Optional[string]]{
+			xml:    `<string>abc</string>`,
+			expect: Of("abc"),
 		},
-		"on empty *int64 Optional given positive int string source": optionalScanTC[string, *int64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Int64(123),
+		"on struct with empty Optionals": optionalUnmarshalXMLTC[Example]{
+			xml:    `<Example></Example>`,
+			expect: Example{},
 		},
-		"on empty *int64 Optional given non-int string source": optionalScanTC[string, *int64]{
-			src:         "abc",
-			expectError: true,
+		"on struct with non-empty Optionals and zero field values": optionalUnmarshalXMLTC[Example]{
+			xml: `<Example><int>0</int><string></string><intPtr>0</intPtr><stringPtr></stringPtr></Example>`,
+			expect: Example{
+				Int:       Of(0),
+				String:    Of(""),
+				IntPtr:    ptrs.Value(Of(0)),
+				StringPtr: ptrs.Value(Of("")),
+			},
 		},
-		"on empty Int64 Optional given int string source": optionalScanTC[string, Int64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on struct with non-empty Optionals and non-zero field values": optionalUnmarshalXMLTC[Example]{
+			xml: `<Example><int>123</int><string>abc</string><intPtr>123</intPtr><stringPtr>abc</stringPtr></Example>`,
+			expect: Example{
+				Int:       Of(123),
+				String:    Of("abc"),
+				IntPtr:    ptrs.Value(Of(123)),
+				StringPtr: ptrs.Value(Of("abc")),
+			},
 		},
-		"on empty *Int64 Optional given int string source": optionalScanTC[string, *Int64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int64](123),
+	})
+}
+
+func BenchmarkOptional_UnmarshalYAML(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var opt Optional[int]
+		if err := yaml.Unmarshal([]byte(`123`), &opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalUnmarshalYAMLTC[T any] struct {
+	yaml   string
+	expect T
+	test.Control
+}
+
+func (tc optionalUnmarshalYAMLTC[T]) Test(t *testing.T) {
+	var value T
+	err := yaml.Unmarshal([]byte(tc.yaml), &value)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expect, value, "unexpected value")
+}
+
+func TestOptional_UnmarshalYAML(t *testing.T) {
+	type Example struct {
+		Int       Optional[int]     `yaml:"int"`
+		String    Optional[string]  `yaml:"string"`
+		IntPtr    *Optional[int]    `yaml:"intPtr"`
+		StringPtr *Optional[string] `yaml:"stringPtr"`
+	}
+
+	test.RunCases(t, test.Cases{
+		"on empty int Optional": optionalUnmarshalYAMLTC[Optional[int]]{
+			yaml:   `null`,
+			expect: Empty[int](),
 		},
-		"on empty uint Optional given zero string source": optionalScanTC[string, uint]{
-			src:         "",
-			expectError: true,
+		"on non-empty int Optional with zero value": optionalUnmarshalYAMLTC[Optional[int]]{
+			yaml:   `0`,
+			expect: Of(0),
 		},
-		"on empty uint Optional given zero int string source": optionalScanTC[string, uint]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   0,
+		"on non-empty int Optional with non-zero value": optionalUnmarshalYAMLTC[Optional[int]]{
+			yaml:   `123`,
+			expect: Of(123),
 		},
-		"on empty uint Optional given negative non-zero int string source": optionalScanTC[string, uint]{
-			src:         "-123",
-			expectError: true,
+		"on empty string Optional": optionalUnmarshalYAMLTC[Optional[string]]{
+			yaml:   `null`,
+			expect: Empty[string](),
 		},
-		"on empty uint Optional given positive non-zero int string source": optionalScanTC[string, uint]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty string Optional with zero value": optionalUnmarshalYAMLTC[Optional[string]]{
+			yaml:   `""`,
+			expect: Of(""),
 		},
-		"on empty uint Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint]{
-			src:         "123.456",
-			expectError: true,
+		"on non-empty string Optional with non-zero value": optionalUnmarshalYAMLTC[Optional[string]]{
+			yaml:   `"abc"`,
+			expect: Of("abc"),
 		},
-		"on empty uint Optional given positive non-zero int string source that exceeds max uint": optionalScanTC[string, uint]{
-			src:         maxUint64String + "0",
-			expectError: true,
+		"on struct with empty Optionals": optionalUnmarshalYAMLTC[Example]{
+			yaml:   `{}`,
+			expect: Example{},
 		},
-		"on empty uint Optional given non-int string source": optionalScanTC[string, uint]{
-			src:         "abc",
-			expectError: true,
+		"on struct with non-empty Optionals and zero field values": optionalUnmarshalYAMLTC[Example]{
+			yaml: `int: 0
+string: ""
+intPtr: 0
+stringPtr: ""`,
+			expect: Example{
+				Int:       Of(0),
+				String:    Of(""),
+				IntPtr:    ptrs.Value(Of(0)),
+				StringPtr: ptrs.Value(Of("")),
+			},
 		},
-		"on empty *uint Optional given zero string source": optionalScanTC[string, *uint]{
-			src:         "",
-			expectError: true,
+		"on struct with non-empty Optionals and non-zero field values": optionalUnmarshalYAMLTC[Example]{
+			yaml: `int: 123
+string: abc
+intPtr: 123
+stringPtr: abc`,
+			expect: Example{
+				Int:       Of(123),
+				String:    Of("abc"),
+				IntPtr:    ptrs.Value(Of(123)),
+				StringPtr: ptrs.Value(Of("abc")),
+			},
 		},
-		"on empty *uint Optional given zero int string source": optionalScanTC[string, *uint]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint(),
+	})
+}
+
+func BenchmarkOptional_Value(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		if _, err := opt.Value(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type optionalValueTC[T any] struct {
+	opt         Optional[T]
+	expectError bool
+	expectValue driver.Value
+	test.Control
+}
+
+func (tc optionalValueTC[T]) Test(t *testing.T) {
+	value, err := tc.opt.Value()
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+}
+
+func TestOptional_Value(t *testing.T) {
+	type Bool bool
+
+	var timeNow = time.Now().UTC()
+
+	test.RunCases(t, test.Cases{
+		// Test cases for driver.Value types
+		"on empty bool Optional": optionalValueTC[bool]{
+			opt:         Empty[bool](),
+			expectValue: nil,
 		},
-		"on empty *uint Optional given non-zero int string source": optionalScanTC[string, *uint]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Uint(123),
+		"on non-empty bool Optional with zero value": optionalValueTC[bool]{
+			opt:         Of(false),
+			expectValue: false,
 		},
-		"on empty *uint Optional given non-int string source": optionalScanTC[string, *uint]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty bool Optional with non-zero value": optionalValueTC[bool]{
+			opt:         Of(true),
+			expectValue: true,
 		},
-		"on empty Uint Optional given int string source": optionalScanTC[string, Uint]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on empty float64 Optional": optionalValueTC[float64]{
+			opt:         Empty[float64](),
+			expectValue: nil,
 		},
-		"on empty *Uint Optional given int string source": optionalScanTC[string, *Uint]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint](123),
+		"on non-empty float64 Optional with zero value": optionalValueTC[float64]{
+			opt:         Of[float64](0),
+			expectValue: float64(0),
 		},
-		"on empty uint8 Optional given zero string source": optionalScanTC[string, uint8]{
-			src:         "",
-			expectError: true,
+		"on non-empty float64 Optional with non-zero value": optionalValueTC[float64]{
+			opt:         Of(123.456),
+			expectValue: 123.456,
 		},
-		"on empty uint8 Optional given zero int string source": optionalScanTC[string, uint8]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   0,
+		"on empty int64 Optional": optionalValueTC[int64]{
+			opt:         Empty[int64](),
+			expectValue: nil,
 		},
-		"on empty uint8 Optional given negative non-zero int string source": optionalScanTC[string, uint8]{
-			src:         "-123",
-			expectError: true,
+		"on non-empty int64 Optional with zero value": optionalValueTC[int64]{
+			opt:         Of[int64](0),
+			expectValue: int64(0),
 		},
-		"on empty uint8 Optional given positive non-zero int string source": optionalScanTC[string, uint8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty int64 Optional with non-zero value": optionalValueTC[int64]{
+			opt:         Of[int64](123),
+			expectValue: int64(123),
 		},
-		"on empty uint8 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint8]{
-			src:         "123.456",
-			expectError: true,
+		"on empty string Optional": optionalValueTC[string]{
+			opt:         Empty[string](),
+			expectValue: nil,
 		},
-		"on empty uint8 Optional given positive non-zero int string source that exceeds max uint8": optionalScanTC[string, uint8]{
-			src:         maxUint64String,
-			expectError: true,
+		"on non-empty string Optional with zero value": optionalValueTC[string]{
+			opt:         Of(""),
+			expectValue: "",
 		},
-		"on empty uint8 Optional given non-int string source": optionalScanTC[string, uint8]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty string Optional with non-zero value": optionalValueTC[string]{
+			opt:         Of("abc"),
+			expectValue: "abc",
 		},
-		"on empty *uint8 Optional given zero string source": optionalScanTC[string, *uint8]{
-			src:         "",
-			expectError: true,
+		"on empty []byte Optional": optionalValueTC[[]byte]{
+			opt:         Empty[[]byte](),
+			expectValue: nil,
 		},
-		"on empty *uint8 Optional given zero int string source": optionalScanTC[string, *uint8]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint8(),
+		"on non-empty []byte Optional with empty value": optionalValueTC[[]byte]{
+			opt:         Of([]byte{}),
+			expectValue: []byte{},
 		},
-		"on empty *uint8 Optional given non-zero int string source": optionalScanTC[string, *uint8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Uint8(123),
+		"on non-empty []byte Optional with non-empty value": optionalValueTC[[]byte]{
+			opt:         Of([]byte("abc")),
+			expectValue: []byte("abc"),
 		},
-		"on empty *uint8 Optional given non-int string source": optionalScanTC[string, *uint8]{
-			src:         "abc",
-			expectError: true,
+		"on empty time.Time Optional": optionalValueTC[time.Time]{
+			opt:         Empty[time.Time](),
+			expectValue: nil,
 		},
-		"on empty Uint8 Optional given int string source": optionalScanTC[string, Uint8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty time.Time Optional with zero value": optionalValueTC[time.Time]{
+			opt:         Of(time.Time{}),
+			expectValue: time.Time{},
 		},
-		"on empty *Uint8 Optional given int string source": optionalScanTC[string, *Uint8]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint8](123),
+		"on non-empty time.Time Optional with non-zero value": optionalValueTC[time.Time]{
+			opt:         Of(timeNow),
+			expectValue: timeNow,
 		},
-		"on empty uint16 Optional given zero string source": optionalScanTC[string, uint16]{
-			src:         "",
-			expectError: true,
+		// Test cases for non-driver.Value types
+		"on empty Bool Optional": optionalValueTC[Bool]{
+			opt:         Empty[Bool](),
+			expectValue: nil,
 		},
-		"on empty uint16 Optional given zero int string source": optionalScanTC[string, uint16]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   0,
+		"on non-empty Bool Optional with zero value": optionalValueTC[Bool]{
+			opt:         Of[Bool](false),
+			expectValue: false,
 		},
-		"on empty uint16 Optional given negative non-zero int string source": optionalScanTC[string, uint16]{
-			src:         "-123",
-			expectError: true,
+		"on non-empty Bool Optional with non-zero value": optionalValueTC[Bool]{
+			opt:         Of[Bool](true),
+			expectValue: true,
 		},
-		"on empty uint16 Optional given positive non-zero int string source": optionalScanTC[string, uint16]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on empty int32 Optional": optionalValueTC[int32]{
+			opt:         Empty[int32](),
+			expectValue: nil,
 		},
-		"on empty uint16 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint16]{
-			src:         "123.456",
-			expectError: true,
+		"on non-empty int32 Optional with zero value": optionalValueTC[int32]{
+			opt:         Of[int32](123),
+			expectValue: int64(123),
 		},
-		"on empty uint16 Optional given positive non-zero int string source that exceeds max uint16": optionalScanTC[string, uint16]{
-			src:         maxUint64String,
-			expectError: true,
+		"on non-empty int32 Optional with non-zero value": optionalValueTC[int32]{
+			opt:         Of[int32](123),
+			expectValue: int64(123),
 		},
-		"on empty uint16 Optional given non-int string source": optionalScanTC[string, uint16]{
-			src:         "abc",
-			expectError: true,
+		// Test cases for driver.Valuer types
+		"on empty sql.NullBool Optional": optionalValueTC[sql.NullBool]{
+			opt:         Empty[sql.NullBool](),
+			expectValue: nil,
 		},
-		"on empty *uint16 Optional given zero string source": optionalScanTC[string, *uint16]{
-			src:         "",
-			expectError: true,
+		"on non-empty sql.NullBool Optional given zero value": optionalValueTC[sql.NullBool]{
+			opt:         Of(sql.NullBool{}),
+			expectValue: nil,
 		},
-		"on empty *uint16 Optional given zero int string source": optionalScanTC[string, *uint16]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint16(),
+		"on non-empty sql.NullBool Optional given false bool value": optionalValueTC[sql.NullBool]{
+			opt:         Of(sql.NullBool{Bool: false, Valid: true}),
+			expectValue: false,
 		},
-		"on empty *uint16 Optional given non-zero int string source": optionalScanTC[string, *uint16]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Uint16(123),
+		"on non-empty sql.NullBool Optional given true bool value": optionalValueTC[sql.NullBool]{
+			opt:         Of(sql.NullBool{Bool: true, Valid: true}),
+			expectValue: true,
 		},
-		"on empty *uint16 Optional given non-int string source": optionalScanTC[string, *uint16]{
-			src:         "abc",
-			expectError: true,
+		"on empty sql.NullInt32 Optional": optionalValueTC[sql.NullInt32]{
+			opt:         Empty[sql.NullInt32](),
+			expectValue: nil,
 		},
-		"on empty Uint16 Optional given int string source": optionalScanTC[string, Uint16]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty sql.NullInt32 Optional given zero value": optionalValueTC[sql.NullInt32]{
+			opt:         Of(sql.NullInt32{}),
+			expectValue: nil,
 		},
-		"on empty *Uint16 Optional given int string source": optionalScanTC[string, *Uint16]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint16](123),
+		"on non-empty sql.NullInt32 Optional given zero int32 value": optionalValueTC[sql.NullInt32]{
+			opt:         Of(sql.NullInt32{Int32: 0, Valid: true}),
+			expectValue: int64(0),
 		},
-		"on empty uint32 Optional given zero string source": optionalScanTC[string, uint32]{
-			src:         "",
-			expectError: true,
+		"on non-empty sql.NullInt32 Optional given non-zero int32 value": optionalValueTC[sql.NullInt32]{
+			opt:         Of(sql.NullInt32{Int32: 123, Valid: true}),
+			expectValue: int64(123),
 		},
-		"on empty uint32 Optional given zero int string source": optionalScanTC[string, uint32]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   0,
+		"on empty Optional[int] Optional": optionalValueTC[Optional[int]]{
+			opt:         Empty[Optional[int]](),
+			expectValue: nil,
 		},
-		"on empty uint32 Optional given negative non-zero int string source": optionalScanTC[string, uint32]{
-			src:         "-123",
-			expectError: true,
+		"on non-empty Optional[int] Optional with empty value": optionalValueTC[Optional[int]]{
+			opt:         Of(Empty[int]()),
+			expectValue: nil,
 		},
-		"on empty uint32 Optional given positive non-zero int string source": optionalScanTC[string, uint32]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on non-empty Optional[int] Optional with non-empty value with zero value": optionalValueTC[Optional[int]]{
+			opt:         Of(Of(0)),
+			expectValue: int64(0),
 		},
-		"on empty uint32 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint32]{
-			src:         "123.456",
-			expectError: true,
+		"on non-empty Optional[int] Optional with non-empty value with non-zero value": optionalValueTC[Optional[int]]{
+			opt:         Of(Of(123)),
+			expectValue: int64(123),
 		},
-		"on empty uint32 Optional given positive non-zero int string source that exceeds max uint32": optionalScanTC[string, uint32]{
-			src:         maxUint64String,
-			expectError: true,
+		"on non-empty Optional[Optional[string]] Optional with non-empty values": optionalValueTC[Optional[Optional[string]]]{
+			opt:         Of(Of(Of("abc"))),
+			expectValue: "abc",
 		},
-		"on empty uint32 Optional given non-int string source": optionalScanTC[string, uint32]{
-			src:         "abc",
-			expectError: true,
+		"on non-empty Optional[Optional[string]] Optional with empty innermost value": optionalValueTC[Optional[Optional[string]]]{
+			opt:         Of(Of(Empty[string]())),
+			expectValue: nil,
 		},
-		"on empty *uint32 Optional given zero string source": optionalScanTC[string, *uint32]{
-			src:         "",
+		"on non-empty Optional[[]int] Optional with non-empty unsupported value": optionalValueTC[Optional[[]int]]{
+			opt:         Of(Of([]int{1})),
 			expectError: true,
 		},
-		"on empty *uint32 Optional given zero int string source": optionalScanTC[string, *uint32]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint32(),
-		},
-		"on empty *uint32 Optional given non-zero int string source": optionalScanTC[string, *uint32]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Uint32(123),
+	})
+}
+
+func BenchmarkOptional_WriteToBuilder(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		var sb strings.Builder
+		opt.WriteToBuilder(&sb, ", ")
+	}
+}
+
+type optionalWriteToBuilderTC[T any] struct {
+	opts   []Optional[T]
+	prefix string
+	sep    string
+	expect string
+	test.Control
+}
+
+func (tc optionalWriteToBuilderTC[T]) Test(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(tc.prefix)
+	for _, opt := range tc.opts {
+		opt.WriteToBuilder(&b, tc.sep)
+	}
+	assert.Equal(t, tc.expect, b.String(), "unexpected string")
+}
+
+func TestOptional_WriteToBuilder(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on string Optionals": optionalWriteToBuilderTC[string]{
+			opts:   []Optional[string]{Of("abc"), Empty[string](), Of(""), Of("def")},
+			sep:    ", ",
+			expect: "abc, , def",
 		},
-		"on empty *uint32 Optional given non-int string source": optionalScanTC[string, *uint32]{
-			src:         "abc",
-			expectError: true,
+		// Other test cases...
+		"on no Optionals": optionalWriteToBuilderTC[int]{
+			sep:    ",",
+			expect: "",
 		},
-		"on empty Uint32 Optional given int string source": optionalScanTC[string, Uint32]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on empty int Optional": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Empty[int]()},
+			sep:    ",",
+			expect: "",
 		},
-		"on empty *Uint32 Optional given int string source": optionalScanTC[string, *Uint32]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint32](123),
+		"on non-empty int Optional with zero value": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Of(0)},
+			sep:    ",",
+			expect: "0",
 		},
-		"on empty uint64 Optional given zero string source": optionalScanTC[string, uint64]{
-			src:         "",
-			expectError: true,
+		"on non-empty int Optional with non-zero value": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Of(123)},
+			sep:    ",",
+			expect: "123",
 		},
-		"on empty uint64 Optional given zero int string source": optionalScanTC[string, uint64]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   0,
+		"on int Optionals with leading empty Optionals": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Empty[int](), Empty[int](), Of(1), Of(2)},
+			sep:    ",",
+			expect: "1,2",
 		},
-		"on empty uint64 Optional given negative non-zero int string source": optionalScanTC[string, uint64]{
-			src:         "-123",
-			expectError: true,
+		"on int Optionals with trailing empty Optionals": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Of(1), Of(2), Empty[int](), Empty[int]()},
+			sep:    ",",
+			expect: "1,2",
 		},
-		"on empty uint64 Optional given positive non-zero int string source": optionalScanTC[string, uint64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"on int Optionals with all empty": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Empty[int](), Empty[int]()},
+			sep:    ",",
+			expect: "",
 		},
-		"on empty uint64 Optional given positive non-zero int string source that contains floating points": optionalScanTC[string, uint64]{
-			src:         "123.456",
-			expectError: true,
+		"on int Optionals with non-empty builder": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Of(1), Empty[int](), Of(2)},
+			prefix: "values: ",
+			sep:    ",",
+			expect: "values: ,1,2",
 		},
-		"on empty uint64 Optional given positive non-zero int string source that exceeds max uint": optionalScanTC[string, uint64]{
-			src:         maxUint64String + "0",
-			expectError: true,
+		"on int Optionals with empty separator": optionalWriteToBuilderTC[int]{
+			opts:   []Optional[int]{Of(1), Of(2), Of(3)},
+			expect: "123",
 		},
-		"on empty uint64 Optional given non-int string source": optionalScanTC[string, uint64]{
-			src:         "abc",
-			expectError: true,
+	})
+}
+
+func BenchmarkAppendPresent(b *testing.B) {
+	opts := []Optional[int]{Empty[int](), Of(0), Of(123)}
+	dst := make([]int, 0, 2)
+	for i := 0; i < b.N; i++ {
+		dst = dst[:0]
+		AppendPresent(&dst, opts...)
+	}
+}
+
+type appendPresentTC[T any] struct {
+	dst    []T
+	calls  [][]Optional[T]
+	expect []T
+	test.Control
+}
+
+func (tc appendPresentTC[T]) Test(t *testing.T) {
+	dst := tc.dst
+	for _, opts := range tc.calls {
+		AppendPresent(&dst, opts...)
+	}
+	assert.Equal(t, tc.expect, dst, "unexpected values")
+}
+
+func TestAppendPresent(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil int slice and no int Optionals": appendPresentTC[int]{
+			calls:  [][]Optional[int]{nil},
+			expect: nil,
 		},
-		"on empty *uint64 Optional given zero string source": optionalScanTC[string, *uint64]{
-			src:         "",
-			expectError: true,
+		"given nil int slice and empty int Optional": appendPresentTC[int]{
+			calls:  [][]Optional[int]{{Empty[int]()}},
+			expect: nil,
 		},
-		"on empty *uint64 Optional given zero int string source": optionalScanTC[string, *uint64]{
-			src:           "0",
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint64(),
+		"given int slice and empty and non-empty int Optionals across two calls": appendPresentTC[int]{
+			dst: []int{-1},
+			calls: [][]Optional[int]{
+				{Empty[int](), Of(0), Of(123)},
+				{Of(456), Empty[int]()},
+			},
+			expect: []int{-1, 0, 123, 456},
 		},
-		"on empty *uint64 Optional given non-zero int string source": optionalScanTC[string, *uint64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Uint64(123),
+		"given nil string slice and no string Optionals": appendPresentTC[string]{
+			calls:  [][]Optional[string]{nil},
+			expect: nil,
 		},
-		"on empty *uint64 Optional given non-int string source": optionalScanTC[string, *uint64]{
-			src:         "abc",
-			expectError: true,
+		"given nil string slice and empty string Optional": appendPresentTC[string]{
+			calls:  [][]Optional[string]{{Empty[string]()}},
+			expect: nil,
 		},
-		"on empty Uint64 Optional given int string source": optionalScanTC[string, Uint64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   123,
+		"given string slice and empty and non-empty string Optionals across two calls": appendPresentTC[string]{
+			dst: []string{"xyz"},
+			calls: [][]Optional[string]{
+				{Empty[string](), Of("abc"), Of("")},
+				{Of("def"), Empty[string]()},
+			},
+			expect: []string{"xyz", "abc", "", "def"},
 		},
-		"on empty *Uint64 Optional given int string source": optionalScanTC[string, *Uint64]{
-			src:           "123",
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint64](123),
+		// Other test cases...
+	})
+}
+
+func BenchmarkAsError(b *testing.B) {
+	opt := Of(errors.New("failed"))
+	for i := 0; i < b.N; i++ {
+		_ = AsError(opt)
+	}
+}
+
+type asErrorTC struct {
+	opt    Optional[error]
+	expect error
+	test.Control
+}
+
+func (tc asErrorTC) Test(t *testing.T) {
+	err := AsError(tc.opt)
+	assert.Equal(t, tc.expect == nil, err == nil, "unexpected error nilness")
+	assert.Equal(t, tc.expect, err, "unexpected error")
+}
+
+func TestAsError(t *testing.T) {
+	type customError struct {
+		error
+	}
+
+	err := errors.New("failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty error Optional": asErrorTC{
+			opt:    Empty[error](),
+			expect: nil,
 		},
-		"on empty []byte Optional given zero string source": optionalScanTC[string, []byte]{
-			src:           "",
-			expectPresent: true,
-			expectValue:   []byte(""),
+		"given non-empty error Optional with nil value": asErrorTC{
+			opt:    Of[error](nil),
+			expect: nil,
 		},
-		"on empty []byte Optional given non-zero string source": optionalScanTC[string, []byte]{
-			src:           "abc",
-			expectPresent: true,
-			expectValue:   []byte("abc"),
+		"given non-empty error Optional with non-nil value": asErrorTC{
+			opt:    Of(err),
+			expect: err,
 		},
-		"on empty Bytes Optional given non-zero string source": optionalScanTC[string, Bytes]{
-			src:           "abc",
-			expectPresent: true,
-			expectValue:   Bytes("abc"),
+		"given non-empty error Optional with typed nil pointer value": asErrorTC{
+			opt:    Of[error]((*customError)(nil)),
+			expect: (*customError)(nil),
 		},
-		"on empty sql.RawBytes Optional given non-zero string source": optionalScanTC[string, sql.RawBytes]{
-			src:           "abc",
-			expectPresent: true,
-			expectValue:   sql.RawBytes("abc"),
+		// Other test cases...
+	})
+}
+
+func BenchmarkAt(b *testing.B) {
+	s := []int{0, 123}
+	for i := 0; i < b.N; i++ {
+		_ = At(s, 1)
+	}
+}
+
+type atTC[E any] struct {
+	s             []E
+	i             int
+	expectPresent bool
+	expectValue   E
+	test.Control
+}
+
+func (tc atTC[E]) Test(t *testing.T) {
+	opt := At(tc.s, tc.i)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestAt(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil int slice": atTC[int]{
+			s:             nil,
+			i:             0,
+			expectPresent: false,
 		},
-		"on empty any Optional given zero string source": optionalScanTC[string, any]{
-			src:           "",
+		"given int slice and in-range index for zero value": atTC[int]{
+			s:             []int{0, 123},
+			i:             0,
 			expectPresent: true,
-			expectValue:   "",
+			expectValue:   0,
 		},
-		"on empty any Optional given non-zero string source": optionalScanTC[string, any]{
-			src:           "abc",
+		"given int slice and in-range index for non-zero value": atTC[int]{
+			s:             []int{0, 123},
+			i:             1,
 			expectPresent: true,
-			expectValue:   "abc",
+			expectValue:   123,
 		},
-		"on empty Optional of unsupported slice given non-zero string source": optionalScanTC[string, []uintptr]{
-			src:         "abc",
-			expectError: true,
+		"given int slice and out-of-range index": atTC[int]{
+			s:             []int{0, 123},
+			i:             2,
+			expectPresent: false,
 		},
-		"on empty Optional of unsupported type given non-zero string source": optionalScanTC[string, uintptr]{
-			src:         "abc",
-			expectError: true,
+		"given int slice and negative index": atTC[int]{
+			s:             []int{0, 123},
+			i:             -1,
+			expectPresent: false,
 		},
-		"on empty sql.NullString Optional given non-zero string source": optionalScanTC[string, sql.NullString]{
-			src:           "abc",
-			expectPresent: true,
-			expectValue:   sql.NullString{String: "abc", Valid: true},
+		"given nil string slice": atTC[string]{
+			s:             nil,
+			i:             0,
+			expectPresent: false,
 		},
-		// Test cases for []byte source
-		// Supported destination types (incl. pointers and convertible types):
-		// []byte, bool, float32, float64, int, int8, int16, int32, int64, string, uint, uint8, uint16, uint32, uint64,
-		// sql.RawBytes, any
-		"on empty []byte Optional given empty []byte source": optionalScanTC[[]byte, []byte]{
-			src:           []byte{},
+		"given string slice and in-range index for zero value": atTC[string]{
+			s:             []string{"abc", ""},
+			i:             1,
 			expectPresent: true,
-			expectValue:   []byte{},
+			expectValue:   "",
 		},
-		"on empty []byte Optional given non-empty []byte source": optionalScanTC[[]byte, []byte]{
-			src:           []byte("abc"),
+		"given string slice and in-range index for non-zero value": atTC[string]{
+			s:             []string{"abc", ""},
+			i:             0,
 			expectPresent: true,
-			expectValue:   []byte("abc"),
+			expectValue:   "abc",
 		},
-		"on empty Bytes Optional given empty []byte source": optionalScanTC[[]byte, Bytes]{
-			src:           []byte{},
-			expectPresent: true,
-			expectValue:   Bytes{},
+		"given string slice and out-of-range index": atTC[string]{
+			s:             []string{"abc", ""},
+			i:             2,
+			expectPresent: false,
 		},
-		"on empty Bytes Optional given non-empty []byte source": optionalScanTC[[]byte, Bytes]{
-			src:           []byte("abc"),
-			expectPresent: true,
-			expectValue:   Bytes("abc"),
+		"given string slice and negative index": atTC[string]{
+			s:             []string{"abc", ""},
+			i:             -1,
+			expectPresent: false,
 		},
-		"on empty bool Optional given empty []byte source": optionalScanTC[[]byte, bool]{
-			src:         []byte{},
-			expectError: true,
+		// Other test cases...
+		"given empty int slice": atTC[int]{
+			s:             []int{},
+			i:             0,
+			expectPresent: false,
 		},
-		"on empty bool Optional given false []byte source": optionalScanTC[[]byte, bool]{
-			src:           []byte("false"),
-			expectPresent: true,
-			expectValue:   false,
+	})
+}
+
+func BenchmarkAtLeast(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = AtLeast(opt, 100)
+	}
+}
+
+type atLeastTC[T cmp.Ordered] struct {
+	opt    Optional[T]
+	lo     T
+	expect T
+	test.Control
+}
+
+func (tc atLeastTC[T]) Test(t *testing.T) {
+	value := AtLeast(tc.opt, tc.lo)
+	assert.Equal(t, tc.expect, value, "unexpected value")
+}
+
+func TestAtLeast(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": atLeastTC[int]{
+			opt:    Empty[int](),
+			lo:     100,
+			expect: 100,
 		},
-		"on empty bool Optional given true []byte source": optionalScanTC[[]byte, bool]{
-			src:           []byte("true"),
-			expectPresent: true,
-			expectValue:   true,
+		"given non-empty int Optional with value below bound": atLeastTC[int]{
+			opt:    Of(0),
+			lo:     100,
+			expect: 100,
 		},
-		"on empty bool Optional given non-boolean []byte source": optionalScanTC[[]byte, bool]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty int Optional with value above bound": atLeastTC[int]{
+			opt:    Of(123),
+			lo:     100,
+			expect: 123,
 		},
-		"on empty *bool Optional given empty []byte source": optionalScanTC[[]byte, *bool]{
-			src:         []byte{},
-			expectError: true,
+		"given empty string Optional": atLeastTC[string]{
+			opt:    Empty[string](),
+			lo:     "b",
+			expect: "b",
 		},
-		"on empty *bool Optional given boolean []byte source": optionalScanTC[[]byte, *bool]{
-			src:           []byte("true"),
-			expectPresent: true,
-			expectValue:   ptrs.True(),
+		"given non-empty string Optional with value below bound": atLeastTC[string]{
+			opt:    Of("abc"),
+			lo:     "b",
+			expect: "b",
 		},
-		"on empty *bool Optional given non-boolean []byte source": optionalScanTC[[]byte, *bool]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty string Optional with value above bound": atLeastTC[string]{
+			opt:    Of("xyz"),
+			lo:     "b",
+			expect: "xyz",
 		},
-		"on empty Bool Optional given boolean []byte source": optionalScanTC[[]byte, Bool]{
-			src:           []byte("true"),
-			expectPresent: true,
-			expectValue:   true,
+		// Other test cases...
+		"given non-empty int Optional with value equal to bound": atLeastTC[int]{
+			opt:    Of(100),
+			lo:     100,
+			expect: 100,
 		},
-		"on empty *Bool Optional given boolean []byte source": optionalScanTC[[]byte, *Bool]{
-			src:           []byte("false"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Bool](false),
+	})
+}
+
+func BenchmarkBucket(b *testing.B) {
+	opt := Of(50)
+	bounds := []int{10, 100}
+	labels := []string{"low", "medium", "high"}
+	for i := 0; i < b.N; i++ {
+		_ = Bucket(opt, bounds, labels)
+	}
+}
+
+type bucketTC[T cmp.Ordered] struct {
+	opt         Optional[T]
+	bounds      []T
+	labels      []string
+	expect      Optional[string]
+	expectPanic bool
+	test.Control
+}
+
+func (tc bucketTC[T]) Test(t *testing.T) {
+	if tc.expectPanic {
+		assert.Panics(t, func() {
+			Bucket(tc.opt, tc.bounds, tc.labels)
+		}, "expected panic")
+		return
+	}
+	actual := Bucket(tc.opt, tc.bounds, tc.labels)
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestBucket(t *testing.T) {
+	intBounds := []int{10, 100}
+	labels := []string{"low", "medium", "high"}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": bucketTC[int]{
+			opt:    Empty[int](),
+			bounds: intBounds,
+			labels: labels,
+			expect: Empty[string](),
 		},
-		"on empty float32 Optional given empty []byte source": optionalScanTC[[]byte, float32]{
-			src:         []byte{},
-			expectError: true,
+		"given non-empty int Optional with value below first bound": bucketTC[int]{
+			opt:    Of(5),
+			bounds: intBounds,
+			labels: labels,
+			expect: Of("low"),
+		},
+		"given non-empty int Optional with value equal to first bound": bucketTC[int]{
+			opt:    Of(10),
+			bounds: intBounds,
+			labels: labels,
+			expect: Of("medium"),
+		},
+		"given non-empty int Optional with value between bounds": bucketTC[int]{
+			opt:    Of(50),
+			bounds: intBounds,
+			labels: labels,
+			expect: Of("medium"),
+		},
+		"given non-empty int Optional with value equal to last bound": bucketTC[int]{
+			opt:    Of(100),
+			bounds: intBounds,
+			labels: labels,
+			expect: Of("high"),
+		},
+		"given non-empty int Optional with value above last bound": bucketTC[int]{
+			opt:    Of(1000),
+			bounds: intBounds,
+			labels: labels,
+			expect: Of("high"),
 		},
-		"on empty float32 Optional given zero float []byte source": optionalScanTC[[]byte, float32]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		// Other test cases...
+		"given non-empty int Optional with zero value": bucketTC[int]{
+			opt:    Of(0),
+			bounds: intBounds,
+			labels: labels,
+			expect: Of("low"),
+		},
+		"given non-empty float64 Optional with value between bounds": bucketTC[float64]{
+			opt:    Of(0.5),
+			bounds: []float64{0.25, 0.75},
+			labels: labels,
+			expect: Of("medium"),
+		},
+		"given non-empty string Optional with value above last bound": bucketTC[string]{
+			opt:    Of("z"),
+			bounds: []string{"h", "p"},
+			labels: labels,
+			expect: Of("high"),
+		},
+		"given non-empty int Optional and no bounds": bucketTC[int]{
+			opt:    Of(123),
+			labels: []string{"all"},
+			expect: Of("all"),
+		},
+		"given non-empty int Optional with value equal to duplicate bounds": bucketTC[int]{
+			opt:    Of(10),
+			bounds: []int{10, 10, 100},
+			labels: []string{"a", "b", "c", "d"},
+			expect: Of("c"),
+		},
+		"given non-empty int Optional and too few labels": bucketTC[int]{
+			opt:         Of(50),
+			bounds:      intBounds,
+			labels:      labels[:2],
+			expectPanic: true,
 		},
-		"on empty float32 Optional given negative non-zero float []byte source": optionalScanTC[[]byte, float32]{
-			src:           []byte("-123.456"),
-			expectPresent: true,
-			expectValue:   -123.456,
+		"given empty int Optional and too many labels": bucketTC[int]{
+			opt:         Empty[int](),
+			bounds:      intBounds,
+			labels:      append([]string{"none"}, labels...),
+			expectPanic: true,
 		},
-		"on empty float32 Optional given negative non-zero float []byte source that exceeds min float32": optionalScanTC[[]byte, float32]{
-			src:         []byte(minFloat64String),
-			expectError: true,
+		"given non-empty int Optional and unsorted bounds": bucketTC[int]{
+			opt:         Of(50),
+			bounds:      []int{100, 10},
+			labels:      labels,
+			expectPanic: true,
 		},
-		"on empty float32 Optional given positive non-zero float []byte source": optionalScanTC[[]byte, float32]{
-			src:           []byte("123.456"),
-			expectPresent: true,
-			expectValue:   123.456,
+	})
+}
+
+func BenchmarkChangedFields(b *testing.B) {
+	before := map[string]Optional[int]{"abc": Of(123), "def": Of(456)}
+	after := map[string]Optional[int]{"abc": Of(123), "def": Of(789)}
+	for i := 0; i < b.N; i++ {
+		_ = ChangedFields(before, after)
+	}
+}
+
+type changedFieldsTC[T comparable] struct {
+	before map[string]Optional[T]
+	after  map[string]Optional[T]
+	expect map[string]Optional[T]
+	test.Control
+}
+
+func (tc changedFieldsTC[T]) Test(t *testing.T) {
+	changed := ChangedFields(tc.before, tc.after)
+	assert.Equal(t, tc.expect, changed, "unexpected changed fields")
+}
+
+func TestChangedFields(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil maps": changedFieldsTC[int]{
+			expect: map[string]Optional[int]{},
+		},
+		"given unchanged int Optionals": changedFieldsTC[int]{
+			before: map[string]Optional[int]{"abc": Of(123), "def": Empty[int]()},
+			after:  map[string]Optional[int]{"abc": Of(123), "def": Empty[int]()},
+			expect: map[string]Optional[int]{},
+		},
+		"given added, removed, modified, and unchanged int Optionals": changedFieldsTC[int]{
+			before: map[string]Optional[int]{
+				"modified":  Of(123),
+				"removed":   Of(456),
+				"unchanged": Of(789),
+				"unset":     Of(0),
+			},
+			after: map[string]Optional[int]{
+				"added":     Of(0),
+				"modified":  Of(321),
+				"unchanged": Of(789),
+				"unset":     Empty[int](),
+			},
+			expect: map[string]Optional[int]{
+				"added":    Of(0),
+				"modified": Of(321),
+				"removed":  Empty[int](),
+				"unset":    Empty[int](),
+			},
 		},
-		"on empty float32 Optional given positive non-zero float []byte source that exceeds max float32": optionalScanTC[[]byte, float32]{
-			src:         []byte(maxFloat64String),
-			expectError: true,
+		"given added, removed, modified, and unchanged string Optionals": changedFieldsTC[string]{
+			before: map[string]Optional[string]{
+				"modified":  Of("abc"),
+				"removed":   Of("def"),
+				"unchanged": Of(""),
+			},
+			after: map[string]Optional[string]{
+				"added":     Of(""),
+				"modified":  Of("xyz"),
+				"unchanged": Of(""),
+			},
+			expect: map[string]Optional[string]{
+				"added":    Of(""),
+				"modified": Of("xyz"),
+				"removed":  Empty[string](),
+			},
 		},
-		"on empty float32 Optional given non-float []byte source": optionalScanTC[[]byte, float32]{
-			src:         []byte("abc"),
-			expectError: true,
+		// Other test cases...
+		"given empty int Optionals only within one map": changedFieldsTC[int]{
+			before: map[string]Optional[int]{"abc": Empty[int]()},
+			after:  map[string]Optional[int]{"def": Empty[int]()},
+			expect: map[string]Optional[int]{},
 		},
-		"on empty *float32 Optional given empty []byte source": optionalScanTC[[]byte, *float32]{
-			src:         []byte{},
-			expectError: true,
+	})
+}
+
+func BenchmarkClamp(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = Clamp(opt, 0, 100)
+	}
+}
+
+type clampTC[T cmp.Ordered] struct {
+	opt           Optional[T]
+	lo            T
+	hi            T
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc clampTC[T]) Test(t *testing.T) {
+	opt := Clamp(tc.opt, tc.lo, tc.hi)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestClamp(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": clampTC[int]{
+			opt:           Empty[int](),
+			lo:            0,
+			hi:            100,
+			expectPresent: false,
 		},
-		"on empty *float32 Optional given zero float []byte source": optionalScanTC[[]byte, *float32]{
-			src:           []byte("0"),
+		"given non-empty int Optional with value below range": clampTC[int]{
+			opt:           Of(-123),
+			lo:            0,
+			hi:            100,
 			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat32(),
+			expectValue:   0,
 		},
-		"on empty *float32 Optional given negative float []byte source": optionalScanTC[[]byte, *float32]{
-			src:           []byte("-123.456"),
+		"given non-empty int Optional with value within range": clampTC[int]{
+			opt:           Of(50),
+			lo:            0,
+			hi:            100,
 			expectPresent: true,
-			expectValue:   ptrs.Float32(-123.456),
+			expectValue:   50,
 		},
-		"on empty *float32 Optional given positive float []byte source": optionalScanTC[[]byte, *float32]{
-			src:           []byte("123.456"),
+		"given non-empty int Optional with value above range": clampTC[int]{
+			opt:           Of(123),
+			lo:            0,
+			hi:            100,
 			expectPresent: true,
-			expectValue:   ptrs.Float32(123.456),
+			expectValue:   100,
 		},
-		"on empty *float32 Optional given non-float []byte source": optionalScanTC[[]byte, *float32]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given empty string Optional": clampTC[string]{
+			opt:           Empty[string](),
+			lo:            "b",
+			hi:            "d",
+			expectPresent: false,
 		},
-		"on empty Float32 Optional given float []byte source": optionalScanTC[[]byte, Float32]{
-			src:           []byte("123.456"),
+		"given non-empty string Optional with value below range": clampTC[string]{
+			opt:           Of("abc"),
+			lo:            "b",
+			hi:            "d",
 			expectPresent: true,
-			expectValue:   123.456,
+			expectValue:   "b",
 		},
-		"on empty *Float32 Optional given float []byte source": optionalScanTC[[]byte, *Float32]{
-			src:           []byte("123.456"),
+		"given non-empty string Optional with value within range": clampTC[string]{
+			opt:           Of("cde"),
+			lo:            "b",
+			hi:            "d",
 			expectPresent: true,
-			expectValue:   ptrs.Value[Float32](123.456),
+			expectValue:   "cde",
 		},
-		"on empty float64 Optional given empty []byte source": optionalScanTC[[]byte, float64]{
-			src:         []byte{},
-			expectError: true,
+		"given non-empty string Optional with value above range": clampTC[string]{
+			opt:           Of("xyz"),
+			lo:            "b",
+			hi:            "d",
+			expectPresent: true,
+			expectValue:   "d",
 		},
-		"on empty float64 Optional given zero float []byte source": optionalScanTC[[]byte, float64]{
-			src:           []byte("0"),
+		// Other test cases...
+		"given non-empty int Optional with value equal to lower bound": clampTC[int]{
+			opt:           Of(0),
+			lo:            0,
+			hi:            100,
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty float64 Optional given negative non-zero float []byte source": optionalScanTC[[]byte, float64]{
-			src:           []byte("-123.456"),
+		"given non-empty int Optional with value equal to upper bound": clampTC[int]{
+			opt:           Of(100),
+			lo:            0,
+			hi:            100,
 			expectPresent: true,
-			expectValue:   -123.456,
-		},
-		"on empty float64 Optional given negative non-zero float []byte source that exceeds min float64": optionalScanTC[[]byte, float64]{
-			src:         []byte(minFloat64String + "0"),
-			expectError: true,
+			expectValue:   100,
 		},
-		"on empty float64 Optional given positive non-zero float []byte source": optionalScanTC[[]byte, float64]{
-			src:           []byte("123.456"),
+		"given non-empty int Optional with inverted range": clampTC[int]{
+			opt:           Of(-123),
+			lo:            100,
+			hi:            0,
 			expectPresent: true,
-			expectValue:   123.456,
-		},
-		"on empty float64 Optional given positive non-zero float []byte source that exceeds max float64": optionalScanTC[[]byte, float64]{
-			src:         []byte(maxFloat64String + "0"),
-			expectError: true,
-		},
-		"on empty float64 Optional given non-float []byte source": optionalScanTC[[]byte, float64]{
-			src:         []byte("abc"),
-			expectError: true,
-		},
-		"on empty *float64 Optional given empty []byte source": optionalScanTC[[]byte, *float64]{
-			src:         []byte{},
-			expectError: true,
+			expectValue:   0,
 		},
-		"on empty *float64 Optional given zero float []byte source": optionalScanTC[[]byte, *float64]{
-			src:           []byte("0"),
+		"given non-empty float64 Optional with value above range": clampTC[float64]{
+			opt:           Of(1.5),
+			lo:            0,
+			hi:            1,
 			expectPresent: true,
-			expectValue:   ptrs.ZeroFloat64(),
+			expectValue:   1,
 		},
-		"on empty *float64 Optional given negative float []byte source": optionalScanTC[[]byte, *float64]{
-			src:           []byte("-123.456"),
-			expectPresent: true,
-			expectValue:   ptrs.Float64(-123.456),
+	})
+}
+
+func BenchmarkCollectMap(b *testing.B) {
+	m := map[string]Optional[int]{"a": Of(0), "b": Of(123), "c": Of(-123)}
+	for i := 0; i < b.N; i++ {
+		_ = CollectMap(m)
+	}
+}
+
+type collectMapTC[K comparable, V any] struct {
+	m      map[K]Optional[V]
+	expect Optional[map[K]V]
+	test.Control
+}
+
+func (tc collectMapTC[K, V]) Test(t *testing.T) {
+	actual := CollectMap(tc.m)
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestCollectMap(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil map": collectMapTC[string, int]{
+			m:      nil,
+			expect: Of(map[string]int{}),
 		},
-		"on empty *float64 Optional given positive float []byte source": optionalScanTC[[]byte, *float64]{
-			src:           []byte("123.456"),
-			expectPresent: true,
-			expectValue:   ptrs.Float64(123.456),
+		"given map of int Optionals with all present": collectMapTC[string, int]{
+			m:      map[string]Optional[int]{"a": Of(0), "b": Of(123), "c": Of(-123)},
+			expect: Of(map[string]int{"a": 0, "b": 123, "c": -123}),
 		},
-		"on empty *float64 Optional given non-float []byte source": optionalScanTC[[]byte, *float64]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given map of int Optionals with one empty": collectMapTC[string, int]{
+			m:      map[string]Optional[int]{"a": Of(0), "b": Empty[int](), "c": Of(-123)},
+			expect: Empty[map[string]int](),
 		},
-		"on empty Float64 Optional given float []byte source": optionalScanTC[[]byte, Float64]{
-			src:           []byte("123.456"),
-			expectPresent: true,
-			expectValue:   123.456,
+		"given map of string Optionals with all present": collectMapTC[string, string]{
+			m:      map[string]Optional[string]{"a": Of(""), "b": Of("abc")},
+			expect: Of(map[string]string{"a": "", "b": "abc"}),
 		},
-		"on empty *Float64 Optional given float []byte source": optionalScanTC[[]byte, *Float64]{
-			src:           []byte("123.456"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Float64](123.456),
+		"given map of string Optionals with one empty": collectMapTC[string, string]{
+			m:      map[string]Optional[string]{"a": Empty[string](), "b": Of("abc")},
+			expect: Empty[map[string]string](),
 		},
-		"on empty int Optional given empty []byte source": optionalScanTC[[]byte, int]{
-			src:         []byte{},
-			expectError: true,
+		// Other test cases...
+		"given empty map": collectMapTC[string, int]{
+			m:      map[string]Optional[int]{},
+			expect: Of(map[string]int{}),
 		},
-		"on empty int Optional given zero int []byte source": optionalScanTC[[]byte, int]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		"given map of int Optionals with all empty": collectMapTC[int, int]{
+			m:      map[int]Optional[int]{1: Empty[int](), 2: Empty[int]()},
+			expect: Empty[map[int]int](),
 		},
-		"on empty int Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   -123,
+	})
+}
+
+func BenchmarkCollectResults(b *testing.B) {
+	values := []int{0, 123, -123}
+	errs := []error{nil, errors.New("failed"), nil}
+	for i := 0; i < b.N; i++ {
+		_ = CollectResults(values, errs)
+	}
+}
+
+type collectResultsTC[T any] struct {
+	values []T
+	errs   []error
+	expect []Optional[T]
+	test.Control
+}
+
+func (tc collectResultsTC[T]) Test(t *testing.T) {
+	actual := CollectResults(tc.values, tc.errs)
+	assert.Equal(t, tc.expect, actual, "unexpected Optionals")
+}
+
+func TestCollectResults(t *testing.T) {
+	err := errors.New("failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil ints and nil errors": collectResultsTC[int]{
+			expect: nil,
 		},
-		"on empty int Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int]{
-			src:         []byte("-123.456"),
-			expectError: true,
+		"given ints and errors of same length": collectResultsTC[int]{
+			values: []int{0, 123, -123, 0},
+			errs:   []error{nil, err, nil, err},
+			expect: []Optional[int]{Of(0), Empty[int](), Of(-123), Empty[int]()},
 		},
-		"on empty int Optional given negative non-zero int []byte source that exceeds min int": optionalScanTC[[]byte, int]{
-			src:         []byte(minInt64String + "0"),
-			expectError: true,
+		"given ints and fewer errors": collectResultsTC[int]{
+			values: []int{0, 123, -123},
+			errs:   []error{err},
+			expect: []Optional[int]{Empty[int](), Of(123), Of(-123)},
 		},
-		"on empty int Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given ints and more errors": collectResultsTC[int]{
+			values: []int{0, 123},
+			errs:   []error{nil, nil, err},
+			expect: []Optional[int]{Of(0), Of(123)},
 		},
-		"on empty int Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int]{
-			src:         []byte("123.456"),
-			expectError: true,
+		"given strings and errors of same length": collectResultsTC[string]{
+			values: []string{"", "abc", "ABC"},
+			errs:   []error{err, nil, nil},
+			expect: []Optional[string]{Empty[string](), Of("abc"), Of("ABC")},
 		},
-		"on empty int Optional given positive non-zero int []byte source that exceeds max int": optionalScanTC[[]byte, int]{
-			src:         []byte(maxInt64String + "0"),
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkCompare(b *testing.B) {
+	x := Of(123)
+	y := Of(-123)
+	for i := 0; i < b.N; i++ {
+		Compare(x, y)
+	}
+}
+
+type compareTC[T cmp.Ordered] struct {
+	x      Optional[T]
+	y      Optional[T]
+	expect int
+	test.Control
+}
+
+func (tc compareTC[T]) Test(t *testing.T) {
+	actual := Compare(tc.x, tc.y)
+	assert.Equal(t, tc.expect, actual, "unexpected comparison result")
+}
+
+func TestCompare(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional and non-empty int Optional with zero value": compareTC[int]{
+			x:      Empty[int](),
+			y:      Of(0),
+			expect: -1,
 		},
-		"on empty int Optional given non-int []byte source": optionalScanTC[[]byte, int]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty int Optional with zero value and non-empty int Optional with positive non-zero value": compareTC[int]{
+			x:      Of(0),
+			y:      Of(123),
+			expect: -1,
 		},
-		"on empty *int Optional given empty []byte source": optionalScanTC[[]byte, *int]{
-			src:         []byte{},
-			expectError: true,
+		"given two empty int Optionals": compareTC[int]{
+			x:      Empty[int](),
+			y:      Empty[int](),
+			expect: 0,
 		},
-		"on empty *int Optional given zero int []byte source": optionalScanTC[[]byte, *int]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt(),
+		"given two non-empty int Optionals with zero values": compareTC[int]{
+			x:      Of(0),
+			y:      Of(0),
+			expect: 0,
 		},
-		"on empty *int Optional given negative int []byte source": optionalScanTC[[]byte, *int]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int(-123),
+		"given two non-empty int Optionals with same non-zero values": compareTC[int]{
+			x:      Of(123),
+			y:      Of(123),
+			expect: 0,
 		},
-		"on empty *int Optional given positive int []byte source": optionalScanTC[[]byte, *int]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int(123),
+		"given non-empty int Optional with zero value and empty int Optional": compareTC[int]{
+			x:      Of(0),
+			y:      Empty[int](),
+			expect: 1,
 		},
-		"on empty *int Optional given non-int []byte source": optionalScanTC[[]byte, *int]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty int Optional with positive non-zero value and non-empty int Optional with zero value": compareTC[int]{
+			x:      Of(123),
+			y:      Of(0),
+			expect: 1,
 		},
-		"on empty Int Optional given int []byte source": optionalScanTC[[]byte, Int]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		// Other test cases...
+	})
+}
+
+func BenchmarkCompareSlices(b *testing.B) {
+	x := []Optional[int]{Of(123), Empty[int](), Of(-123)}
+	y := []Optional[int]{Of(123), Empty[int](), Of(123)}
+	for i := 0; i < b.N; i++ {
+		CompareSlices(x, y)
+	}
+}
+
+type compareSlicesTC[T cmp.Ordered] struct {
+	x      []Optional[T]
+	y      []Optional[T]
+	expect int
+	test.Control
+}
+
+func (tc compareSlicesTC[T]) Test(t *testing.T) {
+	actual := CompareSlices(tc.x, tc.y)
+	assert.Equal(t, tc.expect, actual, "unexpected comparison result")
+}
+
+func TestCompareSlices(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given two nil int Optional slices": compareSlicesTC[int]{
+			expect: 0,
 		},
-		"on empty *Int Optional given int []byte source": optionalScanTC[[]byte, *Int]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int](123),
+		"given int Optional slices with equal elements": compareSlicesTC[int]{
+			x:      []Optional[int]{Of(0), Empty[int](), Of(123)},
+			y:      []Optional[int]{Of(0), Empty[int](), Of(123)},
+			expect: 0,
 		},
-		"on empty int8 Optional given empty []byte source": optionalScanTC[[]byte, int8]{
-			src:         []byte{},
-			expectError: true,
+		"given int Optional slices with empty element before non-empty element": compareSlicesTC[int]{
+			x:      []Optional[int]{Of(0), Empty[int](), Of(123)},
+			y:      []Optional[int]{Of(0), Of(0), Of(-123)},
+			expect: -1,
 		},
-		"on empty int8 Optional given zero int []byte source": optionalScanTC[[]byte, int8]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		"given int Optional slices with non-empty element before empty element": compareSlicesTC[int]{
+			x:      []Optional[int]{Of(0), Of(0), Of(-123)},
+			y:      []Optional[int]{Of(0), Empty[int](), Of(123)},
+			expect: 1,
 		},
-		"on empty int8 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int8]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   -123,
+		"given int Optional slices with lesser last element": compareSlicesTC[int]{
+			x:      []Optional[int]{Empty[int](), Of(0)},
+			y:      []Optional[int]{Empty[int](), Of(123)},
+			expect: -1,
 		},
-		"on empty int8 Optional given negative non-zero int string []byte that contains floating points": optionalScanTC[[]byte, int8]{
-			src:         []byte("-123.456"),
-			expectError: true,
+		"given shorter int Optional slice with common prefix": compareSlicesTC[int]{
+			x:      []Optional[int]{Of(0), Empty[int]()},
+			y:      []Optional[int]{Of(0), Empty[int](), Empty[int]()},
+			expect: -1,
 		},
-		"on empty int8 Optional given negative non-zero int string []byte that exceeds min int8": optionalScanTC[[]byte, int8]{
-			src:         []byte(minInt64String),
-			expectError: true,
+		"given longer int Optional slice with common prefix": compareSlicesTC[int]{
+			x:      []Optional[int]{Of(0), Empty[int](), Empty[int]()},
+			y:      []Optional[int]{Of(0), Empty[int]()},
+			expect: 1,
 		},
-		"on empty int8 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int8]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given string Optional slices with equal elements": compareSlicesTC[string]{
+			x:      []Optional[string]{Of(""), Empty[string](), Of("abc")},
+			y:      []Optional[string]{Of(""), Empty[string](), Of("abc")},
+			expect: 0,
 		},
-		"on empty int8 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int8]{
-			src:         []byte("123.456"),
-			expectError: true,
+		"given string Optional slices with empty element before non-empty element": compareSlicesTC[string]{
+			x:      []Optional[string]{Empty[string](), Of("abc")},
+			y:      []Optional[string]{Of(""), Empty[string]()},
+			expect: -1,
 		},
-		"on empty int8 Optional given positive non-zero int []byte source that exceeds max int8": optionalScanTC[[]byte, int8]{
-			src:         []byte(maxInt64String),
-			expectError: true,
+		"given shorter string Optional slice with common prefix": compareSlicesTC[string]{
+			x:      []Optional[string]{Of("abc")},
+			y:      []Optional[string]{Of("abc"), Empty[string]()},
+			expect: -1,
 		},
-		"on empty int8 Optional given non-int []byte source": optionalScanTC[[]byte, int8]{
-			src:         []byte("abc"),
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkConcatPresent(b *testing.B) {
+	opts := []Optional[[]int]{Of([]int{0, 123}), Empty[[]int](), Of([]int{-123})}
+	for i := 0; i < b.N; i++ {
+		_ = ConcatPresent(opts...)
+	}
+}
+
+type concatPresentTC[T any] struct {
+	opts   []Optional[[]T]
+	expect []T
+	test.Control
+}
+
+func (tc concatPresentTC[T]) Test(t *testing.T) {
+	actual := ConcatPresent(tc.opts...)
+	assert.Equal(t, tc.expect, actual, "unexpected values")
+}
+
+func TestConcatPresent(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no Optionals": concatPresentTC[int]{
+			opts:   nil,
+			expect: nil,
 		},
-		"on empty *int8 Optional given empty []byte source": optionalScanTC[[]byte, *int8]{
-			src:         []byte{},
-			expectError: true,
+		"given only empty []int Optionals": concatPresentTC[int]{
+			opts:   []Optional[[]int]{Empty[[]int](), Empty[[]int]()},
+			expect: nil,
 		},
-		"on empty *int8 Optional given zero int []byte source": optionalScanTC[[]byte, *int8]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt8(),
+		"given non-empty []int Optionals with empty Optional between them": concatPresentTC[int]{
+			opts:   []Optional[[]int]{Of([]int{0, 123}), Empty[[]int](), Of([]int{-123})},
+			expect: []int{0, 123, -123},
 		},
-		"on empty *int8 Optional given negative int []byte source": optionalScanTC[[]byte, *int8]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int8(-123),
+		"given non-empty []string Optionals with empty Optional between them": concatPresentTC[string]{
+			opts:   []Optional[[]string]{Of([]string{"abc"}), Empty[[]string](), Of([]string{"", "def"})},
+			expect: []string{"abc", "", "def"},
 		},
-		"on empty *int8 Optional given positive int []byte source": optionalScanTC[[]byte, *int8]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int8(123),
+		// Other test cases...
+		"given non-empty []int Optional with nil value": concatPresentTC[int]{
+			opts:   []Optional[[]int]{Of[[]int](nil)},
+			expect: nil,
 		},
-		"on empty *int8 Optional given non-int []byte source": optionalScanTC[[]byte, *int8]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty []int Optionals with nil value between them": concatPresentTC[int]{
+			opts:   []Optional[[]int]{Of([]int{1}), Of[[]int](nil), Of([]int{}), Of([]int{2, 3})},
+			expect: []int{1, 2, 3},
 		},
-		"on empty Int8 Optional given int []byte source": optionalScanTC[[]byte, Int8]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+	})
+}
+
+func TestConcatPresent_doesNotModifyValues(t *testing.T) {
+	first := make([]int, 1, 4)
+	first[0] = 1
+	second := []int{2, 3}
+
+	actual := ConcatPresent(Of(first), Of(second))
+	actual[0] = 0
+
+	assert.Equal(t, []int{1, 0, 0, 0}, first[:cap(first)], "unexpected first slice")
+	assert.Equal(t, []int{2, 3}, second, "unexpected second slice")
+}
+
+func BenchmarkCountDistinct(b *testing.B) {
+	opts := []Optional[int]{Empty[int](), Of(0), Of(123), Of(123)}
+	for i := 0; i < b.N; i++ {
+		_ = CountDistinct(opts...)
+	}
+}
+
+type countDistinctTC[T comparable] struct {
+	opts   []Optional[T]
+	expect int
+	test.Control
+}
+
+func (tc countDistinctTC[T]) Test(t *testing.T) {
+	count := CountDistinct(tc.opts...)
+	assert.Equal(t, tc.expect, count, "unexpected count")
+}
+
+func TestCountDistinct(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no int Optionals": countDistinctTC[int]{
+			expect: 0,
 		},
-		"on empty *Int8 Optional given int []byte source": optionalScanTC[[]byte, *Int8]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int8](123),
+		"given empty int Optional": countDistinctTC[int]{
+			opts:   []Optional[int]{Empty[int]()},
+			expect: 0,
 		},
-		"on empty int16 Optional given empty []byte source": optionalScanTC[[]byte, int16]{
-			src:         []byte{},
-			expectError: true,
+		"given empty and non-empty int Optionals with duplicate values": countDistinctTC[int]{
+			opts: []Optional[int]{
+				Empty[int](),
+				Of(0),
+				Of(123),
+				Of(123),
+				Empty[int](),
+				Of(0),
+			},
+			expect: 2,
 		},
-		"on empty int16 Optional given zero int []byte source": optionalScanTC[[]byte, int16]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		"given no string Optionals": countDistinctTC[string]{
+			expect: 0,
 		},
-		"on empty int16 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int16]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   -123,
+		"given empty string Optional": countDistinctTC[string]{
+			opts:   []Optional[string]{Empty[string]()},
+			expect: 0,
 		},
-		"on empty int16 Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int16]{
-			src:         []byte("-123.456"),
-			expectError: true,
+		"given empty and non-empty string Optionals with duplicate values": countDistinctTC[string]{
+			opts: []Optional[string]{
+				Empty[string](),
+				Of("abc"),
+				Of(""),
+				Of("abc"),
+				Of("def"),
+			},
+			expect: 3,
 		},
-		"on empty int16 Optional given negative non-zero int []byte source that exceeds min int16": optionalScanTC[[]byte, int16]{
-			src:         []byte(minInt64String),
-			expectError: true,
+		// Other test cases...
+		"given multiple empty int Optionals": countDistinctTC[int]{
+			opts:   []Optional[int]{Empty[int](), Empty[int](), Empty[int]()},
+			expect: 0,
 		},
-		"on empty int16 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+	})
+}
+
+func BenchmarkEmpty(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Empty[int]()
+	}
+}
+
+type emptyTC[T any] struct {
+	test.Control
+}
+
+func (tc emptyTC[T]) Test(t *testing.T) {
+	opt := Empty[T]()
+	value, present := opt.Get()
+	assert.Zero(t, value, "expected zero value")
+	assert.False(t, present, "expected emptiness")
+}
+
+func TestEmpty(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"with int":    emptyTC[int]{},
+		"with string": emptyTC[string]{},
+		// Other test cases...
+	})
+}
+
+func BenchmarkEqual(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Equal(Of(123), Of(123))
+	}
+}
+
+type equalTC[T1 any, T2 any] struct {
+	opt1   Optional[T1]
+	opt2   Optional[T2]
+	expect bool
+	test.Control
+}
+
+func (tc equalTC[T1, T2]) Test(t *testing.T) {
+	actual := Equal(tc.opt1, tc.opt2)
+	assert.Equal(t, tc.expect, actual, "unexpected equality")
+}
+
+func TestEqual(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional and empty int Optional": equalTC[int, int]{
+			opt1:   Empty[int](),
+			opt2:   Empty[int](),
+			expect: true,
 		},
-		"on empty int16 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int16]{
-			src:         []byte("123.456"),
-			expectError: true,
+		"given empty int Optional and non-empty int Optional with zero value": equalTC[int, int]{
+			opt1:   Empty[int](),
+			opt2:   Of(0),
+			expect: false,
 		},
-		"on empty int16 Optional given positive non-zero int []byte source that exceeds max int16": optionalScanTC[[]byte, int16]{
-			src:         []byte(maxInt64String),
-			expectError: true,
+		"given non-empty int Optional with zero value and empty int Optional": equalTC[int, int]{
+			opt1:   Of(0),
+			opt2:   Empty[int](),
+			expect: false,
 		},
-		"on empty int16 Optional given non-int []byte source": optionalScanTC[[]byte, int16]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty int Optional with zero value and non-empty int Optional with zero value": equalTC[int, int]{
+			opt1:   Of(0),
+			opt2:   Of(0),
+			expect: true,
 		},
-		"on empty *int16 Optional given empty []byte source": optionalScanTC[[]byte, *int16]{
-			src:         []byte{},
-			expectError: true,
+		"given non-empty int Optional with zero value and non-empty int Optional with non-zero value": equalTC[int, int]{
+			opt1:   Of(0),
+			opt2:   Of(123),
+			expect: false,
 		},
-		"on empty *int16 Optional given zero int []byte source": optionalScanTC[[]byte, *int16]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt16(),
+		"given non-empty int Optional with non-zero value and non-empty int Optional with zero value": equalTC[int, int]{
+			opt1:   Of(123),
+			opt2:   Of(0),
+			expect: false,
 		},
-		"on empty *int16 Optional given negative int []byte source": optionalScanTC[[]byte, *int16]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int16(-123),
+		"given non-empty int Optional with non-zero value and non-empty int Optional with equal non-zero value": equalTC[int, int]{
+			opt1:   Of(123),
+			opt2:   Of(123),
+			expect: true,
 		},
-		"on empty *int16 Optional given positive int []byte source": optionalScanTC[[]byte, *int16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int16(123),
+		"given non-empty int Optional with non-zero value and non-empty int Optional with similar but not equal non-zero value": equalTC[int, int]{
+			opt1:   Of(123),
+			opt2:   Of(-123),
+			expect: false,
 		},
-		"on empty *int16 Optional given non-int []byte source": optionalScanTC[[]byte, *int16]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty int Optional with non-zero value and empty int Optional": equalTC[int, int]{
+			opt1:   Of(123),
+			opt2:   Empty[int](),
+			expect: false,
 		},
-		"on empty Int16 Optional given int []byte source": optionalScanTC[[]byte, Int16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given empty any Optional and empty int Optional": equalTC[any, int]{
+			opt1:   Empty[any](),
+			opt2:   Empty[int](),
+			expect: true,
 		},
-		"on empty *Int16 Optional given int []byte source": optionalScanTC[[]byte, *Int16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int16](123),
+		"given empty any Optional and non-empty int Optional with zero value": equalTC[any, int]{
+			opt1:   Empty[any](),
+			opt2:   Of(0),
+			expect: false,
 		},
-		"on empty int32 Optional given empty []byte source": optionalScanTC[[]byte, int32]{
-			src:         []byte{},
-			expectError: true,
+		"given non-empty any Optional with zero int value and non-empty int Optional with zero value": equalTC[any, int]{
+			opt1:   Of[any](0),
+			opt2:   Of(0),
+			expect: true,
 		},
-		"on empty int32 Optional given zero int []byte source": optionalScanTC[[]byte, int32]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		"given non-empty any Optional with non-zero int value and non-empty int Optional with equal non-zero value": equalTC[any, int]{
+			opt1:   Of[any](123),
+			opt2:   Of(123),
+			expect: true,
 		},
-		"on empty int32 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int32]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   -123,
+		"given non-empty any Optional with zero int value and non-empty string Optional with similar but not equal non-zero value": equalTC[any, string]{
+			opt1:   Of[any](0),
+			opt2:   Of("0"),
+			expect: false,
 		},
-		"on empty int32 Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int32]{
-			src:         []byte("-123.456"),
-			expectError: true,
+		"given empty string Optional and empty string Optional": equalTC[string, string]{
+			opt1:   Empty[string](),
+			opt2:   Empty[string](),
+			expect: true,
 		},
-		"on empty int32 Optional given negative non-zero int []byte source that exceeds min int32": optionalScanTC[[]byte, int32]{
-			src:         []byte(minInt64String),
-			expectError: true,
+		"given empty string Optional and non-empty string Optional with zero value": equalTC[string, string]{
+			opt1:   Empty[string](),
+			opt2:   Of(""),
+			expect: false,
 		},
-		"on empty int32 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given non-empty string Optional and zero value given empty string Optional": equalTC[string, string]{
+			opt1:   Of(""),
+			opt2:   Empty[string](),
+			expect: false,
 		},
-		"on empty int32 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int32]{
-			src:         []byte("123.456"),
-			expectError: true,
+		"given non-empty string Optional with zero value and non-empty string Optional with zero value": equalTC[string, string]{
+			opt1:   Of(""),
+			opt2:   Of(""),
+			expect: true,
 		},
-		"on empty int32 Optional given positive non-zero int []byte source that exceeds max int32": optionalScanTC[[]byte, int32]{
-			src:         []byte(maxInt64String),
-			expectError: true,
+		"given non-empty string Optional with zero value and non-empty string Optional with non-zero value": equalTC[string, string]{
+			opt1:   Of(""),
+			opt2:   Of("abc"),
+			expect: false,
 		},
-		"on empty int32 Optional given non-int []byte source": optionalScanTC[[]byte, int32]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty string Optional with non-zero value and non-empty string Optional with zero value": equalTC[string, string]{
+			opt1:   Of("abc"),
+			opt2:   Of(""),
+			expect: false,
 		},
-		"on empty *int32 Optional given empty []byte source": optionalScanTC[[]byte, *int32]{
-			src:         []byte{},
-			expectError: true,
+		"given non-empty string Optional with non-zero value and non-empty string Optional with equal non-zero value": equalTC[string, string]{
+			opt1:   Of("abc"),
+			opt2:   Of("abc"),
+			expect: true,
 		},
-		"on empty *int32 Optional given []byte int string source": optionalScanTC[[]byte, *int32]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt32(),
+		"given non-empty string Optional with non-zero value and non-empty string Optional with similar but not equal non-zero value": equalTC[string, string]{
+			opt1:   Of("abc"),
+			opt2:   Of("ABC"),
+			expect: false,
 		},
-		"on empty *int32 Optional given negative int []byte source": optionalScanTC[[]byte, *int32]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int32(-123),
+		"given non-empty string Optional with non-zero value and empty string Optional": equalTC[string, string]{
+			opt1:   Of("abc"),
+			opt2:   Empty[string](),
+			expect: false,
 		},
-		"on empty *int32 Optional given positive int []byte source": optionalScanTC[[]byte, *int32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int32(123),
+		// Other test cases...
+	})
+}
+
+func BenchmarkEqualDeep(b *testing.B) {
+	x := Of([]int{1, 2, 3})
+	y := Of([]int{1, 2, 3})
+	for i := 0; i < b.N; i++ {
+		EqualDeep(x, y)
+	}
+}
+
+type equalDeepTC[T any] struct {
+	x      Optional[T]
+	y      Optional[T]
+	expect bool
+	test.Control
+}
+
+func (tc equalDeepTC[T]) Test(t *testing.T) {
+	actual := EqualDeep(tc.x, tc.y)
+	assert.Equal(t, tc.expect, actual, "unexpected equality")
+}
+
+func TestEqualDeep(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given two empty int slice Optionals": equalDeepTC[[]int]{
+			x:      Empty[[]int](),
+			y:      Empty[[]int](),
+			expect: true,
 		},
-		"on empty *int32 Optional given non-int []byte source": optionalScanTC[[]byte, *int32]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given empty int slice Optional and non-empty int slice Optional with nil value": equalDeepTC[[]int]{
+			x:      Empty[[]int](),
+			y:      Of([]int(nil)),
+			expect: false,
 		},
-		"on empty Int32 Optional given int []byte source": optionalScanTC[[]byte, Int32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given non-empty int slice Optional with nil value and empty int slice Optional": equalDeepTC[[]int]{
+			x:      Of([]int(nil)),
+			y:      Empty[[]int](),
+			expect: false,
 		},
-		"on empty *Int32 Optional given int []byte source": optionalScanTC[[]byte, *Int32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int32](123),
+		"given two non-empty int slice Optionals with nil values": equalDeepTC[[]int]{
+			x:      Of([]int(nil)),
+			y:      Of([]int(nil)),
+			expect: true,
 		},
-		"on empty int64 Optional given empty []byte source": optionalScanTC[[]byte, int64]{
-			src:         []byte{},
-			expectError: true,
+		"given two non-empty int slice Optionals with equal but distinct values": equalDeepTC[[]int]{
+			x:      Of([]int{1, 2, 3}),
+			y:      Of([]int{1, 2, 3}),
+			expect: true,
 		},
-		"on empty int64 Optional given zero int []byte source": optionalScanTC[[]byte, int64]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		"given two non-empty int slice Optionals with different values": equalDeepTC[[]int]{
+			x:      Of([]int{1, 2, 3}),
+			y:      Of([]int{3, 2, 1}),
+			expect: false,
 		},
-		"on empty int64 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, int64]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   -123,
+		"given two non-empty int slice Optionals with values of different lengths": equalDeepTC[[]int]{
+			x:      Of([]int{1, 2, 3}),
+			y:      Of([]int{1, 2}),
+			expect: false,
 		},
-		"on empty int64 Optional given negative non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int64]{
-			src:         []byte("-123.456"),
-			expectError: true,
+		"given two non-empty string map Optionals with equal but distinct values": equalDeepTC[map[string]string]{
+			x:      Of(map[string]string{"abc": "def"}),
+			y:      Of(map[string]string{"abc": "def"}),
+			expect: true,
 		},
-		"on empty int64 Optional given negative non-zero int []byte source that exceeds min int64": optionalScanTC[[]byte, int64]{
-			src:         []byte(minInt64String + "0"),
-			expectError: true,
+		"given two non-empty string map Optionals with different values": equalDeepTC[map[string]string]{
+			x:      Of(map[string]string{"abc": "def"}),
+			y:      Of(map[string]string{"abc": "DEF"}),
+			expect: false,
 		},
-		"on empty int64 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, int64]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		// Other test cases...
+	})
+}
+
+func BenchmarkEqualFloat(b *testing.B) {
+	x := Of(0.1 + 0.2)
+	y := Of(0.3)
+	for i := 0; i < b.N; i++ {
+		EqualFloat(x, y, 1e-9)
+	}
+}
+
+type equalFloatTC[T ~float32 | ~float64] struct {
+	x       Optional[T]
+	y       Optional[T]
+	epsilon T
+	expect  bool
+	test.Control
+}
+
+func (tc equalFloatTC[T]) Test(t *testing.T) {
+	actual := EqualFloat(tc.x, tc.y, tc.epsilon)
+	assert.Equal(t, tc.expect, actual, "unexpected equality")
+}
+
+func TestEqualFloat(t *testing.T) {
+	type Float64 float64
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty float64 Optionals": equalFloatTC[float64]{
+			x:       Empty[float64](),
+			y:       Empty[float64](),
+			epsilon: 0.01,
+			expect:  true,
+		},
+		"given empty and non-empty float64 Optionals": equalFloatTC[float64]{
+			x:       Empty[float64](),
+			y:       Of(0.0),
+			epsilon: 0.01,
+			expect:  false,
+		},
+		"given non-empty and empty float64 Optionals": equalFloatTC[float64]{
+			x:       Of(0.0),
+			y:       Empty[float64](),
+			epsilon: 0.01,
+			expect:  false,
+		},
+		"given non-empty float64 Optionals with values within epsilon": equalFloatTC[float64]{
+			x:       Of(1.0),
+			y:       Of(1.005),
+			epsilon: 0.01,
+			expect:  true,
+		},
+		"given non-empty float64 Optionals with values outside epsilon": equalFloatTC[float64]{
+			x:       Of(1.0),
+			y:       Of(1.02),
+			epsilon: 0.01,
+			expect:  false,
+		},
+		"given non-empty float64 Optionals with NaN values": equalFloatTC[float64]{
+			x:       Of(math.NaN()),
+			y:       Of(math.NaN()),
+			epsilon: 0.01,
+			expect:  true,
+		},
+		"given non-empty float64 Optionals with NaN and non-NaN values": equalFloatTC[float64]{
+			x:       Of(math.NaN()),
+			y:       Of(0.0),
+			epsilon: math.Inf(1),
+			expect:  false,
 		},
-		"on empty int64 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, int64]{
-			src:         []byte("123.456"),
-			expectError: true,
+		// Other test cases...
+		"given non-empty float64 Optionals with equal values and zero epsilon": equalFloatTC[float64]{
+			x:      Of(1.5),
+			y:      Of(1.5),
+			expect: true,
 		},
-		"on empty int64 Optional given positive non-zero int []byte source that exceeds max int64": optionalScanTC[[]byte, int64]{
-			src:         []byte(maxInt64String + "0"),
-			expectError: true,
+		"given non-empty float64 Optionals with values differing by rounding error": equalFloatTC[float64]{
+			x:       Of(0.1 + 0.2),
+			y:       Of(0.3),
+			epsilon: 1e-9,
+			expect:  true,
+		},
+		"given non-empty float64 Optionals with values differing by exactly epsilon": equalFloatTC[float64]{
+			x:       Of(1.0),
+			y:       Of(1.5),
+			epsilon: 0.5,
+			expect:  true,
+		},
+		"given non-empty float64 Optionals with negative and positive zero values": equalFloatTC[float64]{
+			x:      Of(math.Copysign(0, -1)),
+			y:      Of(0.0),
+			expect: true,
 		},
-		"on empty int64 Optional given non-int []byte source": optionalScanTC[[]byte, int64]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty float64 Optionals with equal infinite values": equalFloatTC[float64]{
+			x:      Of(math.Inf(1)),
+			y:      Of(math.Inf(1)),
+			expect: true,
 		},
-		"on empty *int64 Optional given empty []byte source": optionalScanTC[[]byte, *int64]{
-			src:         []byte{},
-			expectError: true,
+		"given non-empty float64 Optionals with opposite infinite values": equalFloatTC[float64]{
+			x:       Of(math.Inf(1)),
+			y:       Of(math.Inf(-1)),
+			epsilon: math.Inf(1),
+			expect:  false,
 		},
-		"on empty *int64 Optional given zero int []byte source": optionalScanTC[[]byte, *int64]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroInt64(),
+		"given non-empty float32 Optionals with values within epsilon": equalFloatTC[float32]{
+			x:       Of[float32](1.0),
+			y:       Of[float32](1.005),
+			epsilon: 0.01,
+			expect:  true,
 		},
-		"on empty *int64 Optional given negative int []byte source": optionalScanTC[[]byte, *int64]{
-			src:           []byte("-123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int64(-123),
+		"given non-empty Float64 Optionals with values outside epsilon": equalFloatTC[Float64]{
+			x:       Of[Float64](1.0),
+			y:       Of[Float64](-1.0),
+			epsilon: 0.01,
+			expect:  false,
 		},
-		"on empty *int64 Optional given positive int []byte source": optionalScanTC[[]byte, *int64]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Int64(123),
+	})
+}
+
+type fieldFillRateRecord struct {
+	Age  Optional[int]
+	Name Optional[string]
+}
+
+func BenchmarkFieldFillRate(b *testing.B) {
+	records := []fieldFillRateRecord{{Age: Of(30)}, {}, {Age: Of(0)}, {}}
+	field := func(record fieldFillRateRecord) Optional[int] {
+		return record.Age
+	}
+	for i := 0; i < b.N; i++ {
+		_ = FieldFillRate(records, field)
+	}
+}
+
+type fieldFillRateTC[T any] struct {
+	records         []fieldFillRateRecord
+	field           func(record fieldFillRateRecord) Optional[T]
+	expect          float64
+	expectCallCount int
+	test.Control
+}
+
+func (tc fieldFillRateTC[T]) Test(t *testing.T) {
+	var callCount int
+	actual := FieldFillRate(tc.records, func(record fieldFillRateRecord) Optional[T] {
+		callCount++
+		return tc.field(record)
+	})
+	assert.Equal(t, tc.expect, actual, "unexpected rate")
+	assert.Equalf(t, tc.expectCallCount, callCount, "expected function to be called %v times", tc.expectCallCount)
+}
+
+func TestFieldFillRate(t *testing.T) {
+	ageField := func(record fieldFillRateRecord) Optional[int] {
+		return record.Age
+	}
+	nameField := func(record fieldFillRateRecord) Optional[string] {
+		return record.Name
+	}
+	records := []fieldFillRateRecord{
+		{Age: Of(30), Name: Of("Alice")},
+		{Name: Of("")},
+		{Age: Of(0), Name: Of("Carol")},
+		{Name: Of("Dave")},
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil records": fieldFillRateTC[int]{
+			records: nil,
+			field:   ageField,
+			expect:  0,
+		},
+		"given records with mix of present and empty int fields": fieldFillRateTC[int]{
+			records:         records,
+			field:           ageField,
+			expect:          0.5,
+			expectCallCount: 4,
+		},
+		"given records with all present string fields": fieldFillRateTC[string]{
+			records:         records,
+			field:           nameField,
+			expect:          1,
+			expectCallCount: 4,
 		},
-		"on empty *int64 Optional given non-int []byte source": optionalScanTC[[]byte, *int64]{
-			src:         []byte("abc"),
-			expectError: true,
+		// Other test cases...
+		"given empty records": fieldFillRateTC[int]{
+			records: []fieldFillRateRecord{},
+			field:   ageField,
+			expect:  0,
+		},
+		"given records with all empty int fields": fieldFillRateTC[int]{
+			records:         []fieldFillRateRecord{{}, {}, {}},
+			field:           ageField,
+			expect:          0,
+			expectCallCount: 3,
+		},
+		"given records with one of three present int fields": fieldFillRateTC[int]{
+			records:         []fieldFillRateRecord{{}, {Age: Of(1)}, {}},
+			field:           ageField,
+			expect:          1.0 / 3,
+			expectCallCount: 3,
 		},
-		"on empty Int64 Optional given int []byte source": optionalScanTC[[]byte, Int64]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+	})
+}
+
+func BenchmarkFilterMapSlice(b *testing.B) {
+	isNonNegative := func(value int) bool {
+		return value >= 0
+	}
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	opts := []Optional[int]{Of(123), Empty[int](), Of(-123)}
+	for i := 0; i < b.N; i++ {
+		_ = FilterMapSlice(opts, isNonNegative, toString)
+	}
+}
+
+type filterMapSliceTC[T, M any] struct {
+	opts                     []Optional[T]
+	keep                     func(value T) bool
+	transform                func(value T) M
+	expect                   []Optional[M]
+	expectKeepCallCount      uint
+	expectTransformCallCount uint
+	test.Control
+}
+
+func (tc filterMapSliceTC[T, M]) Test(t *testing.T) {
+	var keepCallCount, transformCallCount uint
+	actual := FilterMapSlice(tc.opts, func(value T) bool {
+		keepCallCount++
+		return tc.keep(value)
+	}, func(value T) M {
+		transformCallCount++
+		return tc.transform(value)
+	})
+	assert.Equal(t, tc.expect, actual, "unexpected Optionals")
+	assert.Equalf(t, tc.expectKeepCallCount, keepCallCount, "expected keep function to be called %v times", tc.expectKeepCallCount)
+	assert.Equalf(t, tc.expectTransformCallCount, transformCallCount, "expected transform function to be called %v times", tc.expectTransformCallCount)
+}
+
+func TestFilterMapSlice(t *testing.T) {
+	isNonNegative := func(value int) bool {
+		return value >= 0
+	}
+	isNotEmpty := func(value string) bool {
+		return value != ""
+	}
+	toLength := func(value string) int {
+		return len(value)
+	}
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil int Optionals": filterMapSliceTC[int, string]{
+			opts:      nil,
+			keep:      isNonNegative,
+			transform: toString,
+			expect:    nil,
+		},
+		"given int Optionals with negative values and interleaved empty Optionals": filterMapSliceTC[int, string]{
+			opts:                     []Optional[int]{Of(-1), Empty[int](), Of(0), Of(-123), Of(123), Empty[int]()},
+			keep:                     isNonNegative,
+			transform:                toString,
+			expect:                   []Optional[string]{Empty[string](), Empty[string](), Of("0"), Empty[string](), Of("123"), Empty[string]()},
+			expectKeepCallCount:      4,
+			expectTransformCallCount: 2,
+		},
+		"given only empty int Optionals": filterMapSliceTC[int, string]{
+			opts:      []Optional[int]{Empty[int](), Empty[int]()},
+			keep:      isNonNegative,
+			transform: toString,
+			expect:    []Optional[string]{Empty[string](), Empty[string]()},
+		},
+		"given string Optionals with zero values": filterMapSliceTC[string, int]{
+			opts:                     []Optional[string]{Of(""), Of("abc"), Empty[string](), Of("de")},
+			keep:                     isNotEmpty,
+			transform:                toLength,
+			expect:                   []Optional[int]{Empty[int](), Of(3), Empty[int](), Of(2)},
+			expectKeepCallCount:      3,
+			expectTransformCallCount: 2,
 		},
-		"on empty *Int64 Optional given int []byte source": optionalScanTC[[]byte, *Int64]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Int64](123),
+		// Other test cases...
+		"given empty int Optionals slice": filterMapSliceTC[int, string]{
+			opts:      []Optional[int]{},
+			keep:      isNonNegative,
+			transform: toString,
+			expect:    []Optional[string]{},
+		},
+		"given int Optionals with only negative values": filterMapSliceTC[int, string]{
+			opts:                []Optional[int]{Of(-1), Of(-2)},
+			keep:                isNonNegative,
+			transform:           toString,
+			expect:              []Optional[string]{Empty[string](), Empty[string]()},
+			expectKeepCallCount: 2,
 		},
-		"on empty string Optional given empty []byte source": optionalScanTC[[]byte, string]{
-			src:           []byte{},
-			expectPresent: true,
-			expectValue:   "",
+	})
+}
+
+func BenchmarkFilterPresent(b *testing.B) {
+	opts := []Optional[int]{Empty[int](), Of(0), Of(123)}
+	for i := 0; i < b.N; i++ {
+		_ = FilterPresent(opts)
+	}
+}
+
+type filterPresentTC[T any] struct {
+	opts   []Optional[T]
+	expect []Optional[T]
+	test.Control
+}
+
+func (tc filterPresentTC[T]) Test(t *testing.T) {
+	filtered := FilterPresent(tc.opts)
+	assert.Equal(t, tc.expect, filtered, "unexpected Optionals")
+}
+
+func TestFilterPresent(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil int Optional slice": filterPresentTC[int]{
+			opts:   nil,
+			expect: nil,
 		},
-		"on empty string Optional given non-empty []byte source": optionalScanTC[[]byte, string]{
-			src:           []byte("abc"),
-			expectPresent: true,
-			expectValue:   "abc",
+		"given empty int Optional": filterPresentTC[int]{
+			opts:   []Optional[int]{Empty[int]()},
+			expect: nil,
 		},
-		"on empty *string Optional given empty []byte source": optionalScanTC[[]byte, *string]{
-			src:           []byte{},
-			expectPresent: true,
-			expectValue:   ptrs.ZeroString(),
+		"given empty and non-empty int Optionals": filterPresentTC[int]{
+			opts:   []Optional[int]{Of(123), Empty[int](), Of(0), Empty[int](), Of(456)},
+			expect: []Optional[int]{Of(123), Of(0), Of(456)},
 		},
-		"on empty *string Optional given non-empty []byte source": optionalScanTC[[]byte, *string]{
-			src:           []byte("abc"),
-			expectPresent: true,
-			expectValue:   ptrs.String("abc"),
+		"given nil string Optional slice": filterPresentTC[string]{
+			opts:   nil,
+			expect: nil,
 		},
-		"on empty String Optional given non-empty []byte source": optionalScanTC[[]byte, String]{
-			src:           []byte("abc"),
-			expectPresent: true,
-			expectValue:   "abc",
+		"given empty string Optional": filterPresentTC[string]{
+			opts:   []Optional[string]{Empty[string]()},
+			expect: nil,
 		},
-		"on empty *String Optional given non-empty []byte source": optionalScanTC[[]byte, *String]{
-			src:           []byte("abc"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[String]("abc"),
+		"given empty and non-empty string Optionals": filterPresentTC[string]{
+			opts:   []Optional[string]{Empty[string](), Of("abc"), Of(""), Empty[string]()},
+			expect: []Optional[string]{Of("abc"), Of("")},
 		},
-		"on empty uint Optional given empty []byte source": optionalScanTC[[]byte, uint]{
-			src:         []byte{},
-			expectError: true,
+		// Other test cases...
+		"given multiple empty int Optionals": filterPresentTC[int]{
+			opts:   []Optional[int]{Empty[int](), Empty[int]()},
+			expect: nil,
 		},
-		"on empty uint Optional given zero int []byte source": optionalScanTC[[]byte, uint]{
-			src:           []byte("0"),
-			expectPresent: true,
+	})
+}
+
+func BenchmarkFind(b *testing.B) {
+	opts := []Optional[int]{Empty[int](), Empty[int](), Of(123)}
+	for i := 0; i < b.N; i++ {
+		_ = Find(opts...)
+	}
+}
+
+type findTC[T any] struct {
+	opts          []Optional[T]
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc findTC[T]) Test(t *testing.T) {
+	opt := Find(tc.opts...)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestFind(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no int Optionals": findTC[int]{
+			expectPresent: false,
 			expectValue:   0,
 		},
-		"on empty uint Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint]{
-			src:         []byte("-123"),
-			expectError: true,
+		"given empty int Optional": findTC[int]{
+			opts:          []Optional[int]{Empty[int]()},
+			expectPresent: false,
+			expectValue:   0,
 		},
-		"on empty uint Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint]{
-			src:           []byte("123"),
+		"given an empty int Optional and two non-empty int Optionals": findTC[int]{
+			opts: []Optional[int]{
+				Empty[int](),
+				Of(0),
+				Of(123),
+			},
 			expectPresent: true,
-			expectValue:   123,
-		},
-		"on empty uint Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint]{
-			src:         []byte("123.456"),
-			expectError: true,
-		},
-		"on empty uint Optional given positive non-zero int []byte source that exceeds max uint": optionalScanTC[[]byte, uint]{
-			src:         []byte(maxUint64String + "0"),
-			expectError: true,
-		},
-		"on empty uint Optional given non-int []byte source": optionalScanTC[[]byte, uint]{
-			src:         []byte("abc"),
-			expectError: true,
+			expectValue:   0,
 		},
-		"on empty *uint Optional given empty []byte source": optionalScanTC[[]byte, *uint]{
-			src:         []byte{},
-			expectError: true,
+		"given no string Optionals": findTC[string]{
+			expectPresent: false,
+			expectValue:   "",
 		},
-		"on empty *uint Optional given zero int []byte source": optionalScanTC[[]byte, *uint]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint(),
+		"given empty string Optional": findTC[string]{
+			opts:          []Optional[string]{Empty[string]()},
+			expectPresent: false,
+			expectValue:   "",
 		},
-		"on empty *uint Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint]{
-			src:           []byte("123"),
+		"given an empty string Optional and two non-empty string Optionals": findTC[string]{
+			opts: []Optional[string]{
+				Empty[string](),
+				Of("abc"),
+				Of(""),
+			},
 			expectPresent: true,
-			expectValue:   ptrs.Uint(123),
+			expectValue:   "abc",
 		},
-		"on empty *uint Optional given non-int []byte source": optionalScanTC[[]byte, *uint]{
-			src:         []byte("abc"),
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	toString := func(value int) Optional[string] {
+		if value == 0 {
+			return Empty[string]()
+		}
+		return Of(strconv.FormatInt(int64(value), 10))
+	}
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = FlatMap(opt, toString)
+	}
+}
+
+type flatMapTC[T, M any] struct {
+	opt           Optional[T]
+	fn            func(value T) Optional[M]
+	expectPresent bool
+	expectValue   M
+	test.Control
+}
+
+func (tc flatMapTC[T, M]) Test(t *testing.T) {
+	opt := FlatMap(tc.opt, tc.fn)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestFlatMap(t *testing.T) {
+	toInt := func(value string) Optional[int] {
+		if value == "" {
+			return Empty[int]()
+		}
+		i, err := strconv.ParseInt(value, 10, 0)
+		if err != nil {
+			panic(err)
+		}
+		return OfZeroable(int(i))
+	}
+	toString := func(value int) Optional[string] {
+		if value == 0 {
+			return Empty[string]()
+		}
+		return Of(strconv.FormatInt(int64(value), 10))
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": flatMapTC[int, string]{
+			opt:           Empty[int](),
+			fn:            toString,
+			expectPresent: false,
 		},
-		"on empty Uint Optional given int []byte source": optionalScanTC[[]byte, Uint]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given non-empty int Optional with zero value": flatMapTC[int, string]{
+			opt:           Of(0),
+			fn:            toString,
+			expectPresent: false,
 		},
-		"on empty *Uint Optional given int []byte source": optionalScanTC[[]byte, *Uint]{
-			src:           []byte("123"),
+		"given non-empty int Optional with non-zero value": flatMapTC[int, string]{
+			opt:           Of(123),
+			fn:            toString,
 			expectPresent: true,
-			expectValue:   ptrs.Value[Uint](123),
+			expectValue:   "123",
 		},
-		"on empty uint8 Optional given empty []byte source": optionalScanTC[[]byte, uint8]{
-			src:         []byte{},
-			expectError: true,
+		"given empty string Optional": flatMapTC[string, int]{
+			opt:           Empty[string](),
+			fn:            toInt,
+			expectPresent: false,
 		},
-		"on empty uint8 Optional given zero int []byte source": optionalScanTC[[]byte, uint8]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		"given non-empty string Optional with zero value": flatMapTC[string, int]{
+			opt:           Of(""),
+			fn:            toInt,
+			expectPresent: false,
 		},
-		"on empty uint8 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint8]{
-			src:         []byte("-123"),
-			expectError: true,
+		"given non-empty string Optional with zero-representing value": flatMapTC[string, int]{
+			opt:           Of("0"),
+			fn:            toInt,
+			expectPresent: false,
 		},
-		"on empty uint8 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint8]{
-			src:           []byte("123"),
+		"given non-empty string Optional with non-zero-representing value": flatMapTC[string, int]{
+			opt:           Of("123"),
+			fn:            toInt,
 			expectPresent: true,
 			expectValue:   123,
 		},
-		"on empty uint8 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint8]{
-			src:         []byte("123.456"),
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkFromProtoPtr(b *testing.B) {
+	p := ptrs.Int(123)
+	for i := 0; i < b.N; i++ {
+		_ = FromProtoPtr(p)
+	}
+}
+
+type fromProtoPtrTC[T any] struct {
+	p             *T
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc fromProtoPtrTC[T]) Test(t *testing.T) {
+	opt := FromProtoPtr(tc.p)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestFromProtoPtr(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil int pointer": fromProtoPtrTC[int]{
+			p:             nil,
+			expectPresent: false,
 		},
-		"on empty uint8 Optional given positive non-zero int []byte source that exceeds max uint8": optionalScanTC[[]byte, uint8]{
-			src:         []byte(maxUint64String),
-			expectError: true,
+		"given zero int pointer": fromProtoPtrTC[int]{
+			p:             ptrs.ZeroInt(),
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"on empty uint8 Optional given non-int []byte source": optionalScanTC[[]byte, uint8]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-zero int pointer": fromProtoPtrTC[int]{
+			p:             ptrs.Int(123),
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"on empty *uint8 Optional given empty []byte source": optionalScanTC[[]byte, *uint8]{
-			src:         []byte{},
-			expectError: true,
+		"given nil string pointer": fromProtoPtrTC[string]{
+			p:             nil,
+			expectPresent: false,
 		},
-		"on empty *uint8 Optional given zero int []byte source": optionalScanTC[[]byte, *uint8]{
-			src:           []byte("0"),
+		"given zero string pointer": fromProtoPtrTC[string]{
+			p:             ptrs.ZeroString(),
 			expectPresent: true,
-			expectValue:   ptrs.ZeroUint8(),
+			expectValue:   "",
 		},
-		"on empty *uint8 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint8]{
-			src:           []byte("123"),
+		"given non-zero string pointer": fromProtoPtrTC[string]{
+			p:             ptrs.String("abc"),
 			expectPresent: true,
-			expectValue:   ptrs.Uint8(123),
+			expectValue:   "abc",
 		},
-		"on empty *uint8 Optional given non-int []byte source": optionalScanTC[[]byte, *uint8]{
-			src:         []byte("abc"),
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkFuncMap(b *testing.B) {
+	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(`{{ orElse . 0 }}`))
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		if err := tmpl.Execute(io.Discard, opt); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type funcMapTC struct {
+	text        string
+	data        any
+	expectError bool
+	expect      string
+	test.Control
+}
+
+func (tc funcMapTC) Test(t *testing.T) {
+	tmpl, err := template.New("").Funcs(FuncMap()).Parse(tc.text)
+	if !assert.NoError(t, err, "unexpected error parsing template") {
+		return
+	}
+	var sb strings.Builder
+	err = tmpl.Execute(&sb, tc.data)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+		assert.Equal(t, tc.expect, sb.String(), "unexpected output")
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given getOrZero on empty int Optional": funcMapTC{
+			text:   `{{ getOrZero . }}`,
+			data:   Empty[int](),
+			expect: "0",
 		},
-		"on empty Uint8 Optional given int []byte source": optionalScanTC[[]byte, Uint8]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given getOrZero on non-empty int Optional": funcMapTC{
+			text:   `{{ getOrZero . }}`,
+			data:   Of(123),
+			expect: "123",
 		},
-		"on empty *Uint8 Optional given int []byte source": optionalScanTC[[]byte, *Uint8]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint8](123),
+		"given getOrZero on empty string Optional": funcMapTC{
+			text:   `{{ printf "%q" (getOrZero .) }}`,
+			data:   Empty[string](),
+			expect: `""`,
 		},
-		"on empty uint16 Optional given empty []byte source": optionalScanTC[[]byte, uint16]{
-			src:         []byte{},
-			expectError: true,
+		"given getOrZero on non-empty string Optional": funcMapTC{
+			text:   `{{ getOrZero . }}`,
+			data:   Of("abc"),
+			expect: "abc",
 		},
-		"on empty uint16 Optional given zero int []byte source": optionalScanTC[[]byte, uint16]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+		"given isPresent on empty int Optional": funcMapTC{
+			text:   `{{ isPresent . }}`,
+			data:   Empty[int](),
+			expect: "false",
+		},
+		"given isPresent on non-empty int Optional with zero value": funcMapTC{
+			text:   `{{ isPresent . }}`,
+			data:   Of(0),
+			expect: "true",
+		},
+		"given isPresent on empty string Optional": funcMapTC{
+			text:   `{{ if isPresent . }}present{{ else }}empty{{ end }}`,
+			data:   Empty[string](),
+			expect: "empty",
+		},
+		"given isPresent on non-empty string Optional": funcMapTC{
+			text:   `{{ if isPresent . }}present{{ else }}empty{{ end }}`,
+			data:   Of("abc"),
+			expect: "present",
+		},
+		"given orElse on empty int Optional": funcMapTC{
+			text:   `{{ orElse . 456 }}`,
+			data:   Empty[int](),
+			expect: "456",
+		},
+		"given orElse on non-empty int Optional": funcMapTC{
+			text:   `{{ orElse . 456 }}`,
+			data:   Of(123),
+			expect: "123",
 		},
-		"on empty uint16 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint16]{
-			src:         []byte("-123"),
-			expectError: true,
+		"given orElse on empty string Optional": funcMapTC{
+			text:   `{{ orElse . "def" }}`,
+			data:   Empty[string](),
+			expect: "def",
 		},
-		"on empty uint16 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given orElse on non-empty string Optional": funcMapTC{
+			text:   `{{ orElse . "def" }}`,
+			data:   Of("abc"),
+			expect: "abc",
 		},
-		"on empty uint16 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint16]{
-			src:         []byte("123.456"),
-			expectError: true,
+		// Other test cases...
+		"given orElse on struct fields of different Optional types": funcMapTC{
+			text: `{{ orElse .Age 0 }} {{ orElse .Name "unknown" }}`,
+			data: struct {
+				Age  Optional[int]
+				Name Optional[string]
+			}{Age: Of(42)},
+			expect: "42 unknown",
+		},
+		"given isPresent on non-empty int Optional pointer": funcMapTC{
+			text:   `{{ isPresent . }}`,
+			data:   ptrs.Value(Of(123)),
+			expect: "true",
+		},
+		"given getOrZero on nil int Optional pointer": funcMapTC{
+			text:   `{{ getOrZero . }}`,
+			data:   (*Optional[int])(nil),
+			expect: "0",
 		},
-		"on empty uint16 Optional given positive non-zero int []byte source that exceeds max uint16": optionalScanTC[[]byte, uint16]{
-			src:         []byte(maxUint64String),
+		"given getOrZero on nil": funcMapTC{
+			text:        `{{ getOrZero . }}`,
+			data:        nil,
 			expectError: true,
 		},
-		"on empty uint16 Optional given non-int []byte source": optionalScanTC[[]byte, uint16]{
-			src:         []byte("abc"),
+		"given isPresent on unsupported type": funcMapTC{
+			text:        `{{ isPresent . }}`,
+			data:        123,
 			expectError: true,
 		},
-		"on empty *uint16 Optional given zero []byte source": optionalScanTC[[]byte, *uint16]{
-			src:         []byte{},
+		"given orElse on unsupported type": funcMapTC{
+			text:        `{{ orElse . 456 }}`,
+			data:        "abc",
 			expectError: true,
 		},
-		"on empty *uint16 Optional given zero int []byte source": optionalScanTC[[]byte, *uint16]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint16(),
-		},
-		"on empty *uint16 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Uint16(123),
-		},
-		"on empty *uint16 Optional given non-int []byte source": optionalScanTC[[]byte, *uint16]{
-			src:         []byte("abc"),
+		"given getOrZero on ReadOnly": funcMapTC{
+			text:        `{{ getOrZero . }}`,
+			data:        NewReadOnly(Of(123)),
 			expectError: true,
 		},
-		"on empty Uint16 Optional given int []byte source": optionalScanTC[[]byte, Uint16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
-		},
-		"on empty *Uint16 Optional given int []byte source": optionalScanTC[[]byte, *Uint16]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint16](123),
+		"given isPresent on pointer to Tracked": funcMapTC{
+			text:        `{{ isPresent . }}`,
+			data:        &Tracked[int]{},
+			expectError: true,
 		},
-		"on empty uint32 Optional given empty []byte source": optionalScanTC[[]byte, uint32]{
-			src:         []byte{},
+		"given orElse on nil pointer to ReadOnly": funcMapTC{
+			text:        `{{ orElse . 456 }}`,
+			data:        (*ReadOnly[int])(nil),
 			expectError: true,
 		},
-		"on empty uint32 Optional given zero int []byte source": optionalScanTC[[]byte, uint32]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   0,
+	})
+}
+
+func BenchmarkGetAny(b *testing.B) {
+	opts := []Optional[int]{Empty[int](), Of(0), Of(123)}
+	for i := 0; i < b.N; i++ {
+		_ = GetAny(opts...)
+	}
+}
+
+type getAnyTC[T any] struct {
+	opts   []Optional[T]
+	expect []T
+	test.Control
+}
+
+func (tc getAnyTC[T]) Test(t *testing.T) {
+	actual := GetAny(tc.opts...)
+	assert.Equal(t, tc.expect, actual, "unexpected values")
+}
+
+func TestGetAny(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no int Optionals": getAnyTC[int]{
+			expect: nil,
 		},
-		"on empty uint32 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint32]{
-			src:         []byte("-123"),
-			expectError: true,
+		"given empty int Optional": getAnyTC[int]{
+			opts:   []Optional[int]{Empty[int]()},
+			expect: nil,
 		},
-		"on empty uint32 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given an empty int Optional and two non-empty int Optionals": getAnyTC[int]{
+			opts: []Optional[int]{
+				Empty[int](),
+				Of(0),
+				Of(123),
+			},
+			expect: []int{0, 123},
 		},
-		"on empty uint32 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint32]{
-			src:         []byte("123.456"),
-			expectError: true,
+		"given no string Optionals": getAnyTC[string]{
+			expect: nil,
 		},
-		"on empty uint32 Optional given positive non-zero int []byte source that exceeds max uint32": optionalScanTC[[]byte, uint32]{
-			src:         []byte(maxUint64String),
-			expectError: true,
+		"given empty string Optional": getAnyTC[string]{
+			opts:   []Optional[string]{Empty[string]()},
+			expect: nil,
 		},
-		"on empty uint32 Optional given non-int []byte source": optionalScanTC[[]byte, uint32]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given an empty string Optional and two non-empty string Optionals": getAnyTC[string]{
+			opts: []Optional[string]{
+				Empty[string](),
+				Of("abc"),
+				Of(""),
+			},
+			expect: []string{"abc", ""},
 		},
-		"on empty *uint32 Optional given empty []byte source": optionalScanTC[[]byte, *uint32]{
-			src:         []byte{},
-			expectError: true,
+		// Other test cases...
+	})
+}
+
+func BenchmarkHighestPriority(b *testing.B) {
+	type item = struct {
+		Priority int
+		Opt      Optional[int]
+	}
+	items := []item{{Priority: 1, Opt: Of(123)}, {Priority: 3, Opt: Empty[int]()}, {Priority: 2, Opt: Of(456)}}
+	for i := 0; i < b.N; i++ {
+		_ = HighestPriority(items...)
+	}
+}
+
+type highestPriorityTC[T any] struct {
+	items []struct {
+		Priority int
+		Opt      Optional[T]
+	}
+	expect Optional[T]
+	test.Control
+}
+
+func (tc highestPriorityTC[T]) Test(t *testing.T) {
+	actual := HighestPriority(tc.items...)
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestHighestPriority(t *testing.T) {
+	type (
+		intItem = struct {
+			Priority int
+			Opt      Optional[int]
+		}
+		stringItem = struct {
+			Priority int
+			Opt      Optional[string]
+		}
+	)
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no items": highestPriorityTC[int]{
+			expect: Empty[int](),
 		},
-		"on empty *uint32 Optional given zero int []byte source": optionalScanTC[[]byte, *uint32]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint32(),
+		"given only empty int Optionals": highestPriorityTC[int]{
+			items:  []intItem{{Priority: 1, Opt: Empty[int]()}, {Priority: 2, Opt: Empty[int]()}},
+			expect: Empty[int](),
 		},
-		"on empty *uint32 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Uint32(123),
+		"given lower priority non-empty int Optional before higher priority one": highestPriorityTC[int]{
+			items:  []intItem{{Priority: 1, Opt: Of(123)}, {Priority: 2, Opt: Of(456)}},
+			expect: Of(456),
 		},
-		"on empty *uint32 Optional given non-int []byte source": optionalScanTC[[]byte, *uint32]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given higher priority non-empty int Optional before lower priority one": highestPriorityTC[int]{
+			items:  []intItem{{Priority: 2, Opt: Of(456)}, {Priority: 1, Opt: Of(123)}},
+			expect: Of(456),
 		},
-		"on empty Uint32 Optional given int []byte source": optionalScanTC[[]byte, Uint32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		"given highest priority empty int Optional": highestPriorityTC[int]{
+			items:  []intItem{{Priority: 1, Opt: Of(123)}, {Priority: 3, Opt: Empty[int]()}, {Priority: 2, Opt: Of(0)}},
+			expect: Of(0),
+		},
+		"given non-empty string Optionals with equal priority": highestPriorityTC[string]{
+			items:  []stringItem{{Priority: 1, Opt: Of("abc")}, {Priority: 1, Opt: Of("def")}},
+			expect: Of("abc"),
 		},
-		"on empty *Uint32 Optional given int []byte source": optionalScanTC[[]byte, *Uint32]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint32](123),
+		// Other test cases...
+		"given non-empty int Optionals with negative priorities": highestPriorityTC[int]{
+			items:  []intItem{{Priority: -2, Opt: Of(123)}, {Priority: -1, Opt: Of(456)}, {Priority: 0, Opt: Empty[int]()}},
+			expect: Of(456),
 		},
-		"on empty uint64 Optional given empty []byte source": optionalScanTC[[]byte, uint64]{
-			src:         []byte{},
-			expectError: true,
+		"given single non-empty string Optional": highestPriorityTC[string]{
+			items:  []stringItem{{Priority: 0, Opt: Of("")}},
+			expect: Of(""),
 		},
-		"on empty uint64 Optional given zero int []byte source": optionalScanTC[[]byte, uint64]{
-			src:           []byte("0"),
+	})
+}
+
+func BenchmarkIfChanged(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = IfChanged(opt, 456)
+	}
+}
+
+type ifChangedTC[T comparable] struct {
+	opt           Optional[T]
+	baseline      T
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc ifChangedTC[T]) Test(t *testing.T) {
+	opt := IfChanged(tc.opt, tc.baseline)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestIfChanged(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": ifChangedTC[int]{
+			opt:           Empty[int](),
+			baseline:      123,
+			expectPresent: false,
+		},
+		"given non-empty int Optional with value equal to baseline": ifChangedTC[int]{
+			opt:           Of(123),
+			baseline:      123,
+			expectPresent: false,
+		},
+		"given non-empty int Optional with value different to baseline": ifChangedTC[int]{
+			opt:           Of(0),
+			baseline:      123,
 			expectPresent: true,
 			expectValue:   0,
 		},
-		"on empty uint64 Optional given negative non-zero int []byte source": optionalScanTC[[]byte, uint64]{
-			src:         []byte("-123"),
-			expectError: true,
+		"given empty string Optional": ifChangedTC[string]{
+			opt:           Empty[string](),
+			baseline:      "abc",
+			expectPresent: false,
 		},
-		"on empty uint64 Optional given positive non-zero int []byte source": optionalScanTC[[]byte, uint64]{
-			src:           []byte("123"),
+		"given non-empty string Optional with value equal to baseline": ifChangedTC[string]{
+			opt:           Of("abc"),
+			baseline:      "abc",
+			expectPresent: false,
+		},
+		"given non-empty string Optional with value different to baseline": ifChangedTC[string]{
+			opt:           Of(""),
+			baseline:      "abc",
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   "",
 		},
-		"on empty uint64 Optional given positive non-zero int []byte source that contains floating points": optionalScanTC[[]byte, uint64]{
-			src:         []byte("123.456"),
-			expectError: true,
+		// Other test cases...
+		"given empty int Optional with zero baseline": ifChangedTC[int]{
+			opt:           Empty[int](),
+			baseline:      0,
+			expectPresent: false,
 		},
-		"on empty uint64 Optional given positive non-zero int []byte source that exceeds max uint": optionalScanTC[[]byte, uint64]{
-			src:         []byte(maxUint64String + "0"),
-			expectError: true,
+	})
+}
+
+func BenchmarkIndexBy(b *testing.B) {
+	opts := []Optional[string]{Empty[string](), Of("abc"), Of("de")}
+	keyFn := func(value string) int {
+		return len(value)
+	}
+	for i := 0; i < b.N; i++ {
+		_ = IndexBy(keyFn, opts...)
+	}
+}
+
+type indexByTC[T any, K comparable] struct {
+	keyFn  func(value T) K
+	opts   []Optional[T]
+	expect map[K]T
+	test.Control
+}
+
+func (tc indexByTC[T, K]) Test(t *testing.T) {
+	index := IndexBy(tc.keyFn, tc.opts...)
+	assert.Equal(t, tc.expect, index, "unexpected index")
+}
+
+func TestIndexBy(t *testing.T) {
+	strLen := func(value string) int {
+		return len(value)
+	}
+	mod10 := func(value int) int {
+		return value % 10
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no int Optionals": indexByTC[int, int]{
+			keyFn:  mod10,
+			expect: map[int]int{},
 		},
-		"on empty uint64 Optional given non-int []byte source": optionalScanTC[[]byte, uint64]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given empty int Optional": indexByTC[int, int]{
+			keyFn:  mod10,
+			opts:   []Optional[int]{Empty[int]()},
+			expect: map[int]int{},
 		},
-		"on empty *uint64 Optional given empty []byte source": optionalScanTC[[]byte, *uint64]{
-			src:         []byte{},
-			expectError: true,
+		"given empty and non-empty int Optionals with duplicate keys": indexByTC[int, int]{
+			keyFn: mod10,
+			opts: []Optional[int]{
+				Empty[int](),
+				Of(0),
+				Of(123),
+				Of(456),
+				Of(13),
+			},
+			expect: map[int]int{0: 0, 3: 13, 6: 456},
 		},
-		"on empty *uint64 Optional given zero int []byte source": optionalScanTC[[]byte, *uint64]{
-			src:           []byte("0"),
-			expectPresent: true,
-			expectValue:   ptrs.ZeroUint64(),
+		"given no string Optionals": indexByTC[string, int]{
+			keyFn:  strLen,
+			expect: map[int]string{},
 		},
-		"on empty *uint64 Optional given non-zero int []byte source": optionalScanTC[[]byte, *uint64]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Uint64(123),
+		"given empty string Optional": indexByTC[string, int]{
+			keyFn:  strLen,
+			opts:   []Optional[string]{Empty[string]()},
+			expect: map[int]string{},
 		},
-		"on empty *uint64 Optional given non-int []byte source": optionalScanTC[[]byte, *uint64]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given empty and non-empty string Optionals with duplicate keys": indexByTC[string, int]{
+			keyFn: strLen,
+			opts: []Optional[string]{
+				Empty[string](),
+				Of(""),
+				Of("abc"),
+				Of("de"),
+				Of("fgh"),
+			},
+			expect: map[int]string{0: "", 2: "de", 3: "fgh"},
 		},
-		"on empty Uint64 Optional given int []byte source": optionalScanTC[[]byte, Uint64]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   123,
+		// Other test cases...
+	})
+}
+
+func BenchmarkInvert(b *testing.B) {
+	opt := Empty[bool]()
+	for i := 0; i < b.N; i++ {
+		_ = Invert(opt, true)
+	}
+}
+
+type invertTC[T any] struct {
+	opt        Optional[T]
+	whenAbsent T
+	expect     Optional[T]
+	test.Control
+}
+
+func (tc invertTC[T]) Test(t *testing.T) {
+	actual := Invert(tc.opt, tc.whenAbsent)
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestInvert(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty bool Optional": invertTC[bool]{
+			opt:        Empty[bool](),
+			whenAbsent: true,
+			expect:     Of(true),
 		},
-		"on empty *Uint64 Optional given int []byte source": optionalScanTC[[]byte, *Uint64]{
-			src:           []byte("123"),
-			expectPresent: true,
-			expectValue:   ptrs.Value[Uint64](123),
+		"given non-empty bool Optional with zero value": invertTC[bool]{
+			opt:        Of(false),
+			whenAbsent: true,
+			expect:     Empty[bool](),
 		},
-		"on empty sql.RawBytes Optional given empty []byte source": optionalScanTC[[]byte, sql.RawBytes]{
-			src:           []byte{},
-			expectPresent: true,
-			expectValue:   sql.RawBytes{},
+		"given non-empty bool Optional with non-zero value": invertTC[bool]{
+			opt:        Of(true),
+			whenAbsent: true,
+			expect:     Empty[bool](),
 		},
-		"on empty sql.RawBytes Optional given non-empty []byte source": optionalScanTC[[]byte, sql.RawBytes]{
-			src:           []byte("abc"),
-			expectPresent: true,
-			expectValue:   sql.RawBytes("abc"),
+		// Other test cases...
+		"given empty int Optional": invertTC[int]{
+			opt:        Empty[int](),
+			whenAbsent: 123,
+			expect:     Of(123),
+		},
+		"given empty int Optional and zero whenAbsent": invertTC[int]{
+			opt:        Empty[int](),
+			whenAbsent: 0,
+			expect:     Of(0),
+		},
+		"given non-empty int Optional with non-zero value": invertTC[int]{
+			opt:        Of(123),
+			whenAbsent: 456,
+			expect:     Empty[int](),
+		},
+		"given empty string Optional": invertTC[string]{
+			opt:        Empty[string](),
+			whenAbsent: "abc",
+			expect:     Of("abc"),
+		},
+		"given non-empty string Optional with zero value": invertTC[string]{
+			opt:        Of(""),
+			whenAbsent: "abc",
+			expect:     Empty[string](),
 		},
-		"on empty any Optional given empty []byte source": optionalScanTC[[]byte, any]{
-			src:           []byte{},
-			expectPresent: true,
-			expectValue:   []byte{},
+	})
+}
+
+func BenchmarkJSONEqual(b *testing.B) {
+	x, y := Of(123), Of(123)
+	for i := 0; i < b.N; i++ {
+		if _, err := JSONEqual(x, y); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type jsonEqualTC[T any] struct {
+	x           Optional[T]
+	y           Optional[T]
+	expect      bool
+	expectError bool
+	test.Control
+}
+
+func (tc jsonEqualTC[T]) Test(t *testing.T) {
+	equal, err := JSONEqual(tc.x, tc.y)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	assert.Equal(t, tc.expect, equal, "unexpected equality")
+}
+
+func TestJSONEqual(t *testing.T) {
+	type Example struct {
+		Number int    `json:"number"`
+		Text   string `json:"-"`
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optionals": jsonEqualTC[int]{
+			x:      Empty[int](),
+			y:      Empty[int](),
+			expect: true,
 		},
-		"on empty any Optional given non-empty []byte source": optionalScanTC[[]byte, any]{
-			src:           []byte("abc"),
-			expectPresent: true,
-			expectValue:   []byte("abc"),
+		"given empty and non-empty int Optionals": jsonEqualTC[int]{
+			x:      Empty[int](),
+			y:      Of(0),
+			expect: false,
 		},
-		"on empty Optional of unsupported slice given non-empty []byte source": optionalScanTC[[]byte, []uintptr]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty int Optionals with equal values": jsonEqualTC[int]{
+			x:      Of(123),
+			y:      Of(123),
+			expect: true,
 		},
-		"on empty Optional of unsupported type given non-empty []byte source": optionalScanTC[[]byte, uintptr]{
-			src:         []byte("abc"),
-			expectError: true,
+		"given non-empty int Optionals with different values": jsonEqualTC[int]{
+			x:      Of(123),
+			y:      Of(456),
+			expect: false,
 		},
-		// Test cases for time.Time source
-		// Supported destination types (incl. pointers and convertible types):
-		// time.Time, string, []byte, sql.RawBytes, any
-		"on empty time.Time Optional given zero time.Time source": optionalScanTC[time.Time, time.Time]{
-			src:           time.Time{},
-			expectPresent: true,
-			expectValue:   time.Time{},
+		"given empty string Optionals": jsonEqualTC[string]{
+			x:      Empty[string](),
+			y:      Empty[string](),
+			expect: true,
 		},
-		"on empty time.Time Optional given non-zero time.Time source": optionalScanTC[time.Time, time.Time]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   timeNow,
+		"given empty and non-empty string Optionals": jsonEqualTC[string]{
+			x:      Empty[string](),
+			y:      Of(""),
+			expect: false,
 		},
-		"on empty *time.Time Optional given zero time.Time source": optionalScanTC[time.Time, *time.Time]{
-			src:           time.Time{},
-			expectPresent: true,
-			expectValue:   &time.Time{},
+		"given non-empty string Optionals with equal values": jsonEqualTC[string]{
+			x:      Of("abc"),
+			y:      Of("abc"),
+			expect: true,
 		},
-		"on empty *time.Time Optional given non-zero time.Time source": optionalScanTC[time.Time, *time.Time]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   ptrs.Value(timeNow),
+		"given non-empty string Optionals with different values": jsonEqualTC[string]{
+			x:      Of("abc"),
+			y:      Of("def"),
+			expect: false,
 		},
-		"on empty Time Optional given non-zero time.Time source": optionalScanTC[time.Time, Time]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   Time(timeNow),
+		// Other test cases...
+		"given non-empty struct Optionals with values differing only by ignored field": jsonEqualTC[Example]{
+			x:      Of(Example{Number: 123, Text: "abc"}),
+			y:      Of(Example{Number: 123, Text: "def"}),
+			expect: true,
 		},
-		"on empty *Time Optional given non-zero time.Time source": optionalScanTC[time.Time, *Time]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   ptrs.Value(Time(timeNow)),
+		"given non-empty struct Optionals with values differing by marshaled field": jsonEqualTC[Example]{
+			x:      Of(Example{Number: 123, Text: "abc"}),
+			y:      Of(Example{Number: 456, Text: "abc"}),
+			expect: false,
 		},
-		"on empty string Optional given zero time.Time source": optionalScanTC[time.Time, string]{
-			src:           time.Time{},
-			expectPresent: true,
-			expectValue:   timeZeroString,
+		"given non-empty float64 Optional with unsupported value": jsonEqualTC[float64]{
+			x:           Of(math.Inf(1)),
+			y:           Of(123.0),
+			expectError: true,
 		},
-		"on empty string Optional given non-zero time.Time source": optionalScanTC[time.Time, string]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   timeNowString,
+		"given non-empty float64 Optional and other with unsupported value": jsonEqualTC[float64]{
+			x:           Of(123.0),
+			y:           Of(math.NaN()),
+			expectError: true,
 		},
-		"on empty *string Optional given zero time.Time source": optionalScanTC[time.Time, *string]{
-			src:           time.Time{},
-			expectPresent: true,
-			expectValue:   ptrs.String(timeZeroString),
+	})
+}
+
+func BenchmarkLastOk(b *testing.B) {
+	type result = struct {
+		Value int
+		Err   error
+	}
+	results := []result{{Value: 123}, {Value: 456}, {Err: errors.New("failed")}}
+	for i := 0; i < b.N; i++ {
+		_ = LastOk(results...)
+	}
+}
+
+type lastOkTC[T any] struct {
+	results []struct {
+		Value T
+		Err   error
+	}
+	expect Optional[T]
+	test.Control
+}
+
+func (tc lastOkTC[T]) Test(t *testing.T) {
+	actual := LastOk(tc.results...)
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestLastOk(t *testing.T) {
+	type (
+		intResult = struct {
+			Value int
+			Err   error
+		}
+		stringResult = struct {
+			Value string
+			Err   error
+		}
+	)
+	err := errors.New("failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no int results": lastOkTC[int]{
+			expect: Empty[int](),
 		},
-		"on empty *string Optional given non-zero time.Time source": optionalScanTC[time.Time, *string]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   ptrs.String(timeNowString),
+		"given int results with last errored": lastOkTC[int]{
+			results: []intResult{{Value: 123}, {Value: 456}, {Value: 789, Err: err}},
+			expect:  Of(456),
 		},
-		"on empty String Optional given non-zero time.Time source": optionalScanTC[time.Time, String]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   String(timeNowString),
+		"given int results with all errored": lastOkTC[int]{
+			results: []intResult{{Value: 123, Err: err}, {Err: err}},
+			expect:  Empty[int](),
 		},
-		"on empty *String Optional given non-zero time.Time source": optionalScanTC[time.Time, *String]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   ptrs.Value(String(timeNowString)),
+		"given int results with last ok with zero value": lastOkTC[int]{
+			results: []intResult{{Value: 123}, {Value: 0}},
+			expect:  Of(0),
 		},
-		"on empty []byte Optional given zero time.Time source": optionalScanTC[time.Time, []byte]{
-			src:           time.Time{},
-			expectPresent: true,
-			expectValue:   []byte(timeZeroString),
+		"given string results with last errored": lastOkTC[string]{
+			results: []stringResult{{Value: "abc"}, {Err: err}},
+			expect:  Of("abc"),
 		},
-		"on empty []byte Optional given non-zero time.Time source": optionalScanTC[time.Time, []byte]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   []byte(timeNowString),
+		// Other test cases...
+		"given int results with only first ok": lastOkTC[int]{
+			results: []intResult{{Value: 123}, {Err: err}, {Err: err}},
+			expect:  Of(123),
 		},
-		"on empty Bytes Optional given non-zero time.Time source": optionalScanTC[time.Time, Bytes]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   Bytes(timeNowString),
+		"given int results with errored between ok": lastOkTC[int]{
+			results: []intResult{{Value: 123}, {Err: err}, {Value: 456}},
+			expect:  Of(456),
 		},
-		"on empty sql.RawBytes Optional given non-zero time.Time source": optionalScanTC[time.Time, sql.RawBytes]{
-			src:           timeNow,
-			expectPresent: true,
-			expectValue:   sql.RawBytes(timeNowString),
+	})
+}
+
+func BenchmarkLoadMap(b *testing.B) {
+	var m sync.Map
+	m.Store("abc", 123)
+	for i := 0; i < b.N; i++ {
+		_ = LoadMap[string, int](&m, "abc")
+	}
+}
+
+type loadMapTC[K comparable, V any] struct {
+	entries       map[K]any
+	key           K
+	expectPresent bool
+	expectValue   V
+	test.Control
+}
+
+func (tc loadMapTC[K, V]) Test(t *testing.T) {
+	var m sync.Map
+	for key, value := range tc.entries {
+		m.Store(key, value)
+	}
+	opt := LoadMap[K, V](&m, tc.key)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestLoadMap(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty map": loadMapTC[string, int]{
+			key:           "abc",
+			expectPresent: false,
 		},
-		"on empty any Optional given zero time.Time source": optionalScanTC[time.Time, any]{
-			src:           time.Time{},
+		"given map containing key with int zero value": loadMapTC[string, int]{
+			entries:       map[string]any{"abc": 0},
+			key:           "abc",
 			expectPresent: true,
-			expectValue:   time.Time{},
+			expectValue:   0,
 		},
-		"on empty any Optional given non-zero time.Time source": optionalScanTC[time.Time, any]{
-			src:           timeNow,
+		"given map containing key with int non-zero value": loadMapTC[string, int]{
+			entries:       map[string]any{"abc": 123},
+			key:           "abc",
 			expectPresent: true,
-			expectValue:   timeNow,
+			expectValue:   123,
 		},
-		"on empty Optional of unsupported slice given non-zero time.Time source": optionalScanTC[time.Time, []uintptr]{
-			src:         timeNow,
-			expectError: true,
+		"given map not containing key": loadMapTC[string, int]{
+			entries:       map[string]any{"abc": 123},
+			key:           "def",
+			expectPresent: false,
 		},
-		"on empty Optional of unsupported type given non-zero time.Time source": optionalScanTC[time.Time, uintptr]{
-			src:         timeNow,
-			expectError: true,
+		"given map containing key with string value for int": loadMapTC[string, int]{
+			entries:       map[string]any{"abc": "123"},
+			key:           "abc",
+			expectPresent: false,
 		},
-		"on empty sql.NullTime Optional given non-zero time.Time source": optionalScanTC[time.Time, sql.NullTime]{
-			src:           timeNow,
+		"given map containing key with string non-zero value": loadMapTC[string, string]{
+			entries:       map[string]any{"abc": "def"},
+			key:           "abc",
 			expectPresent: true,
-			expectValue:   sql.NullTime{Time: timeNow, Valid: true},
-		},
-		// Test cases for nil source
-		"on empty bool Optional given nil source": optionalScanTC[any, bool]{
-			src:           nil,
-			expectPresent: false,
+			expectValue:   "def",
 		},
-		"on empty *bool Optional given nil source": optionalScanTC[any, *bool]{
-			src:           nil,
+		// Other test cases...
+	})
+}
+
+func BenchmarkLookup(b *testing.B) {
+	m := map[string]int{"abc": 123}
+	for i := 0; i < b.N; i++ {
+		_ = Lookup(m, "abc")
+	}
+}
+
+type lookupTC[K comparable, V any] struct {
+	m             map[K]V
+	key           K
+	expectPresent bool
+	expectValue   V
+	test.Control
+}
+
+func (tc lookupTC[K, V]) Test(t *testing.T) {
+	opt := Lookup(tc.m, tc.key)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestLookup(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil map": lookupTC[string, int]{
+			m:             nil,
+			key:           "abc",
 			expectPresent: false,
 		},
-		"on empty float64 Optional given nil source": optionalScanTC[any, float64]{
-			src:           nil,
+		"given map and missing key": lookupTC[string, int]{
+			m:             map[string]int{"abc": 123, "def": 0},
+			key:           "ghi",
 			expectPresent: false,
 		},
-		"on empty *float64 Optional given nil source": optionalScanTC[any, *float64]{
-			src:           nil,
-			expectPresent: false,
+		"given map and key for zero value": lookupTC[string, int]{
+			m:             map[string]int{"abc": 123, "def": 0},
+			key:           "def",
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"on empty int64 Optional given nil source": optionalScanTC[any, int64]{
-			src:           nil,
-			expectPresent: false,
+		"given map and key for non-zero value": lookupTC[string, int]{
+			m:             map[string]int{"abc": 123, "def": 0},
+			key:           "abc",
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"on empty *int64 Optional given nil source": optionalScanTC[any, *int64]{
-			src:           nil,
-			expectPresent: false,
+		// Other test cases...
+		"given map and key for nil value": lookupTC[string, *int]{
+			m:             map[string]*int{"abc": nil},
+			key:           "abc",
+			expectPresent: true,
+			expectValue:   nil,
 		},
-		"on empty string Optional given nil source": optionalScanTC[any, string]{
-			src:           nil,
+	})
+}
+
+func BenchmarkMap(b *testing.B) {
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = Map(opt, toString)
+	}
+}
+
+type mapTC[T, M any] struct {
+	opt           Optional[T]
+	fn            func(value T) M
+	expectPresent bool
+	expectValue   M
+	test.Control
+}
+
+func (tc mapTC[T, M]) Test(t *testing.T) {
+	opt := Map(tc.opt, tc.fn)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestMap(t *testing.T) {
+	toInt := func(value string) int {
+		i, err := strconv.ParseInt(value, 10, 0)
+		if err != nil {
+			panic(err)
+		}
+		return int(i)
+	}
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": mapTC[int, string]{
+			opt:           Empty[int](),
+			fn:            toString,
 			expectPresent: false,
 		},
-		"on empty *string Optional given nil source": optionalScanTC[any, *string]{
-			src:           nil,
-			expectPresent: false,
+		"given non-empty int Optional with zero value": mapTC[int, string]{
+			opt:           Of(0),
+			fn:            toString,
+			expectPresent: true,
+			expectValue:   "0",
 		},
-		"on empty []byte Optional given nil source": optionalScanTC[any, []byte]{
-			src:           nil,
-			expectPresent: false,
+		"given non-empty int Optional with non-zero value": mapTC[int, string]{
+			opt:           Of(123),
+			fn:            toString,
+			expectPresent: true,
+			expectValue:   "123",
 		},
-		"on empty time.Time Optional given nil source": optionalScanTC[any, time.Time]{
-			src:           nil,
+		"given empty string Optional": mapTC[string, int]{
+			opt:           Empty[string](),
+			fn:            toInt,
 			expectPresent: false,
 		},
-		"on empty *time.Time Optional given nil source": optionalScanTC[any, *time.Time]{
-			src:           nil,
-			expectPresent: false,
+		"given non-empty string Optional with zero-representing value": mapTC[string, int]{
+			opt:           Of("0"),
+			fn:            toInt,
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"on empty any Optional given nil source": optionalScanTC[any, any]{
-			src:           nil,
-			expectPresent: false,
+		"given non-empty string Optional with non-zero-representing value": mapTC[string, int]{
+			opt:           Of("123"),
+			fn:            toInt,
+			expectPresent: true,
+			expectValue:   123,
 		},
+		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_String(b *testing.B) {
+func BenchmarkMapContext(b *testing.B) {
+	ctx := context.Background()
+	toString := func(_ context.Context, value int) (string, error) {
+		return strconv.FormatInt(int64(value), 10), nil
+	}
 	opt := Of(123)
 	for i := 0; i < b.N; i++ {
-		_ = opt.String()
+		if _, err := MapContext(ctx, opt, toString); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
 
-type optionalStringTC[T any] struct {
-	opt    Optional[T]
-	expect string
+type mapContextTC[T, M any] struct {
+	ctx           context.Context
+	opt           Optional[T]
+	fn            func(ctx context.Context, value T) (M, error)
+	expectError   bool
+	expectPresent bool
+	expectValue   M
 	test.Control
 }
 
-func (tc optionalStringTC[T]) Test(t *testing.T) {
-	value := tc.opt.String()
-	assert.Equal(t, tc.expect, value, "unexpected string representation")
+func (tc mapContextTC[T, M]) Test(t *testing.T) {
+	opt, err := MapContext(tc.ctx, tc.opt, tc.fn)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestOptional_String(t *testing.T) {
+func TestMapContext(t *testing.T) {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx := context.Background()
+	toInt := func(ctx context.Context, value string) (int, error) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		i, err := strconv.ParseInt(value, 10, 0)
+		return int(i), err
+	}
+	toString := func(ctx context.Context, value int) (string, error) {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(int64(value), 10), nil
+	}
+
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"on empty int Optional": optionalStringTC[int]{
-			opt:    Empty[int](),
-			expect: "<empty>",
+		"given empty int Optional": mapContextTC[int, string]{
+			ctx:           ctx,
+			opt:           Empty[int](),
+			fn:            toString,
+			expectPresent: false,
 		},
-		"on non-empty int Optional with zero value": optionalStringTC[int]{
-			opt:    Of(0),
-			expect: "0",
+		"given empty int Optional and cancelled context": mapContextTC[int, string]{
+			ctx:           cancelledCtx,
+			opt:           Empty[int](),
+			fn:            toString,
+			expectPresent: false,
+		},
+		"given non-empty int Optional with zero value": mapContextTC[int, string]{
+			ctx:           ctx,
+			opt:           Of(0),
+			fn:            toString,
+			expectPresent: true,
+			expectValue:   "0",
+		},
+		"given non-empty int Optional with non-zero value": mapContextTC[int, string]{
+			ctx:           ctx,
+			opt:           Of(123),
+			fn:            toString,
+			expectPresent: true,
+			expectValue:   "123",
 		},
-		"on non-empty int Optional with non-zero value": optionalStringTC[int]{
-			opt:    Of(123),
-			expect: "123",
+		"given non-empty int Optional and cancelled context": mapContextTC[int, string]{
+			ctx:         cancelledCtx,
+			opt:         Of(123),
+			fn:          toString,
+			expectError: true,
 		},
-		"on empty string Optional": optionalStringTC[string]{
-			opt:    Empty[string](),
-			expect: "<empty>",
+		"given empty string Optional": mapContextTC[string, int]{
+			ctx:           ctx,
+			opt:           Empty[string](),
+			fn:            toInt,
+			expectPresent: false,
 		},
-		"on non-empty string Optional with zero value": optionalStringTC[string]{
-			opt:    Of(""),
-			expect: "",
+		"given non-empty string Optional with non-zero-representing value": mapContextTC[string, int]{
+			ctx:           ctx,
+			opt:           Of("123"),
+			fn:            toInt,
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"on non-empty string Optional with non-zero value": optionalStringTC[string]{
-			opt:    Of("abc"),
-			expect: "abc",
+		"given non-empty string Optional with erroneous value": mapContextTC[string, int]{
+			ctx:         ctx,
+			opt:         Of("abc"),
+			fn:          toInt,
+			expectError: true,
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_UnmarshalJSON(b *testing.B) {
+func BenchmarkMapSlice(b *testing.B) {
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	opts := []Optional[int]{Of(123), Empty[int](), Of(-123)}
 	for i := 0; i < b.N; i++ {
-		var opt Optional[int]
-		if err := json.Unmarshal([]byte(`123`), &opt); err != nil {
-			b.Fatal(err)
-		}
+		_ = MapSlice(opts, toString)
 	}
 }
 
-type optionalUnmarshalJSONTC[T any] struct {
-	json   string
-	expect T
+type mapSliceTC[T, M any] struct {
+	opts            []Optional[T]
+	fn              func(value T) M
+	expect          []Optional[M]
+	expectCallCount uint
 	test.Control
 }
 
-func (tc optionalUnmarshalJSONTC[T]) Test(t *testing.T) {
-	var value T
-	err := json.Unmarshal([]byte(tc.json), &value)
-	assert.NoError(t, err, "unexpected error")
-	assert.Equal(t, tc.expect, value, "unexpected value")
+func (tc mapSliceTC[T, M]) Test(t *testing.T) {
+	var callCount uint
+	actual := MapSlice(tc.opts, func(value T) M {
+		callCount++
+		return tc.fn(value)
+	})
+	assert.Equal(t, tc.expect, actual, "unexpected Optionals")
+	assert.Equalf(t, tc.expectCallCount, callCount, "expected function to be called %v times", tc.expectCallCount)
 }
 
-func TestOptional_UnmarshalJSON(t *testing.T) {
-	type Example struct {
-		Int       Optional[int]     `json:"int"`
-		String    Optional[string]  `json:"string"`
-		IntPtr    *Optional[int]    `json:"intPtr"`
-		StringPtr *Optional[string] `json:"stringPtr"`
+func TestMapSlice(t *testing.T) {
+	toInt := func(value string) int {
+		i, err := strconv.ParseInt(value, 10, 0)
+		if err != nil {
+			panic(err)
+		}
+		return int(i)
+	}
+	toString := func(value int) string {
+		return strconv.FormatInt(int64(value), 10)
 	}
 
 	test.RunCases(t, test.Cases{
-		"on empty int Optional": optionalUnmarshalJSONTC[Optional[int]]{
-			json:   `null`,
-			expect: Of(0),
-		},
-		"on non-empty int Optional with zero value": optionalUnmarshalJSONTC[Optional[int]]{
-			json:   `0`,
-			expect: Of(0),
-		},
-		"on non-empty int Optional with non-zero value": optionalUnmarshalJSONTC[Optional[int]]{
-			json:   `123`,
-			expect: Of(123),
-		},
-		"on empty string Optional": optionalUnmarshalJSONTC[Optional[string]]{
-			json:   `null`,
-			expect: Of(""),
-		},
-		"on non-empty string Optional with zero value": optionalUnmarshalJSONTC[Optional[string]]{
-			json:   `""`,
-			expect: Of(""),
-		},
-		"on non-empty string Optional with non-zero value": optionalUnmarshalJSONTC[Optional[string]]{
-			json:   `"abc"`,
-			expect: Of("abc"),
+		// Test cases for documented examples
+		"given nil int Optionals": mapSliceTC[int, string]{
+			opts:            nil,
+			fn:              toString,
+			expect:          nil,
+			expectCallCount: 0,
 		},
-		"on struct with empty Optionals": optionalUnmarshalJSONTC[Example]{
-			json:   `{}`,
-			expect: Example{},
+		"given int Optionals with interleaved empty Optionals": mapSliceTC[int, string]{
+			opts:            []Optional[int]{Empty[int](), Of(0), Empty[int](), Of(123), Empty[int]()},
+			fn:              toString,
+			expect:          []Optional[string]{Empty[string](), Of("0"), Empty[string](), Of("123"), Empty[string]()},
+			expectCallCount: 2,
 		},
-		"on struct with non-empty Optionals and zero field values": optionalUnmarshalJSONTC[Example]{
-			json: `{"int":0,"string":"","intPtr":0,"stringPtr":""}`,
-			expect: Example{
-				Int:       Of(0),
-				String:    Of(""),
-				IntPtr:    ptrs.Value(Of(0)),
-				StringPtr: ptrs.Value(Of("")),
-			},
+		"given only empty int Optionals": mapSliceTC[int, string]{
+			opts:            []Optional[int]{Empty[int](), Empty[int]()},
+			fn:              toString,
+			expect:          []Optional[string]{Empty[string](), Empty[string]()},
+			expectCallCount: 0,
 		},
-		"on struct with non-empty Optionals and non-zero field values": optionalUnmarshalJSONTC[Example]{
-			json: `{"int":123,"string":"abc","intPtr":123,"stringPtr":"abc"}`,
-			expect: Example{
-				Int:       Of(123),
-				String:    Of("abc"),
-				IntPtr:    ptrs.Value(Of(123)),
-				StringPtr: ptrs.Value(Of("abc")),
-			},
+		"given string Optionals with interleaved empty Optionals": mapSliceTC[string, int]{
+			opts:            []Optional[string]{Of("0"), Empty[string](), Of("123")},
+			fn:              toInt,
+			expect:          []Optional[int]{Of(0), Empty[int](), Of(123)},
+			expectCallCount: 2,
 		},
+		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_UnmarshalXML(b *testing.B) {
+func BenchmarkMergeMaps(b *testing.B) {
+	layers := []Optional[map[string]int]{
+		Of(map[string]int{"abc": 123, "def": 456}),
+		Empty[map[string]int](),
+		Of(map[string]int{"def": 789}),
+	}
 	for i := 0; i < b.N; i++ {
-		var opt Optional[int]
-		if err := xml.Unmarshal([]byte(`<int>123</int>`), &opt); err != nil {
-			b.Fatal(err)
-		}
+		_ = MergeMaps(layers...)
 	}
 }
 
-type optionalUnmarshalXMLTC[T any] struct {
-	xml    string
-	expect T
+type mergeMapsTC[K comparable, V any] struct {
+	layers        []Optional[map[K]V]
+	expectPresent bool
+	expectValue   map[K]V
 	test.Control
 }
 
-func (tc optionalUnmarshalXMLTC[T]) Test(t *testing.T) {
-	var value T
-	err := xml.Unmarshal([]byte(tc.xml), &value)
-	assert.NoError(t, err, "unexpected error")
-	assert.Equal(t, tc.expect, value, "unexpected value")
+func (tc mergeMapsTC[K, V]) Test(t *testing.T) {
+	opt := MergeMaps(tc.layers...)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestOptional_UnmarshalXML(t *testing.T) {
-	type Example struct {
-		Int       Optional[int]     `xml:"int"`
-		String    Optional[string]  `xml:"string"`
-		IntPtr    *Optional[int]    `xml:"intPtr"`
-		StringPtr *Optional[string] `xml:"stringPtr"`
-	}
-
+func TestMergeMaps(t *testing.T) {
 	test.RunCases(t, test.Cases{
-		"on empty int Optional": optionalUnmarshalXMLTC[Optional[int]]{
-			xml:    `<int/>`,
-			expect: Of(0),
-		},
-		"on non-empty int Optional with zero value": optionalUnmarshalXMLTC[Optional[int]]{
-			xml:    `<int>0</int>`,
-			expect: Of(0),
-		},
-		"on non-empty int Optional with non-zero value": optionalUnmarshalXMLTC[Optional[int]]{
-			xml:    `<int>123</int>`,
-			expect: Of(123),
-		},
-		"on empty string Optional": optionalUnmarshalXMLTC[Optional[string]]{
-			xml:    `<string/>`,
-			expect: Of(""),
-		},
-		"on non-empty string Optional with zero value": optionalUnmarshalXMLTC[Optional[string]]{
-			xml:    `<string></string>`,
-			expect: Of(""),
-		},
-		"on non-empty string Optional with non-zero value": optionalUnmarshalXMLTC[Optional[string]]{
-			xml:    `<string>abc</string>`,
-			expect: Of("abc"),
+		// Test cases for documented examples
+		"given no map Optionals": mergeMapsTC[string, int]{
+			expectPresent: false,
 		},
-		"on struct with empty Optionals": optionalUnmarshalXMLTC[Example]{
-			xml:    `<Example></Example>`,
-			expect: Example{},
+		"given empty map Optionals": mergeMapsTC[string, int]{
+			layers:        []Optional[map[string]int]{Empty[map[string]int](), Empty[map[string]int]()},
+			expectPresent: false,
 		},
-		"on struct with non-empty Optionals and zero field values": optionalUnmarshalXMLTC[Example]{
-			xml: `<Example><int>0</int><string></string><intPtr>0</intPtr><stringPtr></stringPtr></Example>`,
-			expect: Example{
-				Int:       Of(0),
-				String:    Of(""),
-				IntPtr:    ptrs.Value(Of(0)),
-				StringPtr: ptrs.Value(Of("")),
+		"given empty and non-empty map Optionals with overlapping keys": mergeMapsTC[string, int]{
+			layers: []Optional[map[string]int]{
+				Empty[map[string]int](),
+				Of(map[string]int{"abc": 123, "def": 456}),
+				Empty[map[string]int](),
+				Of(map[string]int{"def": 0, "ghi": 789}),
 			},
+			expectPresent: true,
+			expectValue:   map[string]int{"abc": 123, "def": 0, "ghi": 789},
 		},
-		"on struct with non-empty Optionals and non-zero field values": optionalUnmarshalXMLTC[Example]{
-			xml: `<Example><int>123</int><string>abc</string><intPtr>123</intPtr><stringPtr>abc</stringPtr></Example>`,
-			expect: Example{
-				Int:       Of(123),
-				String:    Of("abc"),
-				IntPtr:    ptrs.Value(Of(123)),
-				StringPtr: ptrs.Value(Of("abc")),
+		// Other test cases...
+		"given non-empty map Optional with nil value": mergeMapsTC[string, int]{
+			layers:        []Optional[map[string]int]{Of[map[string]int](nil)},
+			expectPresent: true,
+			expectValue:   map[string]int{},
+		},
+		"given non-empty map Optionals with nil and non-nil values": mergeMapsTC[string, int]{
+			layers: []Optional[map[string]int]{
+				Of(map[string]int{"abc": 123}),
+				Of[map[string]int](nil),
 			},
+			expectPresent: true,
+			expectValue:   map[string]int{"abc": 123},
 		},
 	})
 }
 
-func BenchmarkOptional_UnmarshalYAML(b *testing.B) {
+func TestMergeMaps_doesNotModifyLayers(t *testing.T) {
+	first := map[string]int{"abc": 123}
+	second := map[string]int{"abc": 456, "def": 789}
+	_ = MergeMaps(Of(first), Of(second))
+	assert.Equal(t, map[string]int{"abc": 123}, first, "unexpected first layer")
+	assert.Equal(t, map[string]int{"abc": 456, "def": 789}, second, "unexpected second layer")
+}
+
+func BenchmarkMustFind(b *testing.B) {
+	opts := []Optional[int]{Empty[int](), Of(0), Of(123)}
 	for i := 0; i < b.N; i++ {
-		var opt Optional[int]
-		if err := yaml.Unmarshal([]byte(`123`), &opt); err != nil {
-			b.Fatal(err)
-		}
+		_ = MustFind(opts...)
 	}
 }
 
-type optionalUnmarshalYAMLTC[T any] struct {
-	yaml   string
-	expect T
+type mustFindTC[T any] struct {
+	opts        []Optional[T]
+	expectPanic bool
+	expectValue T
 	test.Control
 }
 
-func (tc optionalUnmarshalYAMLTC[T]) Test(t *testing.T) {
-	var value T
-	err := yaml.Unmarshal([]byte(tc.yaml), &value)
-	assert.NoError(t, err, "unexpected error")
-	assert.Equal(t, tc.expect, value, "unexpected value")
-}
-
-func TestOptional_UnmarshalYAML(t *testing.T) {
-	type Example struct {
-		Int       Optional[int]     `yaml:"int"`
-		String    Optional[string]  `yaml:"string"`
-		IntPtr    *Optional[int]    `yaml:"intPtr"`
-		StringPtr *Optional[string] `yaml:"stringPtr"`
+func (tc mustFindTC[T]) Test(t *testing.T) {
+	if tc.expectPanic {
+		assert.Panics(t, func() {
+			MustFind(tc.opts...)
+		}, "expected panic")
+	} else {
+		var value T
+		assert.NotPanics(t, func() {
+			value = MustFind(tc.opts...)
+		}, "unexpected panic")
+		assert.Equal(t, tc.expectValue, value, "unexpected value")
 	}
+}
 
+func TestMustFind(t *testing.T) {
 	test.RunCases(t, test.Cases{
-		"on empty int Optional": optionalUnmarshalYAMLTC[Optional[int]]{
-			yaml:   `null`,
-			expect: Empty[int](),
-		},
-		"on non-empty int Optional with zero value": optionalUnmarshalYAMLTC[Optional[int]]{
-			yaml:   `0`,
-			expect: Of(0),
-		},
-		"on non-empty int Optional with non-zero value": optionalUnmarshalYAMLTC[Optional[int]]{
-			yaml:   `123`,
-			expect: Of(123),
-		},
-		"on empty string Optional": optionalUnmarshalYAMLTC[Optional[string]]{
-			yaml:   `null`,
-			expect: Empty[string](),
+		// Test cases for documented examples
+		"given no int Optionals": mustFindTC[int]{
+			expectPanic: true,
 		},
-		"on non-empty string Optional with zero value": optionalUnmarshalYAMLTC[Optional[string]]{
-			yaml:   `""`,
-			expect: Of(""),
+		"given empty int Optional": mustFindTC[int]{
+			opts:        []Optional[int]{Empty[int]()},
+			expectPanic: true,
 		},
-		"on non-empty string Optional with non-zero value": optionalUnmarshalYAMLTC[Optional[string]]{
-			yaml:   `"abc"`,
-			expect: Of("abc"),
+		"given an empty int Optional and two non-empty int Optionals": mustFindTC[int]{
+			opts: []Optional[int]{
+				Empty[int](),
+				Of(0),
+				Of(123),
+			},
+			expectValue: 0,
 		},
-		"on struct with empty Optionals": optionalUnmarshalYAMLTC[Example]{
-			yaml:   `{}`,
-			expect: Example{},
+		"given no string Optionals": mustFindTC[string]{
+			expectPanic: true,
 		},
-		"on struct with non-empty Optionals and zero field values": optionalUnmarshalYAMLTC[Example]{
-			yaml: `int: 0
-string: ""
-intPtr: 0
-stringPtr: ""`,
-			expect: Example{
-				Int:       Of(0),
-				String:    Of(""),
-				IntPtr:    ptrs.Value(Of(0)),
-				StringPtr: ptrs.Value(Of("")),
-			},
+		"given empty string Optional": mustFindTC[string]{
+			opts:        []Optional[string]{Empty[string]()},
+			expectPanic: true,
 		},
-		"on struct with non-empty Optionals and non-zero field values": optionalUnmarshalYAMLTC[Example]{
-			yaml: `int: 123
-string: abc
-intPtr: 123
-stringPtr: abc`,
-			expect: Example{
-				Int:       Of(123),
-				String:    Of("abc"),
-				IntPtr:    ptrs.Value(Of(123)),
-				StringPtr: ptrs.Value(Of("abc")),
+		"given an empty string Optional and two non-empty string Optionals": mustFindTC[string]{
+			opts: []Optional[string]{
+				Empty[string](),
+				Of("abc"),
+				Of(""),
 			},
+			expectValue: "abc",
 		},
+		// Other test cases...
 	})
 }
 
-func BenchmarkOptional_Value(b *testing.B) {
-	opt := Of(123)
+func BenchmarkOf(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		if _, err := opt.Value(); err != nil {
-			b.Fatal(err)
-		}
+		_ = Of(123)
 	}
 }
 
-type optionalValueTC[T any] struct {
-	opt         Optional[T]
-	expectError bool
-	expectValue driver.Value
+type ofTC[T any] struct {
+	value T
 	test.Control
 }
 
-func (tc optionalValueTC[T]) Test(t *testing.T) {
-	value, err := tc.opt.Value()
-	if tc.expectError {
-		assert.Error(t, err, "expected error")
-	} else {
-		assert.NoError(t, err, "unexpected error")
-	}
-	assert.Equal(t, tc.expectValue, value, "unexpected value")
+func (tc ofTC[T]) Test(t *testing.T) {
+	opt := Of(tc.value)
+	value, present := opt.Get()
+	assert.Equal(t, tc.value, value, "unexpected value")
+	assert.True(t, present, "expected presence")
 }
 
-func TestOptional_Value(t *testing.T) {
-	type Bool bool
-
-	var timeNow = time.Now().UTC()
-
+func TestOf(t *testing.T) {
 	test.RunCases(t, test.Cases{
-		// Test cases for driver.Value types
-		"on empty bool Optional": optionalValueTC[bool]{
-			opt:         Empty[bool](),
-			expectValue: nil,
-		},
-		"on non-empty bool Optional with zero value": optionalValueTC[bool]{
-			opt:         Of(false),
-			expectValue: false,
-		},
-		"on non-empty bool Optional with non-zero value": optionalValueTC[bool]{
-			opt:         Of(true),
-			expectValue: true,
-		},
-		"on empty float64 Optional": optionalValueTC[float64]{
-			opt:         Empty[float64](),
-			expectValue: nil,
-		},
-		"on non-empty float64 Optional with zero value": optionalValueTC[float64]{
-			opt:         Of[float64](0),
-			expectValue: float64(0),
-		},
-		"on non-empty float64 Optional with non-zero value": optionalValueTC[float64]{
-			opt:         Of(123.456),
-			expectValue: 123.456,
-		},
-		"on empty int64 Optional": optionalValueTC[int64]{
-			opt:         Empty[int64](),
-			expectValue: nil,
-		},
-		"on non-empty int64 Optional with zero value": optionalValueTC[int64]{
-			opt:         Of[int64](0),
-			expectValue: int64(0),
-		},
-		"on non-empty int64 Optional with non-zero value": optionalValueTC[int64]{
-			opt:         Of[int64](123),
-			expectValue: int64(123),
-		},
-		"on empty string Optional": optionalValueTC[string]{
-			opt:         Empty[string](),
-			expectValue: nil,
-		},
-		"on non-empty string Optional with zero value": optionalValueTC[string]{
-			opt:         Of(""),
-			expectValue: "",
-		},
-		"on non-empty string Optional with non-zero value": optionalValueTC[string]{
-			opt:         Of("abc"),
-			expectValue: "abc",
-		},
-		"on empty []byte Optional": optionalValueTC[[]byte]{
-			opt:         Empty[[]byte](),
-			expectValue: nil,
-		},
-		"on non-empty []byte Optional with empty value": optionalValueTC[[]byte]{
-			opt:         Of([]byte{}),
-			expectValue: []byte{},
-		},
-		"on non-empty []byte Optional with non-empty value": optionalValueTC[[]byte]{
-			opt:         Of([]byte("abc")),
-			expectValue: []byte("abc"),
-		},
-		"on empty time.Time Optional": optionalValueTC[time.Time]{
-			opt:         Empty[time.Time](),
-			expectValue: nil,
-		},
-		"on non-empty time.Time Optional with zero value": optionalValueTC[time.Time]{
-			opt:         Of(time.Time{}),
-			expectValue: time.Time{},
-		},
-		"on non-empty time.Time Optional with non-zero value": optionalValueTC[time.Time]{
-			opt:         Of(timeNow),
-			expectValue: timeNow,
-		},
-		// Test cases for non-driver.Value types
-		"on empty Bool Optional": optionalValueTC[Bool]{
-			opt:         Empty[Bool](),
-			expectValue: nil,
-		},
-		"on non-empty Bool Optional with zero value": optionalValueTC[Bool]{
-			opt:         Of[Bool](false),
-			expectValue: false,
-		},
-		"on non-empty Bool Optional with non-zero value": optionalValueTC[Bool]{
-			opt:         Of[Bool](true),
-			expectValue: true,
-		},
-		"on empty int32 Optional": optionalValueTC[int32]{
-			opt:         Empty[int32](),
-			expectValue: nil,
-		},
-		"on non-empty int32 Optional with zero value": optionalValueTC[int32]{
-			opt:         Of[int32](123),
-			expectValue: int64(123),
+		// Test cases for documented examples
+		"given zero int": ofTC[int]{
+			value: 0,
 		},
-		"on non-empty int32 Optional with non-zero value": optionalValueTC[int32]{
-			opt:         Of[int32](123),
-			expectValue: int64(123),
+		"given non-zero int": ofTC[int]{
+			value: 123,
 		},
-		// Test cases for driver.Valuer types
-		"on empty sql.NullBool Optional": optionalValueTC[sql.NullBool]{
-			opt:         Empty[sql.NullBool](),
-			expectValue: nil,
+		"given nil int pointer": ofTC[*int]{
+			value: nil,
 		},
-		"on non-empty sql.NullBool Optional given zero value": optionalValueTC[sql.NullBool]{
-			opt:         Of(sql.NullBool{}),
-			expectValue: nil,
+		"given zero int pointer": ofTC[*int]{
+			value: ptrs.ZeroInt(),
 		},
-		"on non-empty sql.NullBool Optional given false bool value": optionalValueTC[sql.NullBool]{
-			opt:         Of(sql.NullBool{Bool: false, Valid: true}),
-			expectValue: false,
+		"given non-zero int pointer": ofTC[*int]{
+			value: ptrs.Int(123),
 		},
-		"on non-empty sql.NullBool Optional given true bool value": optionalValueTC[sql.NullBool]{
-			opt:         Of(sql.NullBool{Bool: true, Valid: true}),
-			expectValue: true,
+		"given zero string": ofTC[string]{
+			value: "",
 		},
-		"on empty sql.NullInt32 Optional": optionalValueTC[sql.NullInt32]{
-			opt:         Empty[sql.NullInt32](),
-			expectValue: nil,
+		"given non-zero string": ofTC[string]{
+			value: "abc",
 		},
-		"on non-empty sql.NullInt32 Optional given zero value": optionalValueTC[sql.NullInt32]{
-			opt:         Of(sql.NullInt32{}),
-			expectValue: nil,
+		"given nil string pointer": ofTC[*string]{
+			value: nil,
 		},
-		"on non-empty sql.NullInt32 Optional given zero int32 value": optionalValueTC[sql.NullInt32]{
-			opt:         Of(sql.NullInt32{Int32: 0, Valid: true}),
-			expectValue: int64(0),
+		"given zero string pointer": ofTC[*string]{
+			value: ptrs.ZeroString(),
 		},
-		"on non-empty sql.NullInt32 Optional given non-zero int32 value": optionalValueTC[sql.NullInt32]{
-			opt:         Of(sql.NullInt32{Int32: 123, Valid: true}),
-			expectValue: int64(123),
+		"given non-zero string pointer": ofTC[*string]{
+			value: ptrs.String("abc"),
 		},
+		// Other test cases...
 	})
 }
 
-func BenchmarkCompare(b *testing.B) {
-	x := Of(123)
-	y := Of(-123)
+func BenchmarkOfAssert(b *testing.B) {
+	var value any = 123
 	for i := 0; i < b.N; i++ {
-		Compare(x, y)
+		_ = OfAssert[int](value)
 	}
 }
 
-type compareTC[T cmp.Ordered] struct {
-	x      Optional[T]
-	y      Optional[T]
-	expect int
+type ofAssertTC[T any] struct {
+	value         any
+	expectPresent bool
+	expectValue   T
 	test.Control
 }
 
-func (tc compareTC[T]) Test(t *testing.T) {
-	actual := Compare(tc.x, tc.y)
-	assert.Equal(t, tc.expect, actual, "unexpected comparison result")
+func (tc ofAssertTC[T]) Test(t *testing.T) {
+	opt := OfAssert[T](tc.value)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestCompare(t *testing.T) {
+func TestOfAssert(t *testing.T) {
+	err := errors.New("failed")
+
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given empty int Optional and non-empty int Optional with zero value": compareTC[int]{
-			x:      Empty[int](),
-			y:      Of(0),
-			expect: -1,
+		"given nil as int": ofAssertTC[int]{
+			value:         nil,
+			expectPresent: false,
+		},
+		"given zero int as int": ofAssertTC[int]{
+			value:         0,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"given non-zero int as int": ofAssertTC[int]{
+			value:         123,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"given string as int": ofAssertTC[int]{
+			value:         "123",
+			expectPresent: false,
+		},
+		"given nil as string": ofAssertTC[string]{
+			value:         nil,
+			expectPresent: false,
+		},
+		"given zero string as string": ofAssertTC[string]{
+			value:         "",
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"given non-zero string as string": ofAssertTC[string]{
+			value:         "abc",
+			expectPresent: true,
+			expectValue:   "abc",
 		},
-		"given non-empty int Optional with zero value and non-empty int Optional with positive non-zero value": compareTC[int]{
-			x:      Of(0),
-			y:      Of(123),
-			expect: -1,
+		"given int as string": ofAssertTC[string]{
+			value:         123,
+			expectPresent: false,
 		},
-		"given two empty int Optionals": compareTC[int]{
-			x:      Empty[int](),
-			y:      Empty[int](),
-			expect: 0,
+		// Other test cases...
+		"given nil as error": ofAssertTC[error]{
+			value:         nil,
+			expectPresent: false,
 		},
-		"given two non-empty int Optionals with zero values": compareTC[int]{
-			x:      Of(0),
-			y:      Of(0),
-			expect: 0,
+		"given error as error": ofAssertTC[error]{
+			value:         err,
+			expectPresent: true,
+			expectValue:   err,
 		},
-		"given two non-empty int Optionals with same non-zero values": compareTC[int]{
-			x:      Of(123),
-			y:      Of(123),
-			expect: 0,
+		"given string as error": ofAssertTC[error]{
+			value:         "failed",
+			expectPresent: false,
 		},
-		"given non-empty int Optional with zero value and empty int Optional": compareTC[int]{
-			x:      Of(0),
-			y:      Empty[int](),
-			expect: 1,
+		"given int as fmt.Stringer": ofAssertTC[fmt.Stringer]{
+			value:         123,
+			expectPresent: false,
 		},
-		"given non-empty int Optional with positive non-zero value and non-empty int Optional with zero value": compareTC[int]{
-			x:      Of(123),
-			y:      Of(0),
-			expect: 1,
+		"given time.Duration as fmt.Stringer": ofAssertTC[fmt.Stringer]{
+			value:         time.Second,
+			expectPresent: true,
+			expectValue:   time.Second,
+		},
+		"given nil as *int": ofAssertTC[*int]{
+			value:         nil,
+			expectPresent: false,
+		},
+		"given typed nil pointer as *int": ofAssertTC[*int]{
+			value:         (*int)(nil),
+			expectPresent: true,
+			expectValue:   nil,
 		},
-		// Other test cases...
 	})
 }
 
-func BenchmarkEmpty(b *testing.B) {
+func BenchmarkOfCSVList(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		Empty[int]()
+		_ = OfCSVList("a, b ,c")
 	}
 }
 
-type emptyTC[T any] struct {
+type ofCSVListTC struct {
+	s             string
+	expectPresent bool
+	expectValue   []string
 	test.Control
 }
 
-func (tc emptyTC[T]) Test(t *testing.T) {
-	opt := Empty[T]()
+func (tc ofCSVListTC) Test(t *testing.T) {
+	opt := OfCSVList(tc.s)
 	value, present := opt.Get()
-	assert.Zero(t, value, "expected zero value")
-	assert.False(t, present, "expected emptiness")
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestEmpty(t *testing.T) {
+func TestOfCSVList(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"with int":    emptyTC[int]{},
-		"with string": emptyTC[string]{},
+		"given empty string": ofCSVListTC{
+			s:             "",
+			expectPresent: false,
+		},
+		"given string with single item": ofCSVListTC{
+			s:             "a",
+			expectPresent: true,
+			expectValue:   []string{"a"},
+		},
+		"given string with multiple items": ofCSVListTC{
+			s:             "a, b ,c",
+			expectPresent: true,
+			expectValue:   []string{"a", "b", "c"},
+		},
 		// Other test cases...
+		"given string with blank items": ofCSVListTC{
+			s:             "a,, ,b",
+			expectPresent: true,
+			expectValue:   []string{"a", "", "", "b"},
+		},
+		"given blank string": ofCSVListTC{
+			s:             " ",
+			expectPresent: true,
+			expectValue:   []string{""},
+		},
 	})
 }
 
-func BenchmarkEqual(b *testing.B) {
+func BenchmarkOfFirstReady(b *testing.B) {
+	ch1 := make(chan int)
+	ch2 := make(chan int, 1)
 	for i := 0; i < b.N; i++ {
-		Equal(Of(123), Of(123))
+		ch2 <- 123
+		_ = OfFirstReady[int](ch1, ch2)
 	}
 }
 
-type equalTC[T1 any, T2 any] struct {
-	opt1   Optional[T1]
-	opt2   Optional[T2]
-	expect bool
+type ofFirstReadyTC[T any] struct {
+	values        [][]T
+	closed        []bool
+	nilChans      int
+	expectPresent bool
+	expectValue   T
 	test.Control
 }
 
-func (tc equalTC[T1, T2]) Test(t *testing.T) {
-	actual := Equal(tc.opt1, tc.opt2)
-	assert.Equal(t, tc.expect, actual, "unexpected equality")
+func (tc ofFirstReadyTC[T]) Test(t *testing.T) {
+	chans := make([]<-chan T, 0, len(tc.values)+tc.nilChans)
+	for i := 0; i < tc.nilChans; i++ {
+		chans = append(chans, nil)
+	}
+	for i, values := range tc.values {
+		ch := make(chan T, len(values))
+		for _, value := range values {
+			ch <- value
+		}
+		if i < len(tc.closed) && tc.closed[i] {
+			close(ch)
+		}
+		chans = append(chans, ch)
+	}
+	opt := OfFirstReady(chans...)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestEqual(t *testing.T) {
+func TestOfFirstReady(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given empty int Optional and empty int Optional": equalTC[int, int]{
-			opt1:   Empty[int](),
-			opt2:   Empty[int](),
-			expect: true,
-		},
-		"given empty int Optional and non-empty int Optional with zero value": equalTC[int, int]{
-			opt1:   Empty[int](),
-			opt2:   Of(0),
-			expect: false,
-		},
-		"given non-empty int Optional with zero value and empty int Optional": equalTC[int, int]{
-			opt1:   Of(0),
-			opt2:   Empty[int](),
-			expect: false,
-		},
-		"given non-empty int Optional with zero value and non-empty int Optional with zero value": equalTC[int, int]{
-			opt1:   Of(0),
-			opt2:   Of(0),
-			expect: true,
-		},
-		"given non-empty int Optional with zero value and non-empty int Optional with non-zero value": equalTC[int, int]{
-			opt1:   Of(0),
-			opt2:   Of(123),
-			expect: false,
-		},
-		"given non-empty int Optional with non-zero value and non-empty int Optional with zero value": equalTC[int, int]{
-			opt1:   Of(123),
-			opt2:   Of(0),
-			expect: false,
-		},
-		"given non-empty int Optional with non-zero value and non-empty int Optional with equal non-zero value": equalTC[int, int]{
-			opt1:   Of(123),
-			opt2:   Of(123),
-			expect: true,
-		},
-		"given non-empty int Optional with non-zero value and non-empty int Optional with similar but not equal non-zero value": equalTC[int, int]{
-			opt1:   Of(123),
-			opt2:   Of(-123),
-			expect: false,
-		},
-		"given non-empty int Optional with non-zero value and empty int Optional": equalTC[int, int]{
-			opt1:   Of(123),
-			opt2:   Empty[int](),
-			expect: false,
-		},
-		"given empty any Optional and empty int Optional": equalTC[any, int]{
-			opt1:   Empty[any](),
-			opt2:   Empty[int](),
-			expect: true,
-		},
-		"given empty any Optional and non-empty int Optional with zero value": equalTC[any, int]{
-			opt1:   Empty[any](),
-			opt2:   Of(0),
-			expect: false,
-		},
-		"given non-empty any Optional with zero int value and non-empty int Optional with zero value": equalTC[any, int]{
-			opt1:   Of[any](0),
-			opt2:   Of(0),
-			expect: true,
-		},
-		"given non-empty any Optional with non-zero int value and non-empty int Optional with equal non-zero value": equalTC[any, int]{
-			opt1:   Of[any](123),
-			opt2:   Of(123),
-			expect: true,
-		},
-		"given non-empty any Optional with zero int value and non-empty string Optional with similar but not equal non-zero value": equalTC[any, string]{
-			opt1:   Of[any](0),
-			opt2:   Of("0"),
-			expect: false,
+		"given no channels": ofFirstReadyTC[int]{
+			expectPresent: false,
 		},
-		"given empty string Optional and empty string Optional": equalTC[string, string]{
-			opt1:   Empty[string](),
-			opt2:   Empty[string](),
-			expect: true,
+		"given int channels with none ready": ofFirstReadyTC[int]{
+			values:        [][]int{nil, nil},
+			expectPresent: false,
 		},
-		"given empty string Optional and non-empty string Optional with zero value": equalTC[string, string]{
-			opt1:   Empty[string](),
-			opt2:   Of(""),
-			expect: false,
+		"given int channels with one ready zero value": ofFirstReadyTC[int]{
+			values:        [][]int{nil, {0}},
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"given non-empty string Optional and zero value given empty string Optional": equalTC[string, string]{
-			opt1:   Of(""),
-			opt2:   Empty[string](),
-			expect: false,
+		"given int channels with one ready non-zero value": ofFirstReadyTC[int]{
+			values:        [][]int{nil, {123}, nil},
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"given non-empty string Optional with zero value and non-empty string Optional with zero value": equalTC[string, string]{
-			opt1:   Of(""),
-			opt2:   Of(""),
-			expect: true,
+		"given string channels with one ready non-zero value": ofFirstReadyTC[string]{
+			values:        [][]string{{"abc"}, nil},
+			expectPresent: true,
+			expectValue:   "abc",
 		},
-		"given non-empty string Optional with zero value and non-empty string Optional with non-zero value": equalTC[string, string]{
-			opt1:   Of(""),
-			opt2:   Of("abc"),
-			expect: false,
+		// Other test cases...
+		"given nil int channels": ofFirstReadyTC[int]{
+			nilChans:      2,
+			expectPresent: false,
 		},
-		"given non-empty string Optional with non-zero value and non-empty string Optional with zero value": equalTC[string, string]{
-			opt1:   Of("abc"),
-			opt2:   Of(""),
-			expect: false,
+		"given nil and ready int channels": ofFirstReadyTC[int]{
+			values:        [][]int{{123}},
+			nilChans:      2,
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"given non-empty string Optional with non-zero value and non-empty string Optional with equal non-zero value": equalTC[string, string]{
-			opt1:   Of("abc"),
-			opt2:   Of("abc"),
-			expect: true,
+		"given error channels with one ready nil value": ofFirstReadyTC[error]{
+			values:        [][]error{{nil}},
+			expectPresent: true,
+			expectValue:   nil,
 		},
-		"given non-empty string Optional with non-zero value and non-empty string Optional with similar but not equal non-zero value": equalTC[string, string]{
-			opt1:   Of("abc"),
-			opt2:   Of("ABC"),
-			expect: false,
+		"given closed int channels": ofFirstReadyTC[int]{
+			values:        [][]int{nil, nil},
+			closed:        []bool{true, true},
+			expectPresent: false,
 		},
-		"given non-empty string Optional with non-zero value and empty string Optional": equalTC[string, string]{
-			opt1:   Of("abc"),
-			opt2:   Empty[string](),
-			expect: false,
+		"given closed and ready int channels": ofFirstReadyTC[int]{
+			values:        [][]int{nil, {123}, nil},
+			closed:        []bool{true, false, true},
+			expectPresent: true,
+			expectValue:   123,
 		},
-		// Other test cases...
 	})
 }
 
-func BenchmarkFind(b *testing.B) {
-	opts := []Optional[int]{Empty[int](), Empty[int](), Of(123)}
+func BenchmarkOfNillable(b *testing.B) {
+	value := 123
 	for i := 0; i < b.N; i++ {
-		_ = Find(opts...)
+		_ = OfNillable(&value)
 	}
 }
 
-type findTC[T any] struct {
-	opts          []Optional[T]
+type ofNillableTC[T any] struct {
+	value         T
 	expectPresent bool
-	expectValue   T
 	test.Control
 }
 
-func (tc findTC[T]) Test(t *testing.T) {
-	opt := Find(tc.opts...)
+func (tc ofNillableTC[T]) Test(t *testing.T) {
+	opt := OfNillable(tc.value)
 	value, present := opt.Get()
-	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.value, value, "unexpected value")
 	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestFind(t *testing.T) {
+func TestOfNillable(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given no int Optionals": findTC[int]{
-			expectPresent: false,
-			expectValue:   0,
+		"given zero int": ofNillableTC[int]{
+			value:         0,
+			expectPresent: true,
 		},
-		"given empty int Optional": findTC[int]{
-			opts:          []Optional[int]{Empty[int]()},
+		"given non-zero int": ofNillableTC[int]{
+			value:         123,
+			expectPresent: true,
+		},
+		"given nil int pointer": ofNillableTC[*int]{
+			value:         nil,
 			expectPresent: false,
-			expectValue:   0,
 		},
-		"given an empty int Optional and two non-empty int Optionals": findTC[int]{
-			opts: []Optional[int]{
-				Empty[int](),
-				Of(0),
-				Of(123),
-			},
+		"given zero int pointer": ofNillableTC[*int]{
+			value:         ptrs.ZeroInt(),
+			expectPresent: true,
+		},
+		"given non-zero int pointer": ofNillableTC[*int]{
+			value:         ptrs.Int(123),
+			expectPresent: true,
+		},
+		"given zero string": ofNillableTC[string]{
+			value:         "",
 			expectPresent: true,
-			expectValue:   0,
 		},
-		"given no string Optionals": findTC[string]{
-			expectPresent: false,
-			expectValue:   "",
+		"given non-zero string": ofNillableTC[string]{
+			value:         "abc",
+			expectPresent: true,
 		},
-		"given empty string Optional": findTC[string]{
-			opts:          []Optional[string]{Empty[string]()},
+		"given nil string pointer": ofNillableTC[*string]{
+			value:         nil,
 			expectPresent: false,
-			expectValue:   "",
 		},
-		"given an empty string Optional and two non-empty string Optionals": findTC[string]{
-			opts: []Optional[string]{
-				Empty[string](),
-				Of("abc"),
-				Of(""),
-			},
+		"given zero string pointer": ofNillableTC[*string]{
+			value:         ptrs.ZeroString(),
+			expectPresent: true,
+		},
+		"given non-zero string pointer": ofNillableTC[*string]{
+			value:         ptrs.String("abc"),
 			expectPresent: true,
-			expectValue:   "abc",
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkFlatMap(b *testing.B) {
-	toString := func(value int) Optional[string] {
-		if value == 0 {
-			return Empty[string]()
-		}
-		return Of(strconv.FormatInt(int64(value), 10))
-	}
-	opt := Of(123)
+func BenchmarkOfNonNil(b *testing.B) {
+	err := errors.New("failed")
 	for i := 0; i < b.N; i++ {
-		_ = FlatMap(opt, toString)
+		_ = OfNonNil(err)
 	}
 }
 
-type flatMapTC[T, M any] struct {
-	opt           Optional[T]
-	fn            func(value T) Optional[M]
+type ofNonNilTC[T any] struct {
+	value         T
 	expectPresent bool
-	expectValue   M
 	test.Control
 }
 
-func (tc flatMapTC[T, M]) Test(t *testing.T) {
-	opt := FlatMap(tc.opt, tc.fn)
+func (tc ofNonNilTC[T]) Test(t *testing.T) {
+	opt := OfNonNil(tc.value)
 	value, present := opt.Get()
-	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	if tc.expectPresent {
+		assert.Equal(t, tc.value, value, "unexpected value")
+	}
 	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestFlatMap(t *testing.T) {
-	toInt := func(value string) Optional[int] {
-		if value == "" {
-			return Empty[int]()
-		}
-		i, err := strconv.ParseInt(value, 10, 0)
-		if err != nil {
-			panic(err)
-		}
-		return OfZeroable(int(i))
-	}
-	toString := func(value int) Optional[string] {
-		if value == 0 {
-			return Empty[string]()
-		}
-		return Of(strconv.FormatInt(int64(value), 10))
+func TestOfNonNil(t *testing.T) {
+	type customError struct {
+		error
 	}
 
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given empty int Optional": flatMapTC[int, string]{
-			opt:           Empty[int](),
-			fn:            toString,
+		"given nil error": ofNonNilTC[error]{
+			value:         nil,
 			expectPresent: false,
 		},
-		"given non-empty int Optional with zero value": flatMapTC[int, string]{
-			opt:           Of(0),
-			fn:            toString,
+		"given non-nil error": ofNonNilTC[error]{
+			value:         errors.New("failed"),
+			expectPresent: true,
+		},
+		"given error holding typed nil pointer": ofNonNilTC[error]{
+			value:         (*customError)(nil),
 			expectPresent: false,
 		},
-		"given non-empty int Optional with non-zero value": flatMapTC[int, string]{
-			opt:           Of(123),
-			fn:            toString,
+		"given error holding non-nil pointer": ofNonNilTC[error]{
+			value:         &customError{errors.New("failed")},
 			expectPresent: true,
-			expectValue:   "123",
 		},
-		"given empty string Optional": flatMapTC[string, int]{
-			opt:           Empty[string](),
-			fn:            toInt,
+		"given zero int": ofNonNilTC[int]{
+			value:         0,
+			expectPresent: true,
+		},
+		"given nil int pointer": ofNonNilTC[*int]{
+			value:         nil,
 			expectPresent: false,
 		},
-		"given non-empty string Optional with zero value": flatMapTC[string, int]{
-			opt:           Of(""),
-			fn:            toInt,
+		"given non-zero int pointer": ofNonNilTC[*int]{
+			value:         ptrs.Int(123),
+			expectPresent: true,
+		},
+		// Other test cases...
+		"given nil any": ofNonNilTC[any]{
+			value:         nil,
 			expectPresent: false,
 		},
-		"given non-empty string Optional with zero-representing value": flatMapTC[string, int]{
-			opt:           Of("0"),
-			fn:            toInt,
+		"given nil int slice": ofNonNilTC[[]int]{
+			value:         nil,
 			expectPresent: false,
 		},
-		"given non-empty string Optional with non-zero-representing value": flatMapTC[string, int]{
-			opt:           Of("123"),
-			fn:            toInt,
-			expectPresent: true,
-			expectValue:   123,
+		"given nil string map": ofNonNilTC[map[string]string]{
+			value:         nil,
+			expectPresent: false,
+		},
+		"given nil channel": ofNonNilTC[chan int]{
+			value:         nil,
+			expectPresent: false,
 		},
-		// Other test cases...
 	})
 }
 
-func BenchmarkGetAny(b *testing.B) {
-	opts := []Optional[int]{Empty[int](), Of(0), Of(123)}
+func BenchmarkOfPointer(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		_ = GetAny(opts...)
+		_ = OfPointer(123)
 	}
 }
 
-type getAnyTC[T any] struct {
-	opts   []Optional[T]
-	expect []T
+type ofPointerTC[T any] struct {
+	value T
 	test.Control
 }
 
-func (tc getAnyTC[T]) Test(t *testing.T) {
-	actual := GetAny(tc.opts...)
-	assert.Equal(t, tc.expect, actual, "unexpected values")
+func (tc ofPointerTC[T]) Test(t *testing.T) {
+	opt := OfPointer(tc.value)
+	value, present := opt.Get()
+	assert.NotNil(t, value, "unexpected nil value")
+	assert.Equal(t, tc.value, *value, "unexpected value")
+	assert.True(t, present, "expected presence")
 }
 
-func TestGetAny(t *testing.T) {
+func TestOfPointer(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given no int Optionals": getAnyTC[int]{
-			expect: nil,
-		},
-		"given empty int Optional": getAnyTC[int]{
-			opts:   []Optional[int]{Empty[int]()},
-			expect: nil,
-		},
-		"given an empty int Optional and two non-empty int Optionals": getAnyTC[int]{
-			opts: []Optional[int]{
-				Empty[int](),
-				Of(0),
-				Of(123),
-			},
-			expect: []int{0, 123},
+		"given zero int": ofPointerTC[int]{
+			value: 0,
 		},
-		"given no string Optionals": getAnyTC[string]{
-			expect: nil,
+		"given non-zero int": ofPointerTC[int]{
+			value: 123,
 		},
-		"given empty string Optional": getAnyTC[string]{
-			opts:   []Optional[string]{Empty[string]()},
-			expect: nil,
+		"given zero string": ofPointerTC[string]{
+			value: "",
 		},
-		"given an empty string Optional and two non-empty string Optionals": getAnyTC[string]{
-			opts: []Optional[string]{
-				Empty[string](),
-				Of("abc"),
-				Of(""),
-			},
-			expect: []string{"abc", ""},
+		"given non-zero string": ofPointerTC[string]{
+			value: "abc",
 		},
 		// Other test cases...
 	})
 }
 
-func BenchmarkMap(b *testing.B) {
-	toString := func(value int) string {
-		return strconv.FormatInt(int64(value), 10)
-	}
-	opt := Of(123)
+func BenchmarkOfReflect(b *testing.B) {
+	rv := reflect.ValueOf(123)
 	for i := 0; i < b.N; i++ {
-		_ = Map(opt, toString)
+		_ = OfReflect(rv)
 	}
 }
 
-type mapTC[T, M any] struct {
-	opt           Optional[T]
-	fn            func(value T) M
+type ofReflectTC struct {
+	rv            reflect.Value
 	expectPresent bool
-	expectValue   M
+	expectValue   any
 	test.Control
 }
 
-func (tc mapTC[T, M]) Test(t *testing.T) {
-	opt := Map(tc.opt, tc.fn)
+func (tc ofReflectTC) Test(t *testing.T) {
+	opt := OfReflect(tc.rv)
 	value, present := opt.Get()
 	assert.Equal(t, tc.expectValue, value, "unexpected value")
 	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestMap(t *testing.T) {
-	toInt := func(value string) int {
-		i, err := strconv.ParseInt(value, 10, 0)
-		if err != nil {
-			panic(err)
-		}
-		return int(i)
-	}
-	toString := func(value int) string {
-		return strconv.FormatInt(int64(value), 10)
-	}
+func TestOfReflect(t *testing.T) {
+	var nilErr error
+	nilIntPtr := (*int)(nil)
 
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given empty int Optional": mapTC[int, string]{
-			opt:           Empty[int](),
-			fn:            toString,
+		"given invalid reflect.Value": ofReflectTC{
+			rv:            reflect.Value{},
 			expectPresent: false,
 		},
-		"given non-empty int Optional with zero value": mapTC[int, string]{
-			opt:           Of(0),
-			fn:            toString,
+		"given nil int pointer reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf(nilIntPtr),
+			expectPresent: false,
+		},
+		"given nil interface reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf(&nilErr).Elem(),
+			expectPresent: false,
+		},
+		"given zero int reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf(0),
 			expectPresent: true,
-			expectValue:   "0",
+			expectValue:   0,
 		},
-		"given non-empty int Optional with non-zero value": mapTC[int, string]{
-			opt:           Of(123),
-			fn:            toString,
+		"given non-zero int reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf(123),
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"given zero string reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf(""),
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"given non-zero string reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf("abc"),
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+		"given non-nil int pointer reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf(ptrs.Int(123)),
+			expectPresent: true,
+			expectValue:   ptrs.Int(123),
+		},
+		"given nil slice reflect.Value": ofReflectTC{
+			rv:            reflect.ValueOf([]int(nil)),
+			expectPresent: true,
+			expectValue:   []int(nil),
+		},
+	})
+}
+
+func BenchmarkOfRegexpNamed(b *testing.B) {
+	re := regexp.MustCompile(`^(?P<key>\w+)=(?P<value>\w*)$`)
+	for i := 0; i < b.N; i++ {
+		_ = OfRegexpNamed(re, "abc=123", "value")
+	}
+}
+
+type ofRegexpNamedTC struct {
+	re            *regexp.Regexp
+	s             string
+	name          string
+	expectPresent bool
+	expectValue   string
+	test.Control
+}
+
+func (tc ofRegexpNamedTC) Test(t *testing.T) {
+	opt := OfRegexpNamed(tc.re, tc.s, tc.name)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestOfRegexpNamed(t *testing.T) {
+	re := regexp.MustCompile(`^(?P<key>\w+)(?:=(?P<value>\w*))?$`)
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given matching string and named group that participated": ofRegexpNamedTC{
+			re:            re,
+			s:             "abc=123",
+			name:          "value",
 			expectPresent: true,
 			expectValue:   "123",
 		},
-		"given empty string Optional": mapTC[string, int]{
-			opt:           Empty[string](),
-			fn:            toInt,
+		"given matching string and named group that participated with empty match": ofRegexpNamedTC{
+			re:            re,
+			s:             "abc=",
+			name:          "value",
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"given matching string and optional named group that did not participate": ofRegexpNamedTC{
+			re:            re,
+			s:             "abc",
+			name:          "value",
 			expectPresent: false,
 		},
-		"given non-empty string Optional with zero-representing value": mapTC[string, int]{
-			opt:           Of("0"),
-			fn:            toInt,
+		"given non-matching string": ofRegexpNamedTC{
+			re:            re,
+			s:             "abc=123=456",
+			name:          "key",
+			expectPresent: false,
+		},
+		"given unknown group name": ofRegexpNamedTC{
+			re:            re,
+			s:             "abc=123",
+			name:          "unknown",
+			expectPresent: false,
+		},
+		// Other test cases...
+		"given matching string and other named group": ofRegexpNamedTC{
+			re:            re,
+			s:             "abc=123",
+			name:          "key",
 			expectPresent: true,
-			expectValue:   0,
+			expectValue:   "abc",
 		},
-		"given non-empty string Optional with non-zero-representing value": mapTC[string, int]{
-			opt:           Of("123"),
-			fn:            toInt,
+		"given empty group name": ofRegexpNamedTC{
+			re:            re,
+			s:             "abc=123",
+			name:          "",
+			expectPresent: false,
+		},
+		"given unanchored regexp matching within string": ofRegexpNamedTC{
+			re:            regexp.MustCompile(`id=(?P<id>\d+)`),
+			s:             "name=abc id=123 id=456",
+			name:          "id",
 			expectPresent: true,
-			expectValue:   123,
+			expectValue:   "123",
 		},
-		// Other test cases...
 	})
 }
 
-func BenchmarkMustFind(b *testing.B) {
-	opts := []Optional[int]{Empty[int](), Of(0), Of(123)}
+func BenchmarkOfScan(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		_ = MustFind(opts...)
+		sc := bufio.NewScanner(strings.NewReader("abc"))
+		_ = OfScan(sc)
 	}
 }
 
-type mustFindTC[T any] struct {
-	opts        []Optional[T]
-	expectPanic bool
-	expectValue T
+type ofScanTC struct {
+	input  string
+	split  bufio.SplitFunc
+	expect []Optional[string]
 	test.Control
 }
 
-func (tc mustFindTC[T]) Test(t *testing.T) {
-	if tc.expectPanic {
-		assert.Panics(t, func() {
-			MustFind(tc.opts...)
-		}, "expected panic")
-	} else {
-		var value T
-		assert.NotPanics(t, func() {
-			value = MustFind(tc.opts...)
-		}, "unexpected panic")
-		assert.Equal(t, tc.expectValue, value, "unexpected value")
+func (tc ofScanTC) Test(t *testing.T) {
+	sc := bufio.NewScanner(strings.NewReader(tc.input))
+	if tc.split != nil {
+		sc.Split(tc.split)
+	}
+	opts := make([]Optional[string], len(tc.expect))
+	for i := range opts {
+		opts[i] = OfScan(sc)
 	}
+	assert.Equal(t, tc.expect, opts, "unexpected Optionals")
 }
 
-func TestMustFind(t *testing.T) {
+func TestOfScan(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given no int Optionals": mustFindTC[int]{
-			expectPanic: true,
-		},
-		"given empty int Optional": mustFindTC[int]{
-			opts:        []Optional[int]{Empty[int]()},
-			expectPanic: true,
-		},
-		"given an empty int Optional and two non-empty int Optionals": mustFindTC[int]{
-			opts: []Optional[int]{
-				Empty[int](),
-				Of(0),
-				Of(123),
-			},
-			expectValue: 0,
-		},
-		"given no string Optionals": mustFindTC[string]{
-			expectPanic: true,
+		"given reader with no lines": ofScanTC{
+			input:  "",
+			expect: []Optional[string]{Empty[string](), Empty[string]()},
 		},
-		"given empty string Optional": mustFindTC[string]{
-			opts:        []Optional[string]{Empty[string]()},
-			expectPanic: true,
+		"given reader with two lines": ofScanTC{
+			input:  "abc\ndef\n",
+			expect: []Optional[string]{Of("abc"), Of("def"), Empty[string](), Empty[string]()},
 		},
-		"given an empty string Optional and two non-empty string Optionals": mustFindTC[string]{
-			opts: []Optional[string]{
-				Empty[string](),
-				Of("abc"),
-				Of(""),
-			},
-			expectValue: "abc",
+		"given reader with empty line": ofScanTC{
+			input:  "abc\n\ndef",
+			expect: []Optional[string]{Of("abc"), Of(""), Of("def"), Empty[string]()},
 		},
 		// Other test cases...
+		"given reader with words": ofScanTC{
+			input:  "abc def",
+			split:  bufio.ScanWords,
+			expect: []Optional[string]{Of("abc"), Of("def"), Empty[string]()},
+		},
 	})
 }
 
-func BenchmarkOf(b *testing.B) {
+func BenchmarkOfSelect(b *testing.B) {
+	valueCh := make(chan int, 1)
+	done := make(chan struct{})
 	for i := 0; i < b.N; i++ {
-		_ = Of(123)
+		valueCh <- 123
+		_ = OfSelect(valueCh, done)
 	}
 }
 
-type ofTC[T any] struct {
-	value T
+type ofSelectTC[T any] struct {
+	values        []T
+	closeValueCh  bool
+	closeDone     bool
+	expectPresent bool
+	expectValue   T
 	test.Control
 }
 
-func (tc ofTC[T]) Test(t *testing.T) {
-	opt := Of(tc.value)
+func (tc ofSelectTC[T]) Test(t *testing.T) {
+	valueCh := make(chan T, len(tc.values))
+	for _, value := range tc.values {
+		valueCh <- value
+	}
+	if tc.closeValueCh {
+		close(valueCh)
+	}
+	done := make(chan struct{})
+	if tc.closeDone {
+		close(done)
+	}
+	opt := OfSelect(valueCh, done)
 	value, present := opt.Get()
-	assert.Equal(t, tc.value, value, "unexpected value")
-	assert.True(t, present, "expected presence")
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestOf(t *testing.T) {
+func TestOfSelect(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given zero int": ofTC[int]{
-			value: 0,
-		},
-		"given non-zero int": ofTC[int]{
-			value: 123,
-		},
-		"given nil int pointer": ofTC[*int]{
-			value: nil,
-		},
-		"given zero int pointer": ofTC[*int]{
-			value: ptrs.ZeroInt(),
-		},
-		"given non-zero int pointer": ofTC[*int]{
-			value: ptrs.Int(123),
-		},
-		"given zero string": ofTC[string]{
-			value: "",
+		"given closed done channel": ofSelectTC[int]{
+			closeDone:     true,
+			expectPresent: false,
 		},
-		"given non-zero string": ofTC[string]{
-			value: "abc",
+		"given int channel with ready zero value": ofSelectTC[int]{
+			values:        []int{0},
+			expectPresent: true,
+			expectValue:   0,
 		},
-		"given nil string pointer": ofTC[*string]{
-			value: nil,
+		"given int channel with ready non-zero value": ofSelectTC[int]{
+			values:        []int{123},
+			expectPresent: true,
+			expectValue:   123,
 		},
-		"given zero string pointer": ofTC[*string]{
-			value: ptrs.ZeroString(),
+		"given string channel with ready zero value": ofSelectTC[string]{
+			values:        []string{""},
+			expectPresent: true,
+			expectValue:   "",
 		},
-		"given non-zero string pointer": ofTC[*string]{
-			value: ptrs.String("abc"),
+		"given string channel with ready non-zero value": ofSelectTC[string]{
+			values:        []string{"abc"},
+			expectPresent: true,
+			expectValue:   "abc",
 		},
 		// Other test cases...
+		"given closed int channel": ofSelectTC[int]{
+			closeValueCh:  true,
+			expectPresent: false,
+		},
 	})
 }
 
-func BenchmarkOfNillable(b *testing.B) {
-	value := 123
+func BenchmarkOfZeroable(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		_ = OfNillable(&value)
+		_ = OfZeroable(123)
 	}
 }
 
-type ofNillableTC[T any] struct {
+type ofZeroableTC[T any] struct {
 	value         T
 	expectPresent bool
 	test.Control
 }
 
-func (tc ofNillableTC[T]) Test(t *testing.T) {
-	opt := OfNillable(tc.value)
+func (tc ofZeroableTC[T]) Test(t *testing.T) {
+	opt := OfZeroable(tc.value)
 	value, present := opt.Get()
 	assert.Equal(t, tc.value, value, "unexpected value")
 	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
 }
 
-func TestOfNillable(t *testing.T) {
+func TestOfZeroable(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given zero int": ofNillableTC[int]{
+		"given zero int": ofZeroableTC[int]{
 			value:         0,
-			expectPresent: true,
+			expectPresent: false,
 		},
-		"given non-zero int": ofNillableTC[int]{
+		"given non-zero int": ofZeroableTC[int]{
 			value:         123,
 			expectPresent: true,
 		},
-		"given nil int pointer": ofNillableTC[*int]{
+		"given nil int pointer": ofZeroableTC[*int]{
 			value:         nil,
 			expectPresent: false,
 		},
-		"given zero int pointer": ofNillableTC[*int]{
+		"given zero int pointer": ofZeroableTC[*int]{
 			value:         ptrs.ZeroInt(),
 			expectPresent: true,
 		},
-		"given non-zero int pointer": ofNillableTC[*int]{
+		"given non-zero int pointer": ofZeroableTC[*int]{
 			value:         ptrs.Int(123),
 			expectPresent: true,
 		},
-		"given zero string": ofNillableTC[string]{
+		"given zero string": ofZeroableTC[string]{
 			value:         "",
-			expectPresent: true,
+			expectPresent: false,
 		},
-		"given non-zero string": ofNillableTC[string]{
+		"given non-zero string": ofZeroableTC[string]{
 			value:         "abc",
 			expectPresent: true,
 		},
-		"given nil string pointer": ofNillableTC[*string]{
+		"given nil string pointer": ofZeroableTC[*string]{
 			value:         nil,
 			expectPresent: false,
 		},
-		"given zero string pointer": ofNillableTC[*string]{
+		"given zero string pointer": ofZeroableTC[*string]{
 			value:         ptrs.ZeroString(),
 			expectPresent: true,
 		},
-		"given non-zero string pointer": ofNillableTC[*string]{
+		"given non-zero string pointer": ofZeroableTC[*string]{
 			value:         ptrs.String("abc"),
 			expectPresent: true,
 		},
@@ -5630,107 +11676,452 @@ func TestOfNillable(t *testing.T) {
 	})
 }
 
-func BenchmarkOfPointer(b *testing.B) {
+func BenchmarkPairs(b *testing.B) {
+	opts := []Optional[int]{Of(123), Empty[int](), Of(-123)}
 	for i := 0; i < b.N; i++ {
-		_ = OfPointer(123)
+		Pairs(opts...)(func(_ int, _ bool) bool {
+			return true
+		})
 	}
 }
 
-type ofPointerTC[T any] struct {
-	value T
+type pairsTC[T any] struct {
+	opts           []Optional[T]
+	limit          int
+	expectPresents []bool
+	expectValues   []T
 	test.Control
 }
 
-func (tc ofPointerTC[T]) Test(t *testing.T) {
-	opt := OfPointer(tc.value)
-	value, present := opt.Get()
-	assert.NotNil(t, value, "unexpected nil value")
-	assert.Equal(t, tc.value, *value, "unexpected value")
-	assert.True(t, present, "expected presence")
+func (tc pairsTC[T]) Test(t *testing.T) {
+	var (
+		presents []bool
+		values   []T
+	)
+	Pairs(tc.opts...)(func(value T, present bool) bool {
+		presents = append(presents, present)
+		values = append(values, value)
+		return tc.limit <= 0 || len(values) < tc.limit
+	})
+	assert.Equal(t, tc.expectPresents, presents, "unexpected value presences")
+	assert.Equal(t, tc.expectValues, values, "unexpected values")
 }
 
-func TestOfPointer(t *testing.T) {
+func TestPairs(t *testing.T) {
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given zero int": ofPointerTC[int]{
-			value: 0,
+		"given no int Optionals": pairsTC[int]{
+			expectPresents: nil,
+			expectValues:   nil,
 		},
-		"given non-zero int": ofPointerTC[int]{
-			value: 123,
+		"given int Optionals": pairsTC[int]{
+			opts:           []Optional[int]{Of(0), Empty[int](), Of(123)},
+			expectPresents: []bool{true, false, true},
+			expectValues:   []int{0, 0, 123},
 		},
-		"given zero string": ofPointerTC[string]{
-			value: "",
+		"given no string Optionals": pairsTC[string]{
+			expectPresents: nil,
+			expectValues:   nil,
 		},
-		"given non-zero string": ofPointerTC[string]{
-			value: "abc",
+		"given string Optionals": pairsTC[string]{
+			opts:           []Optional[string]{Empty[string](), Of(""), Of("abc")},
+			expectPresents: []bool{false, true, true},
+			expectValues:   []string{"", "", "abc"},
 		},
 		// Other test cases...
+		"given int Optionals with yield stopping early": pairsTC[int]{
+			opts:           []Optional[int]{Of(0), Empty[int](), Of(123)},
+			limit:          2,
+			expectPresents: []bool{true, false},
+			expectValues:   []int{0, 0},
+		},
 	})
 }
 
-func BenchmarkOfZeroable(b *testing.B) {
+func BenchmarkParseCSVField(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		_ = OfZeroable(123)
+		_ = ParseCSVField[int]("123")
 	}
 }
 
-type ofZeroableTC[T any] struct {
-	value         T
+type parseCSVFieldTC[T any] struct {
+	s             string
 	expectPresent bool
+	expectValue   T
 	test.Control
 }
 
-func (tc ofZeroableTC[T]) Test(t *testing.T) {
-	opt := OfZeroable(tc.value)
+func (tc parseCSVFieldTC[T]) Test(t *testing.T) {
+	value, present := ParseCSVField[T](tc.s).Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestParseCSVField(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty field for int": parseCSVFieldTC[int]{
+			s:             "",
+			expectPresent: false,
+		},
+		"given zero-representing field for int": parseCSVFieldTC[int]{
+			s:             "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"given non-zero-representing field for int": parseCSVFieldTC[int]{
+			s:             "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"given erroneous field for int": parseCSVFieldTC[int]{
+			s:             "abc",
+			expectPresent: false,
+		},
+		"given empty field for string": parseCSVFieldTC[string]{
+			s:             "",
+			expectPresent: false,
+		},
+		"given non-empty field for string": parseCSVFieldTC[string]{
+			s:             "abc",
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func TestParseCSVField_roundTrip(t *testing.T) {
+	var buf strings.Builder
+	w := csv.NewWriter(&buf)
+	ints := []Optional[int]{Empty[int](), Of(0), Of(123)}
+	strs := []Optional[string]{Of("abc"), Empty[string](), Of("a,b")}
+	for i := range ints {
+		err := w.Write([]string{ints[i].CSVField(), strs[i].CSVField()})
+		assert.NoError(t, err, "unexpected error writing record")
+	}
+	w.Flush()
+	assert.NoError(t, w.Error(), "unexpected error flushing records")
+
+	records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+	assert.NoError(t, err, "unexpected error reading records")
+	assert.Len(t, records, len(ints), "unexpected number of records")
+	for i, record := range records {
+		assert.Equal(t, ints[i], ParseCSVField[int](record[0]), "unexpected int Optional")
+		assert.Equal(t, strs[i], ParseCSVField[string](record[1]), "unexpected string Optional")
+	}
+}
+
+func BenchmarkParseFirst(b *testing.B) {
+	opt := Of("0x7b")
+	parseDec := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	}
+	parseAny := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 0, 64)
+	}
+	for i := 0; i < b.N; i++ {
+		_ = ParseFirst(opt, parseDec, parseAny)
+	}
+}
+
+type parseFirstTC[T any] struct {
+	opt                  Optional[string]
+	parsers              []func(s string) (T, error)
+	expectParseCallCount uint
+	expectPresent        bool
+	expectValue          T
+	test.Control
+}
+
+func (tc parseFirstTC[T]) Test(t *testing.T) {
+	var parseCallCount uint
+	parsers := make([]func(s string) (T, error), len(tc.parsers))
+	for i, parser := range tc.parsers {
+		parser := parser
+		parsers[i] = func(s string) (T, error) {
+			parseCallCount++
+			return parser(s)
+		}
+	}
+	opt := ParseFirst(tc.opt, parsers...)
 	value, present := opt.Get()
-	assert.Equal(t, tc.value, value, "unexpected value")
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
 	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+	assert.Equalf(t, tc.expectParseCallCount, parseCallCount, "expected parsers to be called %v times", tc.expectParseCallCount)
+}
+
+func TestParseFirst(t *testing.T) {
+	parseDec := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	}
+	parseAny := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 0, 64)
+	}
+	parseDuration := func(s string) (time.Duration, error) {
+		return time.ParseDuration(s)
+	}
+	parseSeconds := func(s string) (time.Duration, error) {
+		seconds, err := strconv.ParseInt(s, 10, 64)
+		return time.Duration(seconds) * time.Second, err
+	}
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty string Optional": parseFirstTC[int64]{
+			opt:                  Empty[string](),
+			parsers:              []func(s string) (int64, error){parseDec, parseAny},
+			expectParseCallCount: 0,
+			expectPresent:        false,
+		},
+		"given non-empty string Optional parsed by first parser": parseFirstTC[int64]{
+			opt:                  Of("123"),
+			parsers:              []func(s string) (int64, error){parseDec, parseAny},
+			expectParseCallCount: 1,
+			expectPresent:        true,
+			expectValue:          123,
+		},
+		"given non-empty string Optional parsed by second parser": parseFirstTC[int64]{
+			opt:                  Of("0x7b"),
+			parsers:              []func(s string) (int64, error){parseDec, parseAny},
+			expectParseCallCount: 2,
+			expectPresent:        true,
+			expectValue:          123,
+		},
+		"given non-empty string Optional not parsed by any parser": parseFirstTC[int64]{
+			opt:                  Of("abc"),
+			parsers:              []func(s string) (int64, error){parseDec, parseAny},
+			expectParseCallCount: 2,
+			expectPresent:        false,
+		},
+		// Other test cases...
+		"given non-empty string Optional and no parsers": parseFirstTC[int64]{
+			opt:                  Of("123"),
+			expectParseCallCount: 0,
+			expectPresent:        false,
+		},
+		"given non-empty string Optional parsed as duration by second parser": parseFirstTC[time.Duration]{
+			opt:                  Of("90"),
+			parsers:              []func(s string) (time.Duration, error){parseDuration, parseSeconds},
+			expectParseCallCount: 2,
+			expectPresent:        true,
+			expectValue:          90 * time.Second,
+		},
+	})
+}
+
+func BenchmarkRecoverAsError(b *testing.B) {
+	opt := Empty[int]()
+	fn := func() {
+		opt.Require()
+	}
+	for i := 0; i < b.N; i++ {
+		if err := RecoverAsError(fn); err == nil {
+			b.Fatal("expected error")
+		}
+	}
+}
+
+type recoverAsErrorTC struct {
+	fn          func()
+	expectError error
+	expectPanic any
+	test.Control
+}
+
+func (tc recoverAsErrorTC) Test(t *testing.T) {
+	if tc.expectPanic != nil {
+		assert.PanicsWithValue(t, tc.expectPanic, func() {
+			_ = RecoverAsError(tc.fn)
+		}, "expected panic")
+		return
+	}
+	var err error
+	assert.NotPanics(t, func() {
+		err = RecoverAsError(tc.fn)
+	}, "unexpected panic")
+	if tc.expectError != nil {
+		assert.ErrorIs(t, err, tc.expectError, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+}
+
+func TestRecoverAsError(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given function requiring empty Optional": recoverAsErrorTC{
+			fn: func() {
+				Empty[int]().Require()
+			},
+			expectError: ErrNotPresent,
+		},
+		"given function requiring non-empty Optional": recoverAsErrorTC{
+			fn: func() {
+				Of(123).Require()
+			},
+		},
+		"given function panicking with other error": recoverAsErrorTC{
+			fn: func() {
+				panic(errFailed)
+			},
+			expectPanic: errFailed,
+		},
+		"given function panicking with other value": recoverAsErrorTC{
+			fn: func() {
+				panic("failed")
+			},
+			expectPanic: "failed",
+		},
+		// Other test cases...
+		"given function calling MustFind with empty Optionals": recoverAsErrorTC{
+			fn: func() {
+				MustFind(Empty[string]())
+			},
+			expectError: ErrNotPresent,
+		},
+		"given function panicking with error wrapping ErrNotPresent": recoverAsErrorTC{
+			fn: func() {
+				panic(fmt.Errorf("abc: %w", ErrNotPresent))
+			},
+			expectError: ErrNotPresent,
+		},
+	})
+}
+
+func BenchmarkRegisterEnum(b *testing.B) {
+	type Color string
+	values := map[int64]Color{0: "red", 1: "green", 2: "blue"}
+	for i := 0; i < b.N; i++ {
+		RegisterEnum(values)
+	}
 }
 
-func TestOfZeroable(t *testing.T) {
+func TestRegisterEnum(t *testing.T) {
+	type Color string
+	type Size string
+
+	values := map[int64]Color{0: "red", 1: "green", 2: "blue"}
+	RegisterEnum(values)
+	// Ensure that modifying the values after registration has no effect
+	values[3] = "purple"
+
 	test.RunCases(t, test.Cases{
 		// Test cases for documented examples
-		"given zero int": ofZeroableTC[int]{
-			value:         0,
-			expectPresent: false,
-		},
-		"given non-zero int": ofZeroableTC[int]{
-			value:         123,
+		"on empty Color Optional given registered ordinal": optionalScanTC[int64, Color]{
+			src:           1,
 			expectPresent: true,
+			expectValue:   "green",
 		},
-		"given nil int pointer": ofZeroableTC[*int]{
-			value:         nil,
-			expectPresent: false,
-		},
-		"given zero int pointer": ofZeroableTC[*int]{
-			value:         ptrs.ZeroInt(),
+		"on empty Color Optional given registered zero ordinal": optionalScanTC[int64, Color]{
+			src:           0,
 			expectPresent: true,
+			expectValue:   "red",
 		},
-		"given non-zero int pointer": ofZeroableTC[*int]{
-			value:         ptrs.Int(123),
+		"on empty Color Optional given unregistered ordinal": optionalScanTC[int64, Color]{
+			src:         3,
+			expectError: true,
+		},
+		"on empty *Color Optional given registered ordinal": optionalScanTC[int64, *Color]{
+			src:           2,
 			expectPresent: true,
+			expectValue:   ptrs.Value[Color]("blue"),
 		},
-		"given zero string": ofZeroableTC[string]{
-			value:         "",
-			expectPresent: false,
+		"on empty *Color Optional given unregistered ordinal": optionalScanTC[int64, *Color]{
+			src:         -1,
+			expectError: true,
 		},
-		"given non-zero string": ofZeroableTC[string]{
-			value:         "abc",
+		// Other test cases...
+		"on empty Size Optional given ordinal for unregistered type": optionalScanTC[int64, Size]{
+			src:           1,
 			expectPresent: true,
+			expectValue:   "1",
 		},
-		"given nil string pointer": ofZeroableTC[*string]{
-			value:         nil,
-			expectPresent: false,
-		},
-		"given zero string pointer": ofZeroableTC[*string]{
-			value:         ptrs.ZeroString(),
+		"on empty Color Optional given string source": optionalScanTC[string, Color]{
+			src:           "purple",
 			expectPresent: true,
+			expectValue:   "purple",
 		},
-		"given non-zero string pointer": ofZeroableTC[*string]{
-			value:         ptrs.String("abc"),
+		"on empty string Optional given ordinal": optionalScanTC[int64, string]{
+			src:           1,
 			expectPresent: true,
+			expectValue:   "1",
+		},
+	})
+}
+
+func BenchmarkRequireAll(b *testing.B) {
+	opts := map[string]Optional[int]{"abc": Of(0), "def": Of(123)}
+	for i := 0; i < b.N; i++ {
+		if err := RequireAll(nil, opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type requireAllTC[T any] struct {
+	errs          map[string]error
+	opts          map[string]Optional[T]
+	expectError   bool
+	expectErrors  []error
+	expectMessage string
+	test.Control
+}
+
+func (tc requireAllTC[T]) Test(t *testing.T) {
+	err := RequireAll(tc.errs, tc.opts)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+		assert.Equal(t, tc.expectMessage, err.Error(), "unexpected error message")
+		for _, expectErr := range tc.expectErrors {
+			assert.ErrorIs(t, err, expectErr, "expected error")
+		}
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+}
+
+func TestRequireAll(t *testing.T) {
+	errAge := errors.New("age is required")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no int Optionals": requireAllTC[int]{
+			expectError: false,
+		},
+		"given non-empty int Optionals": requireAllTC[int]{
+			opts:        map[string]Optional[int]{"abc": Of(0), "def": Of(123)},
+			expectError: false,
+		},
+		"given two empty int Optionals out of three without errors": requireAllTC[int]{
+			opts: map[string]Optional[int]{
+				"def": Empty[int](),
+				"abc": Empty[int](),
+				"ghi": Of(123),
+			},
+			expectError:   true,
+			expectErrors:  []error{ErrNotPresent},
+			expectMessage: "go-optional: value not present: abc\ngo-optional: value not present: def",
+		},
+		"given two empty string Optionals out of three with some errors": requireAllTC[string]{
+			errs: map[string]error{"age": errAge, "email": nil},
+			opts: map[string]Optional[string]{
+				"age":   Empty[string](),
+				"email": Empty[string](),
+				"name":  Of("abc"),
+			},
+			expectError:   true,
+			expectErrors:  []error{errAge, ErrNotPresent},
+			expectMessage: "age is required\ngo-optional: value not present: email",
 		},
 		// Other test cases...
+		"given non-empty string Optionals with errors": requireAllTC[string]{
+			errs:        map[string]error{"age": errAge},
+			opts:        map[string]Optional[string]{"age": Of("")},
+			expectError: false,
+		},
 	})
 }
 
@@ -5799,6 +12190,436 @@ func TestRequireAny(t *testing.T) {
 	})
 }
 
+func BenchmarkRetry(b *testing.B) {
+	fn := func() (Optional[int], error) {
+		return Of(123), nil
+	}
+	for i := 0; i < b.N; i++ {
+		if _, err := Retry(3, fn); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type retryResult[T any] struct {
+	opt Optional[T]
+	err error
+}
+
+type retryTC[T any] struct {
+	attempts        int
+	results         []retryResult[T]
+	expectCallCount int
+	expectError     error
+	expectPresent   bool
+	expectValue     T
+	test.Control
+}
+
+func (tc retryTC[T]) Test(t *testing.T) {
+	var callCount int
+	opt, err := Retry(tc.attempts, func() (Optional[T], error) {
+		result := tc.results[callCount]
+		callCount++
+		return result.opt, result.err
+	})
+	if tc.expectError != nil {
+		assert.ErrorIs(t, err, tc.expectError, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+	assert.Equalf(t, tc.expectCallCount, callCount, "expected function to be called %v times", tc.expectCallCount)
+}
+
+func TestRetry(t *testing.T) {
+	err1 := errors.New("attempt 1 failed")
+	err2 := errors.New("attempt 2 failed")
+	err3 := errors.New("attempt 3 failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given zero attempts": retryTC[int]{
+			attempts:        0,
+			expectCallCount: 0,
+			expectPresent:   false,
+		},
+		"given attempts where first succeeds": retryTC[int]{
+			attempts:        3,
+			results:         []retryResult[int]{{opt: Of(0)}},
+			expectCallCount: 1,
+			expectPresent:   true,
+			expectValue:     0,
+		},
+		"given attempts where third succeeds": retryTC[int]{
+			attempts:        3,
+			results:         []retryResult[int]{{opt: Empty[int]()}, {err: err2}, {opt: Of(123)}},
+			expectCallCount: 3,
+			expectPresent:   true,
+			expectValue:     123,
+		},
+		"given attempts where all are empty": retryTC[int]{
+			attempts:        3,
+			results:         []retryResult[int]{{opt: Empty[int]()}, {opt: Empty[int]()}, {opt: Empty[int]()}},
+			expectCallCount: 3,
+			expectPresent:   false,
+		},
+		"given attempts where all error": retryTC[int]{
+			attempts:        3,
+			results:         []retryResult[int]{{err: err1}, {err: err2}, {err: err3}},
+			expectCallCount: 3,
+			expectError:     err3,
+		},
+		"given attempts where last errors": retryTC[string]{
+			attempts:        2,
+			results:         []retryResult[string]{{opt: Empty[string]()}, {err: err2}},
+			expectCallCount: 2,
+			expectError:     err2,
+		},
+		"given attempts where last is empty after error": retryTC[string]{
+			attempts:        2,
+			results:         []retryResult[string]{{err: err1}, {opt: Empty[string]()}},
+			expectCallCount: 2,
+			expectPresent:   false,
+		},
+		"given attempts where one errors with non-empty Optional": retryTC[string]{
+			attempts:        2,
+			results:         []retryResult[string]{{opt: Of("abc"), err: err1}, {opt: Of("def")}},
+			expectCallCount: 2,
+			expectPresent:   true,
+			expectValue:     "def",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkRound(b *testing.B) {
+	opt := Of(3.14159)
+	for i := 0; i < b.N; i++ {
+		_ = Round(opt, 2)
+	}
+}
+
+type roundTC[T ~float32 | ~float64] struct {
+	opt    Optional[T]
+	places int
+	expect Optional[T]
+	test.Control
+}
+
+func (tc roundTC[T]) Test(t *testing.T) {
+	actual := Round(tc.opt, tc.places)
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestRound(t *testing.T) {
+	type Float64 float64
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty float64 Optional": roundTC[float64]{
+			opt:    Empty[float64](),
+			places: 2,
+			expect: Empty[float64](),
+		},
+		"given non-empty float64 Optional with zero value": roundTC[float64]{
+			opt:    Of(0.0),
+			places: 2,
+			expect: Of(0.0),
+		},
+		"given non-empty float64 Optional with non-zero value": roundTC[float64]{
+			opt:    Of(3.14159),
+			places: 2,
+			expect: Of(3.14),
+		},
+		"given non-empty float64 Optional with negative value": roundTC[float64]{
+			opt:    Of(-3.14159),
+			places: 3,
+			expect: Of(-3.142),
+		},
+		// Other test cases...
+		"given non-empty float32 Optional with non-zero value": roundTC[float32]{
+			opt:    Of[float32](3.14159),
+			places: 2,
+			expect: Of[float32](3.14),
+		},
+		"given non-empty Float64 Optional with non-zero value": roundTC[Float64]{
+			opt:    Of[Float64](3.14159),
+			places: 2,
+			expect: Of[Float64](3.14),
+		},
+		"given non-empty float64 Optional with half value": roundTC[float64]{
+			opt:    Of(2.5),
+			places: 0,
+			expect: Of(3.0),
+		},
+		"given non-empty float64 Optional with negative half value": roundTC[float64]{
+			opt:    Of(-2.5),
+			places: 0,
+			expect: Of(-3.0),
+		},
+		"given non-empty float64 Optional and negative places": roundTC[float64]{
+			opt:    Of(1234.5),
+			places: -2,
+			expect: Of(1200.0),
+		},
+		"given non-empty float64 Optional with infinite value": roundTC[float64]{
+			opt:    Of(math.Inf(1)),
+			places: 2,
+			expect: Of(math.Inf(1)),
+		},
+		"given non-empty float64 Optional with max value": roundTC[float64]{
+			opt:    Of(math.MaxFloat64),
+			places: 2,
+			expect: Of(math.MaxFloat64),
+		},
+	})
+}
+
+func BenchmarkScanners(b *testing.B) {
+	var x, y, z Optional[int]
+	for i := 0; i < b.N; i++ {
+		_ = Scanners(&x, &y, &z)
+	}
+}
+
+// scannersConnector is a driver.Connector for a fake database that returns the same columns and rows for any query.
+type scannersConnector struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (c scannersConnector) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+func (c scannersConnector) Close() error {
+	return nil
+}
+
+func (c scannersConnector) Connect(context.Context) (driver.Conn, error) {
+	return c, nil
+}
+
+func (c scannersConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c scannersConnector) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec not supported")
+}
+
+func (c scannersConnector) NumInput() int {
+	return 0
+}
+
+func (c scannersConnector) Prepare(string) (driver.Stmt, error) {
+	return c, nil
+}
+
+func (c scannersConnector) Query([]driver.Value) (driver.Rows, error) {
+	return &scannersRows{columns: c.columns, rows: c.rows}, nil
+}
+
+// scannersRows is a driver.Rows that iterates over a fixed set of rows.
+type scannersRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *scannersRows) Close() error {
+	return nil
+}
+
+func (r *scannersRows) Columns() []string {
+	return r.columns
+}
+
+func (r *scannersRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+type scannersTC[T any] struct {
+	row     []driver.Value
+	expect  []Optional[T]
+	prefill []Optional[T]
+	test.Control
+}
+
+func (tc scannersTC[T]) Test(t *testing.T) {
+	columns := make([]string, len(tc.row))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%d", i)
+	}
+	sqlDB := sql.OpenDB(scannersConnector{columns: columns, rows: [][]driver.Value{tc.row}})
+	defer func() {
+		_ = sqlDB.Close()
+	}()
+
+	opts := make([]Optional[T], len(tc.row))
+	copy(opts, tc.prefill)
+	dests := make([]*Optional[T], len(opts))
+	for i := range opts {
+		dests[i] = &opts[i]
+	}
+	scanners := Scanners(dests...)
+	if !assert.Len(t, scanners, len(dests), "unexpected number of scanners") {
+		return
+	}
+	for i, scanner := range scanners {
+		assert.Same(t, dests[i], scanner, "unexpected scanner")
+	}
+
+	err := sqlDB.QueryRow("SELECT *").Scan(scanners...)
+	if !assert.NoError(t, err, "unexpected error") {
+		return
+	}
+	assert.Equal(t, tc.expect, opts, "unexpected Optionals")
+}
+
+func TestScanners(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given int Optionals": scannersTC[int]{
+			row:    []driver.Value{int64(0), nil, int64(123)},
+			expect: []Optional[int]{Of(0), Empty[int](), Of(123)},
+		},
+		"given string Optionals": scannersTC[string]{
+			row:    []driver.Value{"", nil, "abc"},
+			expect: []Optional[string]{Of(""), Empty[string](), Of("abc")},
+		},
+		// Other test cases...
+		"given non-empty int Optionals": scannersTC[int]{
+			row:     []driver.Value{nil, int64(456)},
+			prefill: []Optional[int]{Of(123), Of(123)},
+			expect:  []Optional[int]{Empty[int](), Of(456)},
+		},
+	})
+}
+
+func TestScanners_withNoOptionals(t *testing.T) {
+	scanners := Scanners[int]()
+	assert.NotNil(t, scanners, "unexpected nil scanners")
+	assert.Empty(t, scanners, "unexpected scanners")
+}
+
+func BenchmarkSelect(b *testing.B) {
+	x, y := Of(123), Empty[int]()
+	for i := 0; i < b.N; i++ {
+		_ = Select(i%2 == 0, x, y)
+	}
+}
+
+type selectTC[T any] struct {
+	useFirst bool
+	a        Optional[T]
+	b        Optional[T]
+	expect   Optional[T]
+	test.Control
+}
+
+func (tc selectTC[T]) Test(t *testing.T) {
+	opt := Select(tc.useFirst, tc.a, tc.b)
+	assert.Equal(t, tc.expect, opt, "unexpected Optional")
+}
+
+func TestSelect(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given true with non-empty and empty int Optionals": selectTC[int]{
+			useFirst: true,
+			a:        Of(123),
+			b:        Empty[int](),
+			expect:   Of(123),
+		},
+		"given false with non-empty and empty int Optionals": selectTC[int]{
+			useFirst: false,
+			a:        Of(123),
+			b:        Empty[int](),
+			expect:   Empty[int](),
+		},
+		"given true with empty and non-empty string Optionals": selectTC[string]{
+			useFirst: true,
+			a:        Empty[string](),
+			b:        Of("abc"),
+			expect:   Empty[string](),
+		},
+		"given false with empty and non-empty string Optionals": selectTC[string]{
+			useFirst: false,
+			a:        Empty[string](),
+			b:        Of("abc"),
+			expect:   Of("abc"),
+		},
+		// Other test cases...
+		"given true with two non-empty int Optionals": selectTC[int]{
+			useFirst: true,
+			a:        Of(0),
+			b:        Of(123),
+			expect:   Of(0),
+		},
+		"given false with two non-empty int Optionals": selectTC[int]{
+			useFirst: false,
+			a:        Of(0),
+			b:        Of(123),
+			expect:   Of(123),
+		},
+	})
+}
+
+func BenchmarkSummary(b *testing.B) {
+	opts := []Optional[int]{Of(123), Empty[int](), Of(-123)}
+	for i := 0; i < b.N; i++ {
+		_ = Summary(opts...)
+	}
+}
+
+type summaryTC[T any] struct {
+	opts   []Optional[T]
+	expect string
+	test.Control
+}
+
+func (tc summaryTC[T]) Test(t *testing.T) {
+	actual := Summary(tc.opts...)
+	assert.Equal(t, tc.expect, actual, "unexpected summary")
+}
+
+func TestSummary(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given no int Optionals": summaryTC[int]{
+			expect: "0/0 present",
+		},
+		"given only empty int Optionals": summaryTC[int]{
+			opts:   []Optional[int]{Empty[int](), Empty[int](), Empty[int]()},
+			expect: "0/3 present ...",
+		},
+		"given mixed int Optionals": summaryTC[int]{
+			opts:   []Optional[int]{Of(0), Empty[int](), Of(123), Of(-123), Empty[int]()},
+			expect: "3/5 present P.PP.",
+		},
+		"given only non-empty int Optionals": summaryTC[int]{
+			opts:   []Optional[int]{Of(0), Of(123)},
+			expect: "2/2 present PP",
+		},
+		"given no string Optionals": summaryTC[string]{
+			expect: "0/0 present",
+		},
+		"given mixed string Optionals": summaryTC[string]{
+			opts:   []Optional[string]{Empty[string](), Of(""), Of("abc")},
+			expect: "2/3 present .PP",
+		},
+		// Other test cases...
+	})
+}
+
 func BenchmarkTryFlatMap(b *testing.B) {
 	toString := func(value int) (Optional[string], error) {
 		if value == 0 {
@@ -5987,3 +12808,94 @@ func TestTryMap(t *testing.T) {
 		// Other test cases...
 	})
 }
+
+func BenchmarkZip3(b *testing.B) {
+	x := Of(123)
+	y := Of("abc")
+	z := Of(true)
+	for i := 0; i < b.N; i++ {
+		_ = Zip3(x, y, z)
+	}
+}
+
+type zip3TC[A, B, C any] struct {
+	a             Optional[A]
+	b             Optional[B]
+	c             Optional[C]
+	expectPresent bool
+	test.Control
+}
+
+func (tc zip3TC[A, B, C]) Test(t *testing.T) {
+	opt := Zip3(tc.a, tc.b, tc.c)
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+	if tc.expectPresent {
+		assert.Equal(t, tc.a.value, value.A, "unexpected first value")
+		assert.Equal(t, tc.b.value, value.B, "unexpected second value")
+		assert.Equal(t, tc.c.value, value.C, "unexpected third value")
+	} else {
+		assert.Zero(t, value, "unexpected value")
+	}
+}
+
+func TestZip3(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given three empty Optionals": zip3TC[int, string, bool]{
+			a:             Empty[int](),
+			b:             Empty[string](),
+			c:             Empty[bool](),
+			expectPresent: false,
+		},
+		"given only first non-empty Optional": zip3TC[int, string, bool]{
+			a:             Of(123),
+			b:             Empty[string](),
+			c:             Empty[bool](),
+			expectPresent: false,
+		},
+		"given only second non-empty Optional": zip3TC[int, string, bool]{
+			a:             Empty[int](),
+			b:             Of("abc"),
+			c:             Empty[bool](),
+			expectPresent: false,
+		},
+		"given only third non-empty Optional": zip3TC[int, string, bool]{
+			a:             Empty[int](),
+			b:             Empty[string](),
+			c:             Of(true),
+			expectPresent: false,
+		},
+		"given only first and second non-empty Optionals": zip3TC[int, string, bool]{
+			a:             Of(123),
+			b:             Of("abc"),
+			c:             Empty[bool](),
+			expectPresent: false,
+		},
+		"given only first and third non-empty Optionals": zip3TC[int, string, bool]{
+			a:             Of(123),
+			b:             Empty[string](),
+			c:             Of(true),
+			expectPresent: false,
+		},
+		"given only second and third non-empty Optionals": zip3TC[int, string, bool]{
+			a:             Empty[int](),
+			b:             Of("abc"),
+			c:             Of(true),
+			expectPresent: false,
+		},
+		"given three non-empty Optionals with zero values": zip3TC[int, string, bool]{
+			a:             Of(0),
+			b:             Of(""),
+			c:             Of(false),
+			expectPresent: true,
+		},
+		"given three non-empty Optionals with non-zero values": zip3TC[int, string, bool]{
+			a:             Of(123),
+			b:             Of("abc"),
+			c:             Of(true),
+			expectPresent: true,
+		},
+		// Other test cases...
+	})
+}
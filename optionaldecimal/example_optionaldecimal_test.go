@@ -0,0 +1,50 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optionaldecimal
+
+import (
+	"fmt"
+	"github.com/neocotic/go-optional"
+	"github.com/shopspring/decimal"
+)
+
+func ExampleDecimal_Scan() {
+	var d Decimal
+	fmt.Println(d.Scan(nil), d.Optional())
+	fmt.Println(d.Scan("123.456"), d.Optional())
+	fmt.Println(d.Scan([]byte("0.1000000000000000000000000001")), d.Optional())
+	fmt.Println(d.Scan(int64(123)), d.Optional())
+
+	// Output:
+	// <nil> <empty>
+	// <nil> 123.456
+	// <nil> 0.1000000000000000000000000001
+	// <nil> 123
+}
+
+func ExampleDecimal_Value() {
+	fmt.Println(Decimal{}.Value())
+	fmt.Println(Decimal(optional.Of(decimal.RequireFromString("123.456"))).Value())
+
+	// Output:
+	// <nil> <nil>
+	// 123.456 <nil>
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package optionaldecimal provides support for scanning and valuing optional.Optional containing decimal.Decimal values
+// from the github.com/shopspring/decimal module, which the optional package itself does not depend on.
+//
+// Since decimal.Decimal already implements both sql.Scanner and driver.Valuer, an optional.Optional[decimal.Decimal]
+// can already be scanned and valued natively. Decimal only differs in that it consistently parses src from its textual
+// representation, when available, in order to preserve precision, and is provided by its own module so that the
+// optional module does not need to depend on github.com/shopspring/decimal.
+package optionaldecimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"github.com/neocotic/go-optional"
+	"github.com/shopspring/decimal"
+)
+
+// Decimal is an optional.Optional containing a decimal.Decimal value that can be passed directly to sql.Rows.Scan and
+// used as a query argument.
+//
+// A Decimal can be converted to and from an optional.Optional at any time.
+type Decimal optional.Optional[decimal.Decimal]
+
+var (
+	_ driver.Valuer = Decimal{}
+	_ sql.Scanner   = (*Decimal)(nil)
+)
+
+// Optional returns the Decimal as an optional.Optional.
+func (d Decimal) Optional() optional.Optional[decimal.Decimal] {
+	return optional.Optional[decimal.Decimal](d)
+}
+
+// Scan assigns the given value from a database driver into the Decimal, where possible.
+//
+// If src is nil, the Decimal will be empty, otherwise it will have a decimal.Decimal value present. In order to
+// preserve precision, src is parsed from its textual representation when it is a string or []byte. Otherwise, src can
+// be a float64 or int64.
+//
+// An error is returned if src cannot be parsed as a decimal.Decimal or is of an unsupported type.
+func (d *Decimal) Scan(src any) error {
+	var (
+		value decimal.Decimal
+		err   error
+	)
+	switch s := src.(type) {
+	case nil:
+		*d = Decimal{}
+		return nil
+	case string:
+		if value, err = decimal.NewFromString(s); err != nil {
+			return fmtConversionErr(src, s, err)
+		}
+	case []byte:
+		if value, err = decimal.NewFromString(string(s)); err != nil {
+			return fmtConversionErr(src, string(s), err)
+		}
+	case float64:
+		value = decimal.NewFromFloat(s)
+	case int64:
+		value = decimal.NewFromInt(s)
+	default:
+		return fmt.Errorf("go-optional: couldn't scan %T value into type %T", src, value)
+	}
+	*d = Decimal(optional.Of(value))
+	return nil
+}
+
+// Value returns a driver.Value for the value of the Decimal, if present, otherwise returns nil.
+//
+// In order to preserve precision, the decimal.Decimal value is returned as its string representation.
+func (d Decimal) Value() (driver.Value, error) {
+	value, present := d.Optional().Get()
+	if !present {
+		return nil, nil
+	}
+	return value.String(), nil
+}
+
+// fmtConversionErr returns a formatted error for when a value scanned from a database cannot be converted to a
+// decimal.Decimal.
+func fmtConversionErr(src any, srcStr string, err error) error {
+	return fmt.Errorf("go-optional: couldn't scan %T value (%q) into type decimal.Decimal: %w", src, srcStr, err)
+}
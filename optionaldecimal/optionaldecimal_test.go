@@ -0,0 +1,181 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optionaldecimal
+
+import (
+	"database/sql/driver"
+	"github.com/neocotic/go-optional"
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func BenchmarkDecimal_Optional(b *testing.B) {
+	d := Decimal(optional.Of(decimal.RequireFromString("123.456")))
+	for i := 0; i < b.N; i++ {
+		d.Optional()
+	}
+}
+
+func TestDecimal_Optional(t *testing.T) {
+	expect := optional.Of(decimal.RequireFromString("123.456"))
+	assert.Equal(t, expect, Decimal(expect).Optional(), "unexpected value")
+	assert.Equal(t, optional.Empty[decimal.Decimal](), Decimal{}.Optional(), "unexpected value")
+}
+
+func BenchmarkDecimal_Scan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var d Decimal
+		if err := d.Scan("123.456"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type scanTC struct {
+	src           any
+	expectError   bool
+	expectPresent bool
+	expectValue   string
+	test.Control
+}
+
+func (tc scanTC) Test(t *testing.T) {
+	var d Decimal
+	err := d.Scan(tc.src)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	value, present := d.Optional().Get()
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+	if tc.expectPresent {
+		assert.Equal(t, tc.expectValue, value.String(), "unexpected value")
+	}
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given nil": scanTC{
+			src:           nil,
+			expectPresent: false,
+		},
+		"given string": scanTC{
+			src:           "123.456",
+			expectPresent: true,
+			expectValue:   "123.456",
+		},
+		"given string with high precision": scanTC{
+			src:           "0.1000000000000000000000000001",
+			expectPresent: true,
+			expectValue:   "0.1000000000000000000000000001",
+		},
+		"given erroneous string": scanTC{
+			src:         "abc",
+			expectError: true,
+		},
+		"given bytes": scanTC{
+			src:           []byte("123.456"),
+			expectPresent: true,
+			expectValue:   "123.456",
+		},
+		"given erroneous bytes": scanTC{
+			src:         []byte("abc"),
+			expectError: true,
+		},
+		"given float64": scanTC{
+			src:           123.456,
+			expectPresent: true,
+			expectValue:   "123.456",
+		},
+		"given int64": scanTC{
+			src:           int64(123),
+			expectPresent: true,
+			expectValue:   "123",
+		},
+		"given unsupported type": scanTC{
+			src:         true,
+			expectError: true,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkDecimal_Value(b *testing.B) {
+	d := Decimal(optional.Of(decimal.RequireFromString("123.456")))
+	for i := 0; i < b.N; i++ {
+		if _, err := d.Value(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type valueTC struct {
+	d      Decimal
+	expect driver.Value
+	test.Control
+}
+
+func (tc valueTC) Test(t *testing.T) {
+	value, err := tc.d.Value()
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expect, value, "unexpected value")
+}
+
+func TestDecimal_Value(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty Optional": valueTC{
+			d:      Decimal{},
+			expect: nil,
+		},
+		"given non-empty Optional with zero value": valueTC{
+			d:      Decimal(optional.Of(decimal.Decimal{})),
+			expect: "0",
+		},
+		"given non-empty Optional with non-zero value": valueTC{
+			d:      Decimal(optional.Of(decimal.RequireFromString("123.456"))),
+			expect: "123.456",
+		},
+		// Other test cases...
+	})
+}
+
+func TestDecimal_Scan_roundTrip(t *testing.T) {
+	var d Decimal
+	err := d.Scan("123.456")
+	assert.NoError(t, err, "unexpected error scanning value")
+	value, err := d.Value()
+	assert.NoError(t, err, "unexpected error valuing Decimal")
+	assert.Equal(t, "123.456", value, "unexpected value")
+}
+
+func TestOptional_native(t *testing.T) {
+	var opt optional.Optional[decimal.Decimal]
+	err := opt.Scan("0.1000000000000000000000000001")
+	assert.NoError(t, err, "unexpected error scanning value")
+	value, err := opt.Value()
+	assert.NoError(t, err, "unexpected error valuing Optional")
+	assert.Equal(t, "0.1000000000000000000000000001", value, "unexpected value")
+}
@@ -0,0 +1,15 @@
+module github.com/neocotic/go-optional/optionalpflag
+
+go 1.21
+
+require (
+	github.com/neocotic/go-optional v0.1.2
+	github.com/spf13/pflag v1.0.5
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
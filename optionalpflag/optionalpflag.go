@@ -0,0 +1,110 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package optionalpflag provides support for using optional.Optional as a flag value within a pflag.FlagSet from the
+// github.com/spf13/pflag module (e.g. as used by github.com/spf13/cobra), which the optional package itself does not
+// depend on.
+//
+// An Optional bound to a flag will be empty unless the flag is provided, in which case it will have the parsed value
+// present.
+package optionalpflag
+
+import (
+	"errors"
+	"github.com/neocotic/go-optional"
+	"github.com/spf13/pflag"
+	"reflect"
+)
+
+// Value is a pflag.Value that is backed by an optional.Optional.
+type Value[T any] struct {
+	// opt is a pointer to the optional.Optional that is assigned when set.
+	opt *optional.Optional[T]
+}
+
+var _ pflag.Value = (*Value[any])(nil)
+
+// New returns a Value that assigns any value set to the optional.Optional that the given pointer points to.
+//
+// The value of T can be any type but only the same types that optional.Optional.Scan supports when given a string are
+// supported.
+//
+// If p is nil, any attempt to set a value will result in an error.
+func New[T any](p *optional.Optional[T]) *Value[T] {
+	return &Value[T]{opt: p}
+}
+
+// Set parses the given string and assigns it as the value of the underlying optional.Optional, making it present.
+//
+// An error is returned if the Value was created with a nil pointer or s cannot be parsed into T.
+func (v *Value[T]) Set(s string) error {
+	if v.opt == nil {
+		return errors.New("go-optional: cannot set value of nil Optional pointer")
+	}
+	var opt optional.Optional[T]
+	if err := opt.Scan(s); err != nil {
+		return err
+	}
+	*v.opt = opt
+	return nil
+}
+
+// String returns a string representation of the value of the underlying optional.Optional, if present, otherwise an
+// empty string.
+func (v *Value[T]) String() string {
+	if v.opt == nil || v.opt.IsEmpty() {
+		return ""
+	}
+	return v.opt.String()
+}
+
+// Type returns the name of the type of value, which is the kind of T if it's a basic kind (e.g. "int", "bool",
+// "string"), otherwise the name of T itself.
+func (v *Value[T]) Type() string {
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+	switch rt.Kind() {
+	case reflect.Bool,
+		reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.String,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rt.Kind().String()
+	default:
+		return rt.String()
+	}
+}
+
+// Var defines a flag with the specified name and usage string within the given pflag.FlagSet, which assigns any value
+// provided to the optional.Optional that the given pointer points to.
+//
+// If T is a bool, the flag can be provided without a value (e.g. "--verbose"), in which case true is assigned.
+func Var[T any](fs *pflag.FlagSet, p *optional.Optional[T], name, usage string) *pflag.Flag {
+	return VarP(fs, p, name, "", usage)
+}
+
+// VarP is like Var, but accepts a shorthand letter that can be used after a single dash.
+func VarP[T any](fs *pflag.FlagSet, p *optional.Optional[T], name, shorthand, usage string) *pflag.Flag {
+	v := New(p)
+	flag := fs.VarPF(v, name, shorthand, usage)
+	if v.Type() == reflect.Bool.String() {
+		flag.NoOptDefVal = "true"
+	}
+	return flag
+}
@@ -0,0 +1,305 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optionalpflag
+
+import (
+	"github.com/neocotic/go-optional"
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func BenchmarkValue_Set(b *testing.B) {
+	var opt optional.Optional[int]
+	v := New(&opt)
+	for i := 0; i < b.N; i++ {
+		if err := v.Set("123"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type valueSetTC[T any] struct {
+	s             string
+	expectError   bool
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc valueSetTC[T]) Test(t *testing.T) {
+	var opt optional.Optional[T]
+	err := New(&opt).Set(tc.s)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestValue_Set(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given bool": valueSetTC[bool]{
+			s:             "true",
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"given erroneous bool": valueSetTC[bool]{
+			s:           "abc",
+			expectError: true,
+		},
+		"given float64": valueSetTC[float64]{
+			s:             "1.5",
+			expectPresent: true,
+			expectValue:   1.5,
+		},
+		"given int": valueSetTC[int]{
+			s:             "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"given zero int": valueSetTC[int]{
+			s:             "0",
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"given erroneous int": valueSetTC[int]{
+			s:           "abc",
+			expectError: true,
+		},
+		"given string": valueSetTC[string]{
+			s:             "abc",
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		"given zero string": valueSetTC[string]{
+			s:             "",
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"given uint": valueSetTC[uint]{
+			s:             "123",
+			expectPresent: true,
+			expectValue:   123,
+		},
+		// Other test cases...
+	})
+}
+
+func TestValue_Set_nilPointer(t *testing.T) {
+	v := New[int](nil)
+	assert.NotPanics(t, func() {
+		err := v.Set("123")
+		assert.Error(t, err, "expected error")
+	}, "unexpected panic")
+	assert.Equal(t, "", v.String(), "unexpected value")
+}
+
+func BenchmarkValue_String(b *testing.B) {
+	opt := optional.Of(123)
+	v := New(&opt)
+	for i := 0; i < b.N; i++ {
+		_ = v.String()
+	}
+}
+
+type valueStringTC[T any] struct {
+	opt    optional.Optional[T]
+	expect string
+	test.Control
+}
+
+func (tc valueStringTC[T]) Test(t *testing.T) {
+	actual := New(&tc.opt).String()
+	assert.Equal(t, tc.expect, actual, "unexpected string representation")
+}
+
+func TestValue_String(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Optional": valueStringTC[int]{
+			opt:    optional.Empty[int](),
+			expect: "",
+		},
+		"on non-empty int Optional with zero value": valueStringTC[int]{
+			opt:    optional.Of(0),
+			expect: "0",
+		},
+		"on non-empty int Optional with non-zero value": valueStringTC[int]{
+			opt:    optional.Of(123),
+			expect: "123",
+		},
+		"on empty string Optional": valueStringTC[string]{
+			opt:    optional.Empty[string](),
+			expect: "",
+		},
+		"on non-empty string Optional with non-zero value": valueStringTC[string]{
+			opt:    optional.Of("abc"),
+			expect: "abc",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkValue_Type(b *testing.B) {
+	var opt optional.Optional[int]
+	v := New(&opt)
+	for i := 0; i < b.N; i++ {
+		_ = v.Type()
+	}
+}
+
+type valueTypeTC[T any] struct {
+	expect string
+	test.Control
+}
+
+func (tc valueTypeTC[T]) Test(t *testing.T) {
+	var opt optional.Optional[T]
+	actual := New(&opt).Type()
+	assert.Equal(t, tc.expect, actual, "unexpected type")
+}
+
+func TestValue_Type(t *testing.T) {
+	type String string
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"for bool": valueTypeTC[bool]{
+			expect: "bool",
+		},
+		"for float64": valueTypeTC[float64]{
+			expect: "float64",
+		},
+		"for int": valueTypeTC[int]{
+			expect: "int",
+		},
+		"for int64": valueTypeTC[int64]{
+			expect: "int64",
+		},
+		"for string": valueTypeTC[string]{
+			expect: "string",
+		},
+		"for string-kind": valueTypeTC[String]{
+			expect: "string",
+		},
+		"for uint": valueTypeTC[uint]{
+			expect: "uint",
+		},
+		"for time.Time": valueTypeTC[time.Time]{
+			expect: "time.Time",
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkVar(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var opt optional.Optional[int]
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		Var(fs, &opt, "number", "a number")
+		if err := fs.Parse([]string{"--number=123"}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type varTC[T any] struct {
+	args          []string
+	expectError   bool
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc varTC[T]) Test(t *testing.T) {
+	var opt optional.Optional[T]
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	VarP(fs, &opt, "flag", "f", "a flag")
+	err := fs.Parse(tc.args)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestVar(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"with bool flag not provided": varTC[bool]{
+			args:          nil,
+			expectPresent: false,
+		},
+		"with bool flag provided without value": varTC[bool]{
+			args:          []string{"--flag"},
+			expectPresent: true,
+			expectValue:   true,
+		},
+		"with bool flag provided with false value": varTC[bool]{
+			args:          []string{"--flag=false"},
+			expectPresent: true,
+			expectValue:   false,
+		},
+		"with int flag not provided": varTC[int]{
+			args:          []string{"abc"},
+			expectPresent: false,
+		},
+		"with int flag provided with zero value": varTC[int]{
+			args:          []string{"--flag", "0"},
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"with int flag provided using shorthand": varTC[int]{
+			args:          []string{"-f", "123"},
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"with int flag provided with erroneous value": varTC[int]{
+			args:        []string{"--flag", "abc"},
+			expectError: true,
+		},
+		"with string flag not provided": varTC[string]{
+			args:          nil,
+			expectPresent: false,
+		},
+		"with string flag provided with zero value": varTC[string]{
+			args:          []string{"--flag="},
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"with string flag provided with non-zero value": varTC[string]{
+			args:          []string{"--flag", "abc"},
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// PatchOptional is an Optional that treats an explicit JSON null value as absent when unmarshalling, which can be
+// especially useful for HTTP PATCH semantics where null is intended to clear a field.
+//
+// Unlike Optional, whose UnmarshalJSON treats null as having a value present (i.e. nil or the zero value for T), the
+// UnmarshalJSON of PatchOptional will result in an empty Optional when given null. As with Optional, a missing JSON
+// field will also result in an empty Optional as UnmarshalJSON is never called.
+//
+// A PatchOptional can be converted to and from an Optional at any time.
+type PatchOptional[T any] Optional[T]
+
+var (
+	_ json.Marshaler   = (*PatchOptional[any])(nil)
+	_ json.Unmarshaler = (*PatchOptional[any])(nil)
+)
+
+// MarshalJSON marshals the value of the PatchOptional into JSON, if present, otherwise returns a null-like value.
+//
+// An error is returned if unable to marshal the value.
+func (p PatchOptional[T]) MarshalJSON() ([]byte, error) {
+	return Optional[T](p).MarshalJSON()
+}
+
+// Optional returns the PatchOptional as an Optional.
+func (p PatchOptional[T]) Optional() Optional[T] {
+	return Optional[T](p)
+}
+
+// UnmarshalJSON unmarshalls the JSON data provided as the value for the PatchOptional. If data is null, the
+// PatchOptional will be empty, otherwise it treats the PatchOptional as having a value even though that value may still
+// be the zero value for T.
+//
+// An error is returned if unable to unmarshal data.
+func (p *PatchOptional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*p = PatchOptional[T]{}
+		return nil
+	}
+	return (*Optional[T])(p).UnmarshalJSON(data)
+}
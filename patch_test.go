@@ -0,0 +1,193 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func BenchmarkPatchOptional_MarshalJSON(b *testing.B) {
+	opt := PatchOptional[int](Of(123))
+	for i := 0; i < b.N; i++ {
+		if _, err := opt.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type patchOptionalMarshalJSONTC[T any] struct {
+	opt    PatchOptional[T]
+	expect string
+	test.Control
+}
+
+func (tc patchOptionalMarshalJSONTC[T]) Test(t *testing.T) {
+	data, err := json.Marshal(tc.opt)
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, tc.expect, string(data), "unexpected JSON")
+}
+
+func TestPatchOptional_MarshalJSON(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int PatchOptional": patchOptionalMarshalJSONTC[int]{
+			opt:    PatchOptional[int]{},
+			expect: "null",
+		},
+		"on non-empty int PatchOptional with zero value": patchOptionalMarshalJSONTC[int]{
+			opt:    PatchOptional[int](Of(0)),
+			expect: "0",
+		},
+		"on non-empty int PatchOptional with non-zero value": patchOptionalMarshalJSONTC[int]{
+			opt:    PatchOptional[int](Of(123)),
+			expect: "123",
+		},
+		"on empty string PatchOptional": patchOptionalMarshalJSONTC[string]{
+			opt:    PatchOptional[string]{},
+			expect: "null",
+		},
+		"on non-empty string PatchOptional with non-zero value": patchOptionalMarshalJSONTC[string]{
+			opt:    PatchOptional[string](Of("abc")),
+			expect: `"abc"`,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkPatchOptional_Optional(b *testing.B) {
+	opt := PatchOptional[int](Of(123))
+	for i := 0; i < b.N; i++ {
+		_ = opt.Optional()
+	}
+}
+
+type patchOptionalOptionalTC[T any] struct {
+	opt    PatchOptional[T]
+	expect Optional[T]
+	test.Control
+}
+
+func (tc patchOptionalOptionalTC[T]) Test(t *testing.T) {
+	actual := tc.opt.Optional()
+	assert.Equal(t, tc.expect, actual, "unexpected Optional")
+}
+
+func TestPatchOptional_Optional(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int PatchOptional": patchOptionalOptionalTC[int]{
+			opt:    PatchOptional[int]{},
+			expect: Empty[int](),
+		},
+		"on non-empty int PatchOptional with zero value": patchOptionalOptionalTC[int]{
+			opt:    PatchOptional[int](Of(0)),
+			expect: Of(0),
+		},
+		"on non-empty int PatchOptional with non-zero value": patchOptionalOptionalTC[int]{
+			opt:    PatchOptional[int](Of(123)),
+			expect: Of(123),
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkPatchOptional_UnmarshalJSON(b *testing.B) {
+	data := []byte("123")
+	for i := 0; i < b.N; i++ {
+		var opt PatchOptional[int]
+		if err := opt.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type patchOptionalUnmarshalJSONTC[T any] struct {
+	data          string
+	expectError   bool
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc patchOptionalUnmarshalJSONTC[T]) Test(t *testing.T) {
+	var actual struct {
+		Field PatchOptional[T] `json:"field"`
+	}
+	err := json.Unmarshal([]byte(tc.data), &actual)
+	if tc.expectError {
+		assert.Error(t, err, "expected error")
+	} else {
+		assert.NoError(t, err, "unexpected error")
+	}
+	value, present := actual.Field.Optional().Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestPatchOptional_UnmarshalJSON(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"with missing int field": patchOptionalUnmarshalJSONTC[int]{
+			data:          `{}`,
+			expectPresent: false,
+		},
+		"with null int field": patchOptionalUnmarshalJSONTC[int]{
+			data:          `{"field":null}`,
+			expectPresent: false,
+		},
+		"with zero int field": patchOptionalUnmarshalJSONTC[int]{
+			data:          `{"field":0}`,
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"with non-zero int field": patchOptionalUnmarshalJSONTC[int]{
+			data:          `{"field":123}`,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"with erroneous int field": patchOptionalUnmarshalJSONTC[int]{
+			data:        `{"field":"abc"}`,
+			expectError: true,
+		},
+		"with missing string field": patchOptionalUnmarshalJSONTC[string]{
+			data:          `{}`,
+			expectPresent: false,
+		},
+		"with null string field": patchOptionalUnmarshalJSONTC[string]{
+			data:          `{"field":null}`,
+			expectPresent: false,
+		},
+		"with zero string field": patchOptionalUnmarshalJSONTC[string]{
+			data:          `{"field":""}`,
+			expectPresent: true,
+			expectValue:   "",
+		},
+		"with non-zero string field": patchOptionalUnmarshalJSONTC[string]{
+			data:          `{"field":"abc"}`,
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"context"
+	"sync"
+)
+
+// Promise is a container for an Optional whose value is resolved asynchronously (e.g. during async initialization).
+// A Promise is settled at most once by calling either Resolve or Fail, with any subsequent calls being ignored, and Get
+// can be used to block until it has been settled.
+//
+// The zero value of Promise is ready to use and is unsettled. A Promise is safe for concurrent use and should be passed
+// around via a pointer, since a copy made after Get has been called shares the same channel but not whether it has
+// been settled, and so settling both the copy and the original panics.
+type Promise[T any] struct {
+	// done is closed once the Promise has been settled. It is lazily initialized.
+	done chan struct{}
+	// err is the error with which the Promise failed, if any.
+	err error
+	// mu guards done, err, opt, and settled.
+	mu sync.Mutex
+	// opt is the Optional with which the Promise was resolved, if any.
+	opt Optional[T]
+	// settled is whether the Promise has been resolved or failed.
+	settled bool
+}
+
+// NewPromise returns an unsettled Promise.
+func NewPromise[T any]() *Promise[T] {
+	return &Promise[T]{done: make(chan struct{})}
+}
+
+// Fail settles the Promise with the given error, unblocking any calls to Get, if it has not already been settled,
+// otherwise does nothing.
+//
+// If err is nil, the Promise fails with ErrNotPresent instead so that Get never returns an empty Optional without an
+// error once the Promise has been settled.
+func (p *Promise[T]) Fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.settled {
+		return
+	}
+	if err == nil {
+		err = ErrNotPresent
+	}
+	p.err = err
+	p.settle()
+}
+
+// Get blocks until the Promise has been settled or ctx is done, whichever happens first.
+//
+// If the Promise was resolved, an Optional with the resolved value present is returned. If the Promise failed, an
+// empty Optional is returned along with the error it failed with. Otherwise, if ctx is done before the Promise is
+// settled, an empty Optional is returned along with the error from ctx.
+//
+// If the Promise has already been settled, its result is always returned even if ctx is also done.
+func (p *Promise[T]) Get(ctx context.Context) (Optional[T], error) {
+	done := p.doneChan()
+	select {
+	case <-done:
+		return p.result()
+	default:
+	}
+	select {
+	case <-done:
+		return p.result()
+	case <-ctx.Done():
+		return Optional[T]{}, ctx.Err()
+	}
+}
+
+// Resolve settles the Promise with the given value, unblocking any calls to Get, if it has not already been settled,
+// otherwise does nothing.
+func (p *Promise[T]) Resolve(value T) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.settled {
+		return
+	}
+	p.opt = Optional[T]{
+		present: true,
+		value:   value,
+	}
+	p.settle()
+}
+
+// doneChan returns the channel that is closed once the Promise has been settled, initializing it if needed.
+func (p *Promise[T]) doneChan() <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.done == nil {
+		p.done = make(chan struct{})
+	}
+	return p.done
+}
+
+// result returns the Optional and error with which the Promise was settled.
+func (p *Promise[T]) result() (Optional[T], error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.opt, p.err
+}
+
+// settle marks the Promise as settled and closes its done channel, initializing it if needed.
+//
+// The caller must hold mu.
+func (p *Promise[T]) settle() {
+	if p.done == nil {
+		p.done = make(chan struct{})
+	}
+	p.settled = true
+	close(p.done)
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"context"
+	"errors"
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func BenchmarkNewPromise(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NewPromise[int]()
+	}
+}
+
+func TestNewPromise(t *testing.T) {
+	p := NewPromise[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	opt, err := p.Get(ctx)
+	assert.ErrorIs(t, err, context.Canceled, "unexpected error")
+	assert.Equal(t, Empty[int](), opt, "unexpected Optional")
+}
+
+func BenchmarkPromise_Fail(b *testing.B) {
+	err := errors.New("failed")
+	for i := 0; i < b.N; i++ {
+		p := NewPromise[int]()
+		p.Fail(err)
+	}
+}
+
+func TestPromise_Fail(t *testing.T) {
+	err := errors.New("failed")
+	var p Promise[string]
+
+	p.Fail(err)
+	p.Fail(errors.New("ignored"))
+	p.Resolve("ignored")
+
+	opt, actualErr := p.Get(context.Background())
+	assert.Same(t, err, actualErr, "unexpected error")
+	assert.Equal(t, Empty[string](), opt, "unexpected Optional")
+}
+
+func TestPromise_Fail_nilError(t *testing.T) {
+	var p Promise[string]
+
+	p.Fail(nil)
+	p.Resolve("ignored")
+
+	opt, err := p.Get(context.Background())
+	assert.ErrorIs(t, err, ErrNotPresent, "unexpected error")
+	assert.Equal(t, Empty[string](), opt, "unexpected Optional")
+}
+
+func BenchmarkPromise_Get(b *testing.B) {
+	ctx := context.Background()
+	p := NewPromise[int]()
+	p.Resolve(123)
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Get(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type promiseGetTC[T any] struct {
+	settle        func(p *Promise[T])
+	settleAsync   bool
+	cancelCtx     bool
+	expectError   error
+	expectPresent bool
+	expectValue   T
+	test.Control
+}
+
+func (tc promiseGetTC[T]) Test(t *testing.T) {
+	var p Promise[T]
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if tc.cancelCtx {
+		cancel()
+	}
+	if tc.settle != nil && !tc.settleAsync {
+		tc.settle(&p)
+	}
+
+	type result struct {
+		opt Optional[T]
+		err error
+	}
+	started := make(chan struct{})
+	results := make(chan result, 1)
+	go func() {
+		close(started)
+		opt, err := p.Get(ctx)
+		results <- result{opt, err}
+	}()
+	<-started
+	if tc.settleAsync {
+		select {
+		case <-results:
+			assert.Fail(t, "unexpected return from Get before Promise was settled")
+			return
+		default:
+		}
+		tc.settle(&p)
+	}
+
+	r := <-results
+	opt, err := r.opt, r.err
+	assert.ErrorIs(t, err, tc.expectError, "unexpected error")
+	value, present := opt.Get()
+	assert.Equal(t, tc.expectValue, value, "unexpected value")
+	assert.Equal(t, tc.expectPresent, present, "unexpected value presence")
+}
+
+func TestPromise_Get(t *testing.T) {
+	err := errors.New("failed")
+
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on int Promise resolved before Get": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Resolve(123)
+			},
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on int Promise resolved with zero value before Get": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Resolve(0)
+			},
+			expectPresent: true,
+			expectValue:   0,
+		},
+		"on int Promise resolved after Get": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Resolve(123)
+			},
+			settleAsync:   true,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on int Promise failed before Get": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Fail(err)
+			},
+			expectError: err,
+		},
+		"on int Promise failed after Get": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Fail(err)
+			},
+			settleAsync: true,
+			expectError: err,
+		},
+		"on int Promise failed with nil error after Get": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Fail(nil)
+			},
+			settleAsync: true,
+			expectError: ErrNotPresent,
+		},
+		"on unsettled int Promise with cancelled context": promiseGetTC[int]{
+			cancelCtx:   true,
+			expectError: context.Canceled,
+		},
+		"on string Promise resolved after Get": promiseGetTC[string]{
+			settle: func(p *Promise[string]) {
+				p.Resolve("abc")
+			},
+			settleAsync:   true,
+			expectPresent: true,
+			expectValue:   "abc",
+		},
+		// Other test cases...
+		"on int Promise resolved before Get with cancelled context": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Resolve(123)
+			},
+			cancelCtx:     true,
+			expectPresent: true,
+			expectValue:   123,
+		},
+		"on int Promise failed before Get with cancelled context": promiseGetTC[int]{
+			settle: func(p *Promise[int]) {
+				p.Fail(err)
+			},
+			cancelCtx:   true,
+			expectError: err,
+		},
+	})
+}
+
+func TestPromise_Get_withDeadline(t *testing.T) {
+	p := NewPromise[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	opt, err := p.Get(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "unexpected error")
+	assert.Equal(t, Empty[int](), opt, "unexpected Optional")
+
+	p.Resolve(123)
+	opt, err = p.Get(context.Background())
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, Of(123), opt, "unexpected Optional after Resolve")
+}
+
+func BenchmarkPromise_Resolve(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		p := NewPromise[int]()
+		p.Resolve(i)
+	}
+}
+
+func TestPromise_Resolve(t *testing.T) {
+	var p Promise[string]
+
+	p.Resolve("abc")
+	p.Resolve("def")
+	p.Fail(errors.New("ignored"))
+
+	opt, err := p.Get(context.Background())
+	assert.NoError(t, err, "unexpected error")
+	assert.Equal(t, Of("abc"), opt, "unexpected Optional")
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ReadOnly is a read-only view of an Optional that exposes only its non-mutating methods, which can be used to document
+// and enforce immutability at the type level (e.g. across an API boundary). Unlike Optional, a ReadOnly cannot be
+// changed via Scan or UnmarshalJSON, nor does it provide any other means of changing its value.
+//
+// As with Optional.Frozen, the Optional within a ReadOnly is a shallow copy and so any value that is a pointer, map, or
+// slice will still share its underlying data.
+//
+// The zero value of ReadOnly contains an empty Optional.
+type ReadOnly[T any] struct {
+	// opt is the Optional.
+	opt Optional[T]
+}
+
+var (
+	_ fmt.Stringer   = (*ReadOnly[any])(nil)
+	_ json.Marshaler = (*ReadOnly[any])(nil)
+)
+
+// NewReadOnly returns a ReadOnly containing a copy of the given Optional.
+func NewReadOnly[T any](opt Optional[T]) ReadOnly[T] {
+	return ReadOnly[T]{opt: opt}
+}
+
+// Get returns the value of the Optional within the ReadOnly and whether it is present. See Optional.Get for more
+// information.
+func (r ReadOnly[T]) Get() (T, bool) {
+	return r.opt.Get()
+}
+
+// IsEmpty returns whether the value of the Optional within the ReadOnly is absent.
+func (r ReadOnly[T]) IsEmpty() bool {
+	return r.opt.IsEmpty()
+}
+
+// IsPresent returns whether the value of the Optional within the ReadOnly is present.
+func (r ReadOnly[T]) IsPresent() bool {
+	return r.opt.IsPresent()
+}
+
+// MarshalJSON marshals the value of the Optional within the ReadOnly into JSON. See Optional.MarshalJSON for more
+// information.
+//
+// An error is returned if unable to marshal the value.
+func (r ReadOnly[T]) MarshalJSON() ([]byte, error) {
+	return r.opt.MarshalJSON()
+}
+
+// Optional returns a copy of the Optional within the ReadOnly.
+func (r ReadOnly[T]) Optional() Optional[T] {
+	return r.opt
+}
+
+// OrElse returns the value of the Optional within the ReadOnly if present, otherwise other.
+func (r ReadOnly[T]) OrElse(other T) T {
+	return r.opt.OrElse(other)
+}
+
+// String returns a string representation of the Optional within the ReadOnly. See Optional.String for more
+// information.
+func (r ReadOnly[T]) String() string {
+	return r.opt.String()
+}
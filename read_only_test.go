@@ -0,0 +1,131 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func BenchmarkNewReadOnly(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = NewReadOnly(opt)
+	}
+}
+
+type newReadOnlyTC[T any] struct {
+	opt Optional[T]
+	test.Control
+}
+
+func (tc newReadOnlyTC[T]) Test(t *testing.T) {
+	r := NewReadOnly(tc.opt)
+	assert.Equal(t, tc.opt, r.Optional(), "unexpected Optional")
+}
+
+func TestNewReadOnly(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": newReadOnlyTC[int]{
+			opt: Empty[int](),
+		},
+		"given non-empty int Optional with zero value": newReadOnlyTC[int]{
+			opt: Of(0),
+		},
+		"given non-empty int Optional with non-zero value": newReadOnlyTC[int]{
+			opt: Of(123),
+		},
+		"given empty string Optional": newReadOnlyTC[string]{
+			opt: Empty[string](),
+		},
+		"given non-empty string Optional with zero value": newReadOnlyTC[string]{
+			opt: Of(""),
+		},
+		"given non-empty string Optional with non-zero value": newReadOnlyTC[string]{
+			opt: Of("abc"),
+		},
+		// Other test cases...
+	})
+}
+
+func TestNewReadOnly_doesNotShareOptional(t *testing.T) {
+	opt := Of(123)
+	r := NewReadOnly(opt)
+	if err := opt.Scan(nil); !assert.NoError(t, err, "unexpected error") {
+		return
+	}
+	assert.Equal(t, Of(123), r.Optional(), "unexpected Optional after mutating original")
+}
+
+func TestReadOnly_hasNoMutatingMethods(t *testing.T) {
+	rt := reflect.TypeOf((*ReadOnly[int])(nil))
+	for _, name := range []string{"Clear", "Scan", "Set", "UnmarshalJSON", "UnmarshalXML", "UnmarshalYAML"} {
+		_, found := rt.MethodByName(name)
+		assert.Falsef(t, found, "unexpected method: %v", name)
+	}
+}
+
+type readOnlyTC[T any] struct {
+	opt Optional[T]
+	test.Control
+}
+
+func (tc readOnlyTC[T]) Test(t *testing.T) {
+	r := NewReadOnly(tc.opt)
+	expectValue, expectPresent := tc.opt.Get()
+	value, present := r.Get()
+	assert.Equal(t, expectValue, value, "unexpected value")
+	assert.Equal(t, expectPresent, present, "unexpected value presence")
+	assert.Equal(t, tc.opt.IsEmpty(), r.IsEmpty(), "unexpected value absence")
+	assert.Equal(t, tc.opt.IsPresent(), r.IsPresent(), "unexpected value presence")
+	assert.Equal(t, tc.opt.OrElse(expectValue), r.OrElse(expectValue), "unexpected value")
+	assert.Equal(t, tc.opt.String(), r.String(), "unexpected string")
+	expectJSON, expectErr := tc.opt.MarshalJSON()
+	data, err := r.MarshalJSON()
+	assert.Equal(t, expectErr, err, "unexpected error")
+	assert.Equal(t, string(expectJSON), string(data), "unexpected JSON")
+}
+
+func TestReadOnly(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		"on empty int Optional": readOnlyTC[int]{
+			opt: Empty[int](),
+		},
+		"on non-empty int Optional with zero value": readOnlyTC[int]{
+			opt: Of(0),
+		},
+		"on non-empty int Optional with non-zero value": readOnlyTC[int]{
+			opt: Of(123),
+		},
+		"on empty string Optional": readOnlyTC[string]{
+			opt: Empty[string](),
+		},
+		"on non-empty string Optional with zero value": readOnlyTC[string]{
+			opt: Of(""),
+		},
+		"on non-empty string Optional with non-zero value": readOnlyTC[string]{
+			opt: Of("abc"),
+		},
+	})
+}
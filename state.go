@@ -0,0 +1,47 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import "fmt"
+
+// State represents whether an Optional has a value present, allowing for exhaustive switch statements.
+type State int
+
+const (
+	// Absent is the State of an Optional that has no value present.
+	Absent State = iota
+	// Present is the State of an Optional that has a value present.
+	Present
+)
+
+var _ fmt.Stringer = Absent
+
+// String returns a string representation of the State.
+func (s State) String() string {
+	switch s {
+	case Absent:
+		return "Absent"
+	case Present:
+		return "Present"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
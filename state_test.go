@@ -0,0 +1,64 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func BenchmarkState_String(b *testing.B) {
+	s := Present
+	for i := 0; i < b.N; i++ {
+		_ = s.String()
+	}
+}
+
+type stateStringTC struct {
+	state  State
+	expect string
+	test.Control
+}
+
+func (tc stateStringTC) Test(t *testing.T) {
+	s := tc.state.String()
+	assert.Equal(t, tc.expect, s, "unexpected string representation")
+}
+
+func TestState_String(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on Absent": stateStringTC{
+			state:  Absent,
+			expect: "Absent",
+		},
+		"on Present": stateStringTC{
+			state:  Present,
+			expect: "Present",
+		},
+		// Other test cases...
+		"on unknown State": stateStringTC{
+			state:  State(123),
+			expect: "State(123)",
+		},
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import "sync"
+
+// Tracked is a mutable container for an Optional that records whether a value has ever been present within it, even
+// after it has been cleared. This can be especially useful for "dirty" tracking (e.g. within state machines).
+//
+// The zero value of Tracked is ready to use, contains an empty Optional, and has never been set. A Tracked is safe for
+// concurrent use and should be passed around via a pointer, since a copy would only record whether a value was ever
+// present up until it was copied, missing any later changes made to the original.
+type Tracked[T any] struct {
+	// everSet is whether opt has ever had a value present.
+	everSet bool
+	// mu guards everSet and opt.
+	mu sync.RWMutex
+	// opt is the Optional.
+	opt Optional[T]
+}
+
+// NewTracked returns a Tracked containing the given Optional, which is considered to have been set if it has a value
+// present.
+func NewTracked[T any](opt Optional[T]) *Tracked[T] {
+	return &Tracked[T]{
+		everSet: opt.present,
+		opt:     opt,
+	}
+}
+
+// Clear empties the Optional within the Tracked. Whether a value has ever been set is not affected.
+func (t *Tracked[T]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.opt = Optional[T]{}
+}
+
+// Get returns the value of the Optional within the Tracked and whether it is present. See Optional.Get for more
+// information.
+func (t *Tracked[T]) Get() (T, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.opt.Get()
+}
+
+// IsPresent returns whether the value of the Optional within the Tracked is present.
+func (t *Tracked[T]) IsPresent() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.opt.present
+}
+
+// Optional returns the Optional within the Tracked.
+func (t *Tracked[T]) Optional() Optional[T] {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.opt
+}
+
+// Set replaces the Optional within the Tracked with one that has the given value present, recording that a value has
+// been set.
+func (t *Tracked[T]) Set(value T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.everSet = true
+	t.opt = Optional[T]{
+		present: true,
+		value:   value,
+	}
+}
+
+// WasEverSet returns whether a value has ever been present within the Tracked, regardless of whether it has since been
+// cleared.
+func (t *Tracked[T]) WasEverSet() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.everSet
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2024 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package optional
+
+import (
+	"github.com/neocotic/go-optional/internal/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func BenchmarkNewTracked(b *testing.B) {
+	opt := Of(123)
+	for i := 0; i < b.N; i++ {
+		_ = NewTracked(opt)
+	}
+}
+
+type newTrackedTC[T any] struct {
+	opt              Optional[T]
+	expectWasEverSet bool
+	test.Control
+}
+
+func (tc newTrackedTC[T]) Test(t *testing.T) {
+	tr := NewTracked(tc.opt)
+	assert.Equal(t, tc.opt, tr.Optional(), "unexpected Optional")
+	assert.Equal(t, tc.expectWasEverSet, tr.WasEverSet(), "unexpected set history")
+}
+
+func TestNewTracked(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"given empty int Optional": newTrackedTC[int]{
+			opt:              Empty[int](),
+			expectWasEverSet: false,
+		},
+		"given non-empty int Optional with zero value": newTrackedTC[int]{
+			opt:              Of(0),
+			expectWasEverSet: true,
+		},
+		"given non-empty int Optional with non-zero value": newTrackedTC[int]{
+			opt:              Of(123),
+			expectWasEverSet: true,
+		},
+		"given empty string Optional": newTrackedTC[string]{
+			opt:              Empty[string](),
+			expectWasEverSet: false,
+		},
+		"given non-empty string Optional with non-zero value": newTrackedTC[string]{
+			opt:              Of("abc"),
+			expectWasEverSet: true,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkTracked_Clear(b *testing.B) {
+	tr := NewTracked(Of(123))
+	for i := 0; i < b.N; i++ {
+		tr.Clear()
+	}
+}
+
+type trackedClearTC[T any] struct {
+	opt              Optional[T]
+	expectWasEverSet bool
+	test.Control
+}
+
+func (tc trackedClearTC[T]) Test(t *testing.T) {
+	tr := NewTracked(tc.opt)
+	tr.Clear()
+	assert.Equal(t, Empty[T](), tr.Optional(), "unexpected Optional")
+	assert.False(t, tr.IsPresent(), "unexpected value presence")
+	assert.Equal(t, tc.expectWasEverSet, tr.WasEverSet(), "unexpected set history")
+}
+
+func TestTracked_Clear(t *testing.T) {
+	test.RunCases(t, test.Cases{
+		// Test cases for documented examples
+		"on empty int Tracked": trackedClearTC[int]{
+			opt:              Empty[int](),
+			expectWasEverSet: false,
+		},
+		"on non-empty int Tracked": trackedClearTC[int]{
+			opt:              Of(123),
+			expectWasEverSet: true,
+		},
+		"on empty string Tracked": trackedClearTC[string]{
+			opt:              Empty[string](),
+			expectWasEverSet: false,
+		},
+		"on non-empty string Tracked": trackedClearTC[string]{
+			opt:              Of("abc"),
+			expectWasEverSet: true,
+		},
+		// Other test cases...
+	})
+}
+
+func BenchmarkTracked_Get(b *testing.B) {
+	tr := NewTracked(Of(123))
+	for i := 0; i < b.N; i++ {
+		_, _ = tr.Get()
+	}
+}
+
+func TestTracked_Get(t *testing.T) {
+	var tr Tracked[int]
+	value, present := tr.Get()
+	assert.Equal(t, 0, value, "unexpected value for zero value")
+	assert.False(t, present, "unexpected value presence for zero value")
+
+	tr.Set(123)
+	value, present = tr.Get()
+	assert.Equal(t, 123, value, "unexpected value after Set")
+	assert.True(t, present, "unexpected value presence after Set")
+}
+
+func BenchmarkTracked_IsPresent(b *testing.B) {
+	tr := NewTracked(Of(123))
+	for i := 0; i < b.N; i++ {
+		_ = tr.IsPresent()
+	}
+}
+
+func TestTracked_IsPresent(t *testing.T) {
+	var tr Tracked[string]
+	assert.False(t, tr.IsPresent(), "unexpected value presence for zero value")
+	tr.Set("")
+	assert.True(t, tr.IsPresent(), "unexpected value presence after Set")
+	tr.Clear()
+	assert.False(t, tr.IsPresent(), "unexpected value presence after Clear")
+}
+
+func BenchmarkTracked_Optional(b *testing.B) {
+	tr := NewTracked(Of(123))
+	for i := 0; i < b.N; i++ {
+		_ = tr.Optional()
+	}
+}
+
+func TestTracked_Optional(t *testing.T) {
+	var tr Tracked[int]
+	assert.Equal(t, Empty[int](), tr.Optional(), "unexpected Optional for zero value")
+	tr.Set(123)
+	assert.Equal(t, Of(123), tr.Optional(), "unexpected Optional after Set")
+}
+
+func BenchmarkTracked_Set(b *testing.B) {
+	var tr Tracked[int]
+	for i := 0; i < b.N; i++ {
+		tr.Set(i)
+	}
+}
+
+func TestTracked_Set(t *testing.T) {
+	var tr Tracked[string]
+
+	tr.Set("abc")
+	assert.Equal(t, Of("abc"), tr.Optional(), "unexpected Optional after first Set")
+	assert.True(t, tr.WasEverSet(), "unexpected set history after first Set")
+
+	tr.Set("")
+	assert.Equal(t, Of(""), tr.Optional(), "unexpected Optional after second Set")
+	assert.True(t, tr.WasEverSet(), "unexpected set history after second Set")
+}
+
+func BenchmarkTracked_WasEverSet(b *testing.B) {
+	tr := NewTracked(Of(123))
+	for i := 0; i < b.N; i++ {
+		_ = tr.WasEverSet()
+	}
+}
+
+func TestTracked_WasEverSet(t *testing.T) {
+	var tr Tracked[int]
+	assert.False(t, tr.WasEverSet(), "unexpected set history for zero value")
+
+	tr.Clear()
+	assert.False(t, tr.WasEverSet(), "unexpected set history after Clear without Set")
+
+	tr.Set(123)
+	assert.True(t, tr.WasEverSet(), "unexpected set history after Set")
+
+	tr.Clear()
+	assert.True(t, tr.WasEverSet(), "unexpected set history after Clear")
+	assert.False(t, tr.IsPresent(), "unexpected value presence after Clear")
+}